@@ -0,0 +1,261 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"golang.org/x/time/rate"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+)
+
+const (
+	// defaultBulkOperationMaxPerSecond is used when a BulkOperationRequest does not set maxPerSecond.
+	// Each action makes at least one SFN call, so keep this well below the SFN API limits.
+	defaultBulkOperationMaxPerSecond = 5
+	// maxBulkOperationWorkflows bounds how many workflows a single bulk operation can act on.
+	maxBulkOperationWorkflows = 1000
+	// bulkOperationPageSize is the page size used when following a bulk operation's query.
+	bulkOperationPageSize = 100
+	// bulkOperationStaleAfter is how long a running bulk operation can go without saving progress
+	// before it's considered abandoned, e.g. by a process that was deployed over, and resumed.
+	// Progress is saved after every workflow, which takes at most a second plus the action itself.
+	bulkOperationStaleAfter = 5 * time.Minute
+	// bulkOperationResumeInterval is how often abandoned bulk operations are looked for.
+	bulkOperationResumeInterval = time.Minute
+)
+
+// StartBulkOperation saves a new BulkOperation and runs it in the background.
+// The returned BulkOperation can be polled with GetBulkOperationByID.
+func (h Handler) StartBulkOperation(ctx context.Context, req *models.BulkOperationRequest) (*models.BulkOperation, error) {
+	if err := validateBulkOperationRequest(req); err != nil {
+		return nil, err
+	}
+	if req.MaxPerSecond == 0 {
+		req.MaxPerSecond = defaultBulkOperationMaxPerSecond
+	}
+
+	op := resources.NewBulkOperation(req)
+	if err := h.store.SaveBulkOperation(ctx, *op); err != nil {
+		return nil, err
+	}
+
+	go h.runBulkOperation(bulkOperationContext(*op), *op)
+
+	return op, nil
+}
+
+// bulkOperationContext returns the context a bulk operation runs in. The request context is
+// cancelled once the response is written, so the operation gets its own.
+func bulkOperationContext(op models.BulkOperation) context.Context {
+	ctx := logger.NewContext(context.Background(), logger.New("workflow-manager"))
+	logger.FromContext(ctx).AddContext("bulk-operation-id", op.ID)
+	return ctx
+}
+
+// ResumeBulkOperations resumes bulk operations that were abandoned while running, e.g. by a
+// process that was deployed over or crashed, until the context is canceled. The store claims each
+// abandoned operation for one process, and the operation's results record which workflows are
+// still pending.
+func (h Handler) ResumeBulkOperations(ctx context.Context) {
+	ticker := time.NewTicker(bulkOperationResumeInterval)
+	defer ticker.Stop()
+	for {
+		ops, err := h.store.ClaimStaleBulkOperations(ctx, time.Now().Add(-bulkOperationStaleAfter))
+		if err != nil {
+			logger.FromContext(ctx).ErrorD("claim-stale-bulk-operations-error", logger.M{"error": err.Error()})
+		}
+		for _, op := range ops {
+			logger.FromContext(ctx).InfoD("resume-bulk-operation", logger.M{"bulk-operation-id": op.ID})
+			go h.runBulkOperation(bulkOperationContext(op), op)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetBulkOperationByID returns the current progress of a BulkOperation
+func (h Handler) GetBulkOperationByID(ctx context.Context, operationID string) (*models.BulkOperation, error) {
+	op, err := h.store.GetBulkOperationByID(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+func validateBulkOperationRequest(req *models.BulkOperationRequest) error {
+	if req == nil {
+		return models.BadRequest{Message: "BulkOperationRequest is required"}
+	}
+	if (len(req.WorkflowIDs) == 0) == (req.Query == nil) {
+		return models.BadRequest{Message: "exactly one of workflowIDs or query must be set"}
+	}
+	if len(req.WorkflowIDs) > maxBulkOperationWorkflows {
+		return models.BadRequest{
+			Message: fmt.Sprintf("cannot act on more than %d workflows at once", maxBulkOperationWorkflows),
+		}
+	}
+	if req.MaxPerSecond < 0 {
+		return models.BadRequest{Message: "maxPerSecond must be positive"}
+	}
+
+	switch req.Action {
+	case models.BulkOperationActionCancel:
+		if req.Reason == "" {
+			return models.BadRequest{Message: "reason is required to cancel workflows"}
+		}
+	case models.BulkOperationActionResume:
+		if req.Overrides == nil || req.Overrides.StartAt == "" {
+			return models.BadRequest{Message: "overrides.StartAt is required to resume workflows"}
+		}
+	case models.BulkOperationActionResolve:
+	default:
+		return models.BadRequest{Message: fmt.Sprintf("unknown action '%s'", req.Action)}
+	}
+
+	return nil
+}
+
+// runBulkOperation applies the operation's action to each selected workflow, saving the
+// per-workflow results as it goes. The saved results are the operation's cursor: a resumed
+// operation only acts on the workflows whose results are still pending.
+func (h Handler) runBulkOperation(ctx context.Context, op models.BulkOperation) {
+	if len(op.Results) == 0 {
+		workflowIDs := op.Request.WorkflowIDs
+		if op.Request.Query != nil {
+			var err error
+			workflowIDs, err = h.queryBulkOperationWorkflowIDs(ctx, *op.Request.Query)
+			if err != nil {
+				op.Status = models.BulkOperationStatusFailed
+				op.StatusReason = err.Error()
+				h.saveBulkOperationProgress(ctx, op)
+				return
+			}
+		}
+
+		// results are stored by workflow ID, so only act on each workflow once
+		seen := map[string]bool{}
+		for _, workflowID := range workflowIDs {
+			if seen[workflowID] {
+				continue
+			}
+			seen[workflowID] = true
+			op.Results = append(op.Results, &models.BulkOperationResult{
+				WorkflowID: workflowID,
+				Status:     models.BulkOperationResultStatusPending,
+			})
+		}
+		h.saveBulkOperationProgress(ctx, op)
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(op.Request.MaxPerSecond), 1)
+	for _, result := range op.Results {
+		if result.Status != models.BulkOperationResultStatusPending {
+			continue
+		}
+		if err := limiter.Wait(ctx); err != nil {
+			op.Status = models.BulkOperationStatusFailed
+			op.StatusReason = err.Error()
+			h.saveBulkOperationProgress(ctx, op)
+			return
+		}
+
+		resultWorkflowID, err := h.applyBulkOperationAction(ctx, op.Request, result.WorkflowID)
+		if err != nil {
+			result.Status = models.BulkOperationResultStatusFailed
+			result.Error = err.Error()
+		} else {
+			result.Status = models.BulkOperationResultStatusSucceeded
+			result.ResultWorkflowID = resultWorkflowID
+		}
+		h.saveBulkOperationResult(ctx, op, *result)
+	}
+
+	op.Status = models.BulkOperationStatusCompleted
+	h.saveBulkOperationProgress(ctx, op)
+}
+
+// queryBulkOperationWorkflowIDs follows every page of the query and returns the IDs of the
+// matching workflows.
+func (h Handler) queryBulkOperationWorkflowIDs(ctx context.Context, query models.WorkflowQuery) ([]string, error) {
+	query.SummaryOnly = aws.Bool(true)
+	if query.Limit == 0 {
+		query.Limit = bulkOperationPageSize
+	}
+
+	workflowIDs := []string{}
+	for {
+		workflows, nextPageToken, err := h.store.GetWorkflows(ctx, &query)
+		if err != nil {
+			return nil, err
+		}
+		for _, workflow := range workflows {
+			workflowIDs = append(workflowIDs, workflow.ID)
+		}
+		if len(workflowIDs) > maxBulkOperationWorkflows {
+			return nil, fmt.Errorf("query matched more than %d workflows", maxBulkOperationWorkflows)
+		}
+		if nextPageToken == "" {
+			return workflowIDs, nil
+		}
+		query.PageToken = nextPageToken
+	}
+}
+
+// applyBulkOperationAction performs the requested action for a single workflow. For resumes it
+// returns the ID of the new workflow.
+func (h Handler) applyBulkOperationAction(
+	ctx context.Context,
+	req *models.BulkOperationRequest,
+	workflowID string,
+) (string, error) {
+	switch req.Action {
+	case models.BulkOperationActionCancel:
+		return "", h.CancelWorkflow(ctx, &models.CancelWorkflowInput{
+			WorkflowID: workflowID,
+			Reason:     &models.CancelReason{Reason: req.Reason},
+		})
+	case models.BulkOperationActionResume:
+		workflow, err := h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+			WorkflowID: workflowID,
+			Overrides:  req.Overrides,
+		})
+		if err != nil {
+			return "", err
+		}
+		return workflow.ID, nil
+	case models.BulkOperationActionResolve:
+		return "", h.ResolveWorkflowByID(ctx, workflowID)
+	default:
+		return "", fmt.Errorf("unknown action '%s'", req.Action)
+	}
+}
+
+// saveBulkOperationResult updates the stored result of a BulkOperation for one workflow. Failures
+// are logged like saveBulkOperationProgress's.
+func (h Handler) saveBulkOperationResult(ctx context.Context, op models.BulkOperation, result models.BulkOperationResult) {
+	if err := h.store.UpdateBulkOperationResult(ctx, op.ID, result); err != nil {
+		logger.FromContext(ctx).ErrorD("update-bulk-operation-result-error", logger.M{
+			"error":       err.Error(),
+			"workflow-id": result.WorkflowID,
+		})
+	}
+}
+
+// saveBulkOperationProgress updates the stored BulkOperation. Failures are logged rather than
+// returned so that one failed write does not stop the remaining workflows from being processed.
+func (h Handler) saveBulkOperationProgress(ctx context.Context, op models.BulkOperation) {
+	if err := h.store.UpdateBulkOperation(ctx, op); err != nil {
+		logger.FromContext(ctx).ErrorD("update-bulk-operation-error", logger.M{
+			"error":  err.Error(),
+			"status": op.Status,
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/mocks"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store/memory"
+)
+
+func TestValidateBulkOperationRequest(t *testing.T) {
+	for _, test := range []struct {
+		desc  string
+		req   *models.BulkOperationRequest
+		valid bool
+	}{
+		{
+			desc:  "resolve by ID",
+			req:   &models.BulkOperationRequest{Action: models.BulkOperationActionResolve, WorkflowIDs: []string{"a"}},
+			valid: true,
+		},
+		{
+			desc: "resolve by query",
+			req: &models.BulkOperationRequest{
+				Action: models.BulkOperationActionResolve,
+				Query:  &models.WorkflowQuery{WorkflowDefinitionName: aws.String("name")},
+			},
+			valid: true,
+		},
+		{
+			desc: "both IDs and query",
+			req: &models.BulkOperationRequest{
+				Action:      models.BulkOperationActionResolve,
+				WorkflowIDs: []string{"a"},
+				Query:       &models.WorkflowQuery{WorkflowDefinitionName: aws.String("name")},
+			},
+		},
+		{
+			desc: "neither IDs nor query",
+			req:  &models.BulkOperationRequest{Action: models.BulkOperationActionResolve},
+		},
+		{
+			desc: "cancel without reason",
+			req:  &models.BulkOperationRequest{Action: models.BulkOperationActionCancel, WorkflowIDs: []string{"a"}},
+		},
+		{
+			desc: "resume without StartAt",
+			req:  &models.BulkOperationRequest{Action: models.BulkOperationActionResume, WorkflowIDs: []string{"a"}},
+		},
+		{
+			desc: "missing action",
+			req:  &models.BulkOperationRequest{WorkflowIDs: []string{"a"}},
+		},
+		{
+			desc: "too many workflows",
+			req: &models.BulkOperationRequest{
+				Action:      models.BulkOperationActionResolve,
+				WorkflowIDs: make([]string, maxBulkOperationWorkflows+1),
+			},
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			err := validateBulkOperationRequest(test.req)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.IsType(t, models.BadRequest{}, err)
+			}
+		})
+	}
+}
+
+func TestRunBulkOperation(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	running := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	running.Status = models.WorkflowStatusRunning
+	require.NoError(t, store.SaveWorkflow(ctx, *running))
+	failed := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	failed.Status = models.WorkflowStatusFailed
+	require.NoError(t, store.SaveWorkflow(ctx, *failed))
+
+	t.Log("cancel by query only acts on the matching workflows")
+	mockWFM.EXPECT().
+		CancelWorkflow(gomock.Any(), gomock.Any(), "incident").
+		Do(func(ctx context.Context, wf *models.Workflow, reason string) {
+			assert.Equal(t, running.ID, wf.ID)
+		}).
+		Return(nil)
+	op := resources.NewBulkOperation(&models.BulkOperationRequest{
+		Action: models.BulkOperationActionCancel,
+		Query: &models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(workflowDefinition.Name),
+			Status:                 models.WorkflowStatusRunning,
+		},
+		Reason:       "incident",
+		MaxPerSecond: 100,
+	})
+	require.NoError(t, store.SaveBulkOperation(ctx, *op))
+	h.runBulkOperation(ctx, *op)
+
+	saved, err := h.GetBulkOperationByID(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkOperationStatusCompleted, saved.Status)
+	require.Len(t, saved.Results, 1)
+	assert.Equal(t, running.ID, saved.Results[0].WorkflowID)
+	assert.Equal(t, models.BulkOperationResultStatusSucceeded, saved.Results[0].Status)
+
+	t.Log("resolve by ID records per-workflow failures and keeps going")
+	op = resources.NewBulkOperation(&models.BulkOperationRequest{
+		Action:       models.BulkOperationActionResolve,
+		WorkflowIDs:  []string{"unknown-workflow", failed.ID},
+		MaxPerSecond: 100,
+	})
+	require.NoError(t, store.SaveBulkOperation(ctx, *op))
+	h.runBulkOperation(ctx, *op)
+
+	saved, err = h.GetBulkOperationByID(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkOperationStatusCompleted, saved.Status)
+	require.Len(t, saved.Results, 2)
+	assert.Equal(t, models.BulkOperationResultStatusFailed, saved.Results[0].Status)
+	assert.NotEmpty(t, saved.Results[0].Error)
+	assert.Equal(t, models.BulkOperationResultStatusSucceeded, saved.Results[1].Status)
+
+	resolved, err := store.GetWorkflowByID(ctx, failed.ID)
+	require.NoError(t, err)
+	assert.True(t, resolved.ResolvedByUser)
+}
+
+func TestResumeBulkOperation(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	cancelled := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	cancelled.Status = models.WorkflowStatusCancelled
+	require.NoError(t, store.SaveWorkflow(ctx, *cancelled))
+	running := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	running.Status = models.WorkflowStatusRunning
+	require.NoError(t, store.SaveWorkflow(ctx, *running))
+
+	t.Log("an abandoned operation is claimed once and only acts on its pending workflows")
+	op := resources.NewBulkOperation(&models.BulkOperationRequest{
+		Action:       models.BulkOperationActionCancel,
+		WorkflowIDs:  []string{cancelled.ID, running.ID},
+		Reason:       "incident",
+		MaxPerSecond: 100,
+	})
+	op.Results = []*models.BulkOperationResult{
+		{WorkflowID: cancelled.ID, Status: models.BulkOperationResultStatusSucceeded},
+		{WorkflowID: running.ID, Status: models.BulkOperationResultStatusPending},
+	}
+	require.NoError(t, store.SaveBulkOperation(ctx, *op))
+
+	claimed, err := store.ClaimStaleBulkOperations(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	claimedAgain, err := store.ClaimStaleBulkOperations(ctx, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, claimedAgain)
+
+	mockWFM.EXPECT().
+		CancelWorkflow(gomock.Any(), gomock.Any(), "incident").
+		Do(func(ctx context.Context, wf *models.Workflow, reason string) {
+			assert.Equal(t, running.ID, wf.ID)
+		}).
+		Return(nil)
+	h.runBulkOperation(ctx, claimed[0])
+
+	saved, err := h.GetBulkOperationByID(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.BulkOperationStatusCompleted, saved.Status)
+	require.Len(t, saved.Results, 2)
+	assert.Equal(t, models.BulkOperationResultStatusSucceeded, saved.Results[0].Status)
+	assert.Equal(t, models.BulkOperationResultStatusSucceeded, saved.Results[1].Status)
+}
@@ -0,0 +1,47 @@
+// wfm-sync syncs a directory of workflow definitions to workflow-manager. By default it prints the
+// plan of what would change. With -apply it creates the definitions that don't exist, and new
+// versions of the definitions whose state machine or default tags changed.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/Clever/workflow-manager/gen-go/client"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of .yml, .yaml and .json workflow definitions")
+	url := flag.String("url", os.Getenv("WORKFLOW_MANAGER_URL"), "URL of workflow-manager, found by discovery if empty")
+	applyChanges := flag.Bool("apply", false, "apply the plan, rather than only printing it")
+	flag.Parse()
+
+	var wfm client.Client
+	if *url != "" {
+		wfm = client.New(*url)
+	} else {
+		var err error
+		if wfm, err = client.NewFromDiscovery(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	definitions, err := readDefinitions(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx := context.Background()
+	changes, err := plan(ctx, wfm, definitions)
+	if err != nil {
+		log.Fatal(err)
+	}
+	printPlan(os.Stdout, changes)
+
+	if *applyChanges {
+		if err := apply(ctx, wfm, os.Stdout, changes); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
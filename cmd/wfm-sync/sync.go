@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-openapi/swag"
+
+	"github.com/Clever/workflow-manager/gen-go/client"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+)
+
+const (
+	actionCreate    = "create"
+	actionUpdate    = "update"
+	actionUnchanged = "unchanged"
+)
+
+// change is what syncing a definition does to workflow-manager.
+type change struct {
+	action     string
+	definition models.NewWorkflowDefinitionRequest
+	// latest is the latest version of the definition in workflow-manager, unless it's created
+	latest *models.WorkflowDefinition
+	diff   *models.WorkflowDefinitionDiff
+}
+
+// readDefinitions reads the definitions in the .yml, .yaml and .json files of a directory. A file
+// holds either one definition or a list of them, like the definitions of the embedded package.
+func readDefinitions(dir string) ([]models.NewWorkflowDefinitionRequest, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := []models.NewWorkflowDefinitionRequest{}
+	definitionFiles := map[string]string{}
+	for _, file := range files {
+		switch strings.ToLower(filepath.Ext(file.Name())) {
+		case ".yml", ".yaml", ".json":
+		default:
+			continue
+		}
+		path := filepath.Join(dir, file.Name())
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		// JSON is YAML, so both are read the same way
+		contents, err = yaml.YAMLToJSON(contents)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+
+		fileDefinitions := []models.NewWorkflowDefinitionRequest{}
+		if bytes.HasPrefix(bytes.TrimSpace(contents), []byte("[")) {
+			err = json.Unmarshal(contents, &fileDefinitions)
+		} else {
+			var definition models.NewWorkflowDefinitionRequest
+			err = json.Unmarshal(contents, &definition)
+			fileDefinitions = append(fileDefinitions, definition)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+
+		for _, definition := range fileDefinitions {
+			if definition.Name == "" {
+				return nil, fmt.Errorf("%s: workflow definition name is required", path)
+			}
+			if other, ok := definitionFiles[definition.Name]; ok {
+				return nil, fmt.Errorf("%s: duplicate workflow definition %s, also in %s", path, definition.Name, other)
+			}
+			definitionFiles[definition.Name] = path
+			definitions = append(definitions, definition)
+		}
+	}
+
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Name < definitions[j].Name })
+	return definitions, nil
+}
+
+// plan compares definitions against their latest versions in workflow-manager. A definition
+// needs a new version if any of the fields of its request changed.
+func plan(ctx context.Context, wfm client.Client, definitions []models.NewWorkflowDefinitionRequest) ([]change, error) {
+	changes := []change{}
+	for _, definition := range definitions {
+		versions, err := wfm.GetWorkflowDefinitionVersionsByName(ctx, &models.GetWorkflowDefinitionVersionsByNameInput{
+			Name:   definition.Name,
+			Latest: swag.Bool(true),
+		})
+		if _, ok := err.(*models.NotFound); ok || (err == nil && len(versions) == 0) {
+			changes = append(changes, change{action: actionCreate, definition: definition})
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not get %s: %s", definition.Name, err)
+		}
+
+		latest := versions[0]
+		diff, err := resources.DiffWorkflowDefinitions(latest, models.WorkflowDefinition{
+			Name:         definition.Name,
+			Version:      latest.Version + 1,
+			StateMachine: definition.StateMachine,
+			DefaultTags:  definition.DefaultTags,
+		})
+		if err != nil {
+			return nil, err
+		}
+		fieldChanges, err := definitionChanges(latest, definition)
+		if err != nil {
+			return nil, err
+		}
+		diff.Changes = append(diff.Changes, fieldChanges...)
+		action := actionUnchanged
+		if len(diff.AddedStates) > 0 || len(diff.RemovedStates) > 0 || len(diff.RenamedStates) > 0 ||
+			len(diff.ChangedStates) > 0 || len(diff.Changes) > 0 {
+			action = actionUpdate
+		}
+		changes = append(changes, change{action: action, definition: definition, latest: &latest, diff: diff})
+	}
+	return changes, nil
+}
+
+// definitionChanges compares the fields of a definition's request that DiffWorkflowDefinitions
+// doesn't, i.e. the fields other than its name, state machine and default tags.
+func definitionChanges(latest models.WorkflowDefinition, definition models.NewWorkflowDefinitionRequest) ([]*models.FieldChange, error) {
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"autoRetry", latest.AutoRetry, definition.AutoRetry},
+		{"deadlines", latest.Deadlines, definition.Deadlines},
+		{"inputSchema", latest.InputSchema, definition.InputSchema},
+		{"manager", latest.Manager, definition.Manager},
+		{"outputSchemas", latest.OutputSchemas, definition.OutputSchemas},
+		{"triggers", latest.Triggers, definition.Triggers},
+	}
+	changes := []*models.FieldChange{}
+	for _, field := range fields {
+		from, err := encodeField(field.from)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode %s: %s", field.name, err)
+		}
+		to, err := encodeField(field.to)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode %s: %s", field.name, err)
+		}
+		if from != to {
+			changes = append(changes, &models.FieldChange{Field: field.name, From: from, To: to})
+		}
+	}
+	return changes, nil
+}
+
+// encodeField encodes a field as JSON, with unset and empty values encoded the same way, since
+// workflow-manager may return an empty value for a field that a definition file leaves out.
+func encodeField(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	switch string(encoded) {
+	case "null", `""`, "[]", "{}":
+		return "", nil
+	}
+	return string(encoded), nil
+}
+
+// printPlan prints what applying changes would do.
+func printPlan(w io.Writer, changes []change) {
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.action]++
+		switch c.action {
+		case actionCreate:
+			fmt.Fprintf(w, "+ %s: create version 0\n", c.definition.Name)
+		case actionUnchanged:
+			fmt.Fprintf(w, "  %s: unchanged at version %d\n", c.definition.Name, c.latest.Version)
+		case actionUpdate:
+			fmt.Fprintf(w, "~ %s: update version %d to %d\n", c.definition.Name, c.latest.Version, c.diff.ToVersion)
+			for _, state := range c.diff.AddedStates {
+				fmt.Fprintf(w, "    + state %s\n", state)
+			}
+			for _, state := range c.diff.RemovedStates {
+				fmt.Fprintf(w, "    - state %s\n", state)
+			}
+			for _, rename := range c.diff.RenamedStates {
+				fmt.Fprintf(w, "    ~ state %s renamed to %s\n", rename.From, rename.To)
+			}
+			for _, state := range c.diff.ChangedStates {
+				fields := []string{}
+				for _, fieldChange := range state.Changes {
+					fields = append(fields, fieldChange.Field)
+				}
+				fmt.Fprintf(w, "    ~ state %s: %s\n", state.State, strings.Join(fields, ", "))
+			}
+			for _, fieldChange := range c.diff.Changes {
+				fmt.Fprintf(w, "    ~ %s: %s -> %s\n", fieldChange.Field, fieldChange.From, fieldChange.To)
+			}
+		}
+	}
+	fmt.Fprintf(w, "\n%d to create, %d to update, %d unchanged.\n",
+		counts[actionCreate], counts[actionUpdate], counts[actionUnchanged])
+}
+
+// apply creates the definitions and versions in changes.
+func apply(ctx context.Context, wfm client.Client, w io.Writer, changes []change) error {
+	for _, c := range changes {
+		definition := c.definition
+		switch c.action {
+		case actionCreate:
+			created, err := wfm.NewWorkflowDefinition(ctx, &definition)
+			if err != nil {
+				return fmt.Errorf("could not create %s: %s", definition.Name, err)
+			}
+			fmt.Fprintf(w, "created %s version %d\n", created.Name, created.Version)
+		case actionUpdate:
+			updated, err := wfm.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+				Name:                         definition.Name,
+				NewWorkflowDefinitionRequest: &definition,
+			})
+			if err != nil {
+				return fmt.Errorf("could not update %s: %s", definition.Name, err)
+			}
+			fmt.Fprintf(w, "updated %s to version %d\n", updated.Name, updated.Version)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/client"
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func writeDefinitions(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "wfm-sync")
+	require.NoError(t, err)
+	for name, contents := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644))
+	}
+	return dir
+}
+
+func TestReadDefinitions(t *testing.T) {
+	dir := writeDefinitions(t, map[string]string{
+		"list.yml": `
+- name: b
+  stateMachine: {StartAt: s, States: {s: {Type: Succeed}}}
+- name: c
+  stateMachine: {StartAt: s, States: {s: {Type: Succeed}}}
+`,
+		"single.json": `{"name": "a", "stateMachine": {"StartAt": "s", "States": {"s": {"Type": "Succeed"}}}}`,
+		"README.md":   "not a definition",
+	})
+	defer os.RemoveAll(dir)
+
+	definitions, err := readDefinitions(dir)
+	require.NoError(t, err)
+	require.Len(t, definitions, 3)
+	assert.Equal(t, "a", definitions[0].Name)
+	assert.Equal(t, "b", definitions[1].Name)
+	assert.Equal(t, models.SLStateTypeSucceed, definitions[2].StateMachine.States["s"].Type)
+
+	t.Log("Definitions must have unique names")
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "duplicate.yaml"), []byte("name: a\n"), 0644))
+	_, err = readDefinitions(dir)
+	assert.Error(t, err)
+}
+
+func TestPlanAndApply(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	ctx := context.Background()
+	wfm := client.NewMockClient(mockController)
+
+	stateMachine := func(next string) *models.SLStateMachine {
+		return &models.SLStateMachine{
+			StartAt: "first",
+			States: map[string]models.SLState{
+				"first": {Type: models.SLStateTypeTask, Resource: "worker", Next: next},
+				"end":   {Type: models.SLStateTypeSucceed},
+				"other": {Type: models.SLStateTypeSucceed},
+			},
+		}
+	}
+	definitions := []models.NewWorkflowDefinitionRequest{
+		{Name: "changed", StateMachine: stateMachine("other")},
+		{Name: "new", StateMachine: stateMachine("end")},
+		{Name: "retried", StateMachine: stateMachine("end"), AutoRetry: &models.AutoRetryPolicy{MaxAttempts: 2}},
+		{Name: "same", StateMachine: stateMachine("end"), DefaultTags: map[string]interface{}{}},
+	}
+	latest := func(name string, def *models.WorkflowDefinition, err error) {
+		defs := []models.WorkflowDefinition{}
+		if def != nil {
+			defs = append(defs, *def)
+		}
+		wfm.EXPECT().
+			GetWorkflowDefinitionVersionsByName(ctx, &models.GetWorkflowDefinitionVersionsByNameInput{Name: name, Latest: swag.Bool(true)}).
+			Return(defs, err)
+	}
+	latest("changed", &models.WorkflowDefinition{Name: "changed", Version: 2, StateMachine: stateMachine("end")}, nil)
+	latest("new", nil, &models.NotFound{})
+	latest("retried", &models.WorkflowDefinition{Name: "retried", Version: 1, StateMachine: stateMachine("end"),
+		AutoRetry: &models.AutoRetryPolicy{MaxAttempts: 1}}, nil)
+	latest("same", &models.WorkflowDefinition{Name: "same", Version: 5, StateMachine: stateMachine("end"),
+		Triggers: []*models.WorkflowTrigger{}}, nil)
+
+	changes, err := plan(ctx, wfm, definitions)
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+	assert.Equal(t, actionUpdate, changes[0].action)
+	assert.Equal(t, actionCreate, changes[1].action)
+	assert.Equal(t, actionUpdate, changes[2].action)
+	assert.Equal(t, actionUnchanged, changes[3].action)
+
+	var out bytes.Buffer
+	printPlan(&out, changes)
+	assert.Equal(t, `~ changed: update version 2 to 3
+    ~ state first: Next
++ new: create version 0
+~ retried: update version 1 to 2
+    ~ autoRetry: {"maxAttempts":1} -> {"maxAttempts":2}
+  same: unchanged at version 5
+
+1 to create, 2 to update, 1 unchanged.
+`, out.String())
+
+	t.Log("Applying only creates new definitions and versions")
+	wfm.EXPECT().NewWorkflowDefinition(ctx, &definitions[1]).
+		Return(&models.WorkflowDefinition{Name: "new"}, nil)
+	wfm.EXPECT().UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name:                         "changed",
+		NewWorkflowDefinitionRequest: &definitions[0],
+	}).Return(&models.WorkflowDefinition{Name: "changed", Version: 3}, nil)
+	wfm.EXPECT().UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name:                         "retried",
+		NewWorkflowDefinitionRequest: &definitions[2],
+	}).Return(&models.WorkflowDefinition{Name: "retried", Version: 2}, nil)
+	out.Reset()
+	require.NoError(t, apply(ctx, wfm, &out, changes))
+	assert.Equal(t, "updated changed to version 3\ncreated new version 0\nupdated retried to version 2\n", out.String())
+}
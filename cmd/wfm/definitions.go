@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-openapi/swag"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func listDefinitions(ctx context.Context, c *cli, args []string) error {
+	if _, err := parseArgs(newFlagSet("definitions"), args, 0, 0); err != nil {
+		return err
+	}
+	definitions, err := c.wfm.GetWorkflowDefinitions(ctx)
+	if err != nil {
+		return err
+	}
+	return c.printDefinitions(definitions, definitions)
+}
+
+func getDefinition(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("definition"), args, 1, 2)
+	if err != nil {
+		return err
+	}
+
+	var definition models.WorkflowDefinition
+	if len(args) == 2 {
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %s", args[1])
+		}
+		d, err := c.wfm.GetWorkflowDefinitionByNameAndVersion(ctx, &models.GetWorkflowDefinitionByNameAndVersionInput{
+			Name:    args[0],
+			Version: version,
+		})
+		if err != nil {
+			return err
+		}
+		definition = *d
+	} else {
+		definitions, err := c.wfm.GetWorkflowDefinitionVersionsByName(ctx, &models.GetWorkflowDefinitionVersionsByNameInput{
+			Name:   args[0],
+			Latest: swag.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+		if len(definitions) == 0 {
+			return fmt.Errorf("workflow definition %s not found", args[0])
+		}
+		definition = definitions[0]
+	}
+
+	// the states of a definition don't fit in a table
+	if c.output == outputTable {
+		encoded, err := yaml.Marshal(definition)
+		if err != nil {
+			return err
+		}
+		_, err = c.out.Write(encoded)
+		return err
+	}
+	return c.print(definition, nil, nil)
+}
+
+func listDefinitionVersions(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("versions"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	definitions, err := c.wfm.GetWorkflowDefinitionVersionsByName(ctx, &models.GetWorkflowDefinitionVersionsByNameInput{
+		Name:   args[0],
+		Latest: swag.Bool(false),
+	})
+	if err != nil {
+		return err
+	}
+	return c.printDefinitions(definitions, definitions)
+}
+
+func createDefinition(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("create-definition"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	req, err := readDefinition(args[0])
+	if err != nil {
+		return err
+	}
+	definition, err := c.wfm.NewWorkflowDefinition(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printDefinitions(definition, []models.WorkflowDefinition{*definition})
+}
+
+func updateDefinition(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("update-definition"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	req, err := readDefinition(args[0])
+	if err != nil {
+		return err
+	}
+	definition, err := c.wfm.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name:                         req.Name,
+		NewWorkflowDefinitionRequest: req,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printDefinitions(definition, []models.WorkflowDefinition{*definition})
+}
+
+// readDefinition reads a definition from a YAML or JSON file.
+func readDefinition(path string) (*models.NewWorkflowDefinitionRequest, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// JSON is YAML, so both are read the same way
+	contents, err = yaml.YAMLToJSON(contents)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	var req models.NewWorkflowDefinitionRequest
+	if err := json.Unmarshal(contents, &req); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return &req, nil
+}
+
+// printDefinitions prints a result with definitions, as JSON or as a table of the definitions.
+func (c *cli) printDefinitions(result interface{}, definitions []models.WorkflowDefinition) error {
+	rows := [][]string{}
+	for _, definition := range definitions {
+		states := 0
+		if definition.StateMachine != nil {
+			states = len(definition.StateMachine.States)
+		}
+		rows = append(rows, []string{
+			definition.Name, strconv.FormatInt(definition.Version, 10), strconv.Itoa(states), formatTime(definition.CreatedAt),
+		})
+	}
+	return c.print(result, []string{"NAME", "VERSION", "STATES", "CREATED"}, rows)
+}
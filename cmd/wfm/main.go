@@ -0,0 +1,126 @@
+// wfm is a command-line client for workflow-manager.
+//
+//	wfm [-url URL] [-output json|table] <command> [flags] [args]
+//
+// Run wfm without a command to list the commands.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/client"
+)
+
+// cli holds what commands need to make requests and print their results.
+type cli struct {
+	wfm    client.Client
+	out    io.Writer
+	output string
+	// pollInterval is how often watch checks on a workflow
+	pollInterval time.Duration
+}
+
+type command struct {
+	usage       string
+	description string
+	run         func(ctx context.Context, c *cli, args []string) error
+}
+
+// commands are set in init, since they refer to commands for their usage
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"start":                 {"start -definition NAME[:VERSION|@ALIAS] [-input JSON|@FILE] [-namespace NS] [-queue Q] [-tag K=V]...", "start a workflow", startWorkflow},
+		"get":                   {"get WORKFLOW_ID", "get a workflow and its jobs", getWorkflow},
+		"list":                  {"list -definition NAME [-status STATUS] [-resolved true|false] [-limit N] [-oldest-first]", "list the workflows of a definition", listWorkflows},
+		"watch":                 {"watch WORKFLOW_ID", "print the job transitions of a workflow until it's done", watchWorkflow},
+		"cancel":                {"cancel -reason REASON WORKFLOW_ID", "cancel a workflow", cancelWorkflow},
+		"resume":                {"resume -state STATE [-input JSON|@FILE] [-version N] WORKFLOW_ID", "resume a workflow from a state", resumeWorkflow},
+		"resolve":               {"resolve WORKFLOW_ID", "mark a failed workflow as resolved by a user", resolveWorkflow},
+		"definitions":           {"definitions", "list the latest version of each definition", listDefinitions},
+		"definition":            {"definition NAME [VERSION]", "get a definition, by default its latest version", getDefinition},
+		"versions":              {"versions NAME", "list the versions of a definition", listDefinitionVersions},
+		"create-definition":     {"create-definition FILE", "create a definition from a YAML or JSON file", createDefinition},
+		"update-definition":     {"update-definition FILE", "create a new version of a definition from a YAML or JSON file", updateDefinition},
+		"state-resource":        {"state-resource NAMESPACE NAME", "get a state resource", getStateResource},
+		"put-state-resource":    {"put-state-resource NAMESPACE NAME URI", "create or update a state resource", putStateResource},
+		"delete-state-resource": {"delete-state-resource NAMESPACE NAME", "delete a state resource", deleteStateResource},
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: wfm [-url URL] [-output json|table] <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "\nflags:")
+	flag.PrintDefaults()
+	fmt.Fprintln(os.Stderr, "\ncommands:")
+	names := []string{}
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-22s %s\n", name, commands[name].description)
+	}
+}
+
+func main() {
+	url := flag.String("url", os.Getenv("WORKFLOW_MANAGER_URL"), "URL of workflow-manager, found by discovery if empty")
+	output := flag.String("output", outputTable, "output format, json or table")
+	flag.Usage = usage
+	flag.Parse()
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok || (*output != outputJSON && *output != outputTable) {
+		usage()
+		os.Exit(2)
+	}
+
+	var wfm client.Client
+	if *url != "" {
+		wfm = client.New(*url)
+	} else {
+		var err error
+		if wfm, err = client.NewFromDiscovery(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+
+	c := &cli{wfm: wfm, out: os.Stdout, output: *output, pollInterval: 5 * time.Second}
+	if err := cmd.run(context.Background(), c, flag.Args()[1:]); err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(2)
+		}
+		fmt.Fprintf(os.Stderr, "%s: %s\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+// newFlagSet returns the flags of a command, which print the command's usage when they are invalid.
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: wfm %s\n", commands[name].usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// parseArgs parses the flags of a command, and checks that it has between min and max arguments.
+func parseArgs(flags *flag.FlagSet, args []string, min, max int) ([]string, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() < min || flags.NArg() > max {
+		flags.Usage()
+		return nil, flag.ErrHelp
+	}
+	return flags.Args(), nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+)
+
+// print prints a result as indented JSON, or as a table of rows with a header row.
+func (c *cli) print(result interface{}, header []string, rows [][]string) error {
+	if c.output == outputJSON {
+		encoded, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(c.out, string(encoded))
+		return err
+	}
+	c.printTable(header, rows)
+	return nil
+}
+
+func (c *cli) printTable(header []string, rows [][]string) {
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+}
+
+// formatTime formats times for tables, leaving times that aren't set empty.
+func formatTime(t strfmt.DateTime) string {
+	if time.Time(t).IsZero() {
+		return ""
+	}
+	return time.Time(t).UTC().Format(time.RFC3339)
+}
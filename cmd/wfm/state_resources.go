@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func getStateResource(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("state-resource"), args, 2, 2)
+	if err != nil {
+		return err
+	}
+	stateResource, err := c.wfm.GetStateResource(ctx, &models.GetStateResourceInput{
+		Namespace: args[0],
+		Name:      args[1],
+	})
+	if err != nil {
+		return err
+	}
+	return c.printStateResource(stateResource)
+}
+
+func putStateResource(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("put-state-resource"), args, 3, 3)
+	if err != nil {
+		return err
+	}
+	stateResource, err := c.wfm.PutStateResource(ctx, &models.PutStateResourceInput{
+		Namespace: args[0],
+		Name:      args[1],
+		NewStateResource: &models.NewStateResource{
+			Namespace: args[0],
+			Name:      args[1],
+			URI:       args[2],
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.printStateResource(stateResource)
+}
+
+func deleteStateResource(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("delete-state-resource"), args, 2, 2)
+	if err != nil {
+		return err
+	}
+	return c.wfm.DeleteStateResource(ctx, &models.DeleteStateResourceInput{
+		Namespace: args[0],
+		Name:      args[1],
+	})
+}
+
+func (c *cli) printStateResource(stateResource *models.StateResource) error {
+	return c.print(stateResource, []string{"NAMESPACE", "NAME", "TYPE", "URI", "UPDATED"}, [][]string{{
+		stateResource.Namespace, stateResource.Name, string(stateResource.Type), stateResource.URI,
+		formatTime(stateResource.LastUpdated),
+	}})
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/client"
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func newTestCLI(t *testing.T, output string) (*cli, *client.MockClient, *bytes.Buffer, func()) {
+	mockController := gomock.NewController(t)
+	wfm := client.NewMockClient(mockController)
+	out := &bytes.Buffer{}
+	return &cli{wfm: wfm, out: out, output: output}, wfm, out, mockController.Finish
+}
+
+func TestParseDefinitionRef(t *testing.T) {
+	ref, err := parseDefinitionRef("name")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkflowDefinitionRef{Name: "name", Version: -1}, *ref)
+	ref, err = parseDefinitionRef("name:3")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkflowDefinitionRef{Name: "name", Version: 3}, *ref)
+	ref, err = parseDefinitionRef("name@stable")
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkflowDefinitionRef{Name: "name", Alias: "stable", Version: -1}, *ref)
+	_, err = parseDefinitionRef("name:latest")
+	assert.Error(t, err)
+}
+
+func TestStartAndListWorkflows(t *testing.T) {
+	c, wfm, out, finish := newTestCLI(t, outputTable)
+	defer finish()
+	ctx := context.Background()
+
+	workflow := models.Workflow{WorkflowSummary: models.WorkflowSummary{
+		ID:                 "workflow-id",
+		Status:             models.WorkflowStatusQueued,
+		Namespace:          "production",
+		Queue:              "default",
+		WorkflowDefinition: &models.WorkflowDefinition{Name: "name", Version: 2},
+	}}
+	wfm.EXPECT().StartWorkflow(ctx, &models.StartWorkflowRequest{
+		WorkflowDefinition: &models.WorkflowDefinitionRef{Name: "name", Version: 2},
+		Input:              `{"a": 1}`,
+		Namespace:          "production",
+		Queue:              "default",
+		Tags:               map[string]interface{}{"team": "eng"},
+	}).Return(&workflow, nil)
+	require.NoError(t, startWorkflow(ctx, c, []string{
+		"-definition", "name:2", "-input", `{"a": 1}`, "-namespace", "production", "-tag", "team=eng",
+	}))
+	assert.Contains(t, out.String(), "ID           DEFINITION  STATUS  NAMESPACE   QUEUE    CREATED  STOPPED\n")
+	assert.Contains(t, out.String(), "workflow-id  name:2      queued  production  default")
+
+	t.Log("Lists workflows with filters")
+	c.output = outputJSON
+	out.Reset()
+	wfm.EXPECT().GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "name",
+		Limit:                  swag.Int64(10),
+		OldestFirst:            swag.Bool(false),
+		SummaryOnly:            swag.Bool(false),
+		Status:                 swag.String("failed"),
+		ResolvedByUser:         swag.Bool(false),
+	}).Return([]models.Workflow{workflow}, nil)
+	require.NoError(t, listWorkflows(ctx, c, []string{"-definition", "name", "-status", "failed", "-resolved", "false"}))
+	assert.Contains(t, out.String(), `"id": "workflow-id"`)
+
+	t.Log("Requires a definition")
+	assert.Error(t, listWorkflows(ctx, c, []string{}))
+}
+
+func TestWatchWorkflow(t *testing.T) {
+	c, wfm, out, finish := newTestCLI(t, outputTable)
+	defer finish()
+	ctx := context.Background()
+
+	poll := func(status models.WorkflowStatus, jobs ...*models.Job) *gomock.Call {
+		return wfm.EXPECT().GetWorkflowByID(ctx, "workflow-id").Return(&models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{ID: "workflow-id", Status: status},
+			Jobs:            jobs,
+		}, nil)
+	}
+	gomock.InOrder(
+		poll(models.WorkflowStatusRunning, &models.Job{ID: "1", State: "first", Status: models.JobStatusRunning}),
+		poll(models.WorkflowStatusRunning, &models.Job{ID: "1", State: "first", Status: models.JobStatusRunning}),
+		poll(models.WorkflowStatusFailed, &models.Job{ID: "1", State: "first", Status: models.JobStatusFailed}),
+	)
+	err := watchWorkflow(ctx, c, []string{"workflow-id"})
+	assert.Error(t, err, "watching fails for workflows that don't succeed")
+
+	lines := []string{}
+	for _, line := range bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n")) {
+		// drop the time of the line
+		lines = append(lines, string(bytes.SplitN(line, []byte("  "), 2)[1]))
+	}
+	assert.Equal(t, []string{
+		"first (job 1): running",
+		"workflow workflow-id: running",
+		"first (job 1): running -> failed",
+		"workflow workflow-id: failed",
+	}, lines)
+}
+
+func TestStateResources(t *testing.T) {
+	c, wfm, out, finish := newTestCLI(t, outputTable)
+	defer finish()
+	ctx := context.Background()
+
+	wfm.EXPECT().PutStateResource(ctx, &models.PutStateResourceInput{
+		Namespace: "production",
+		Name:      "worker",
+		NewStateResource: &models.NewStateResource{
+			Namespace: "production", Name: "worker", URI: "arn:aws:states:::activity:worker",
+		},
+	}).Return(&models.StateResource{Namespace: "production", Name: "worker", URI: "arn:aws:states:::activity:worker"}, nil)
+	require.NoError(t, putStateResource(ctx, c, []string{"production", "worker", "arn:aws:states:::activity:worker"}))
+	assert.Contains(t, out.String(), "production  worker")
+
+	assert.Error(t, putStateResource(ctx, c, []string{"production", "worker"}), "the URI is required")
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/swag"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+)
+
+// tagFlags are repeated K=V flags.
+type tagFlags map[string]interface{}
+
+func (t tagFlags) String() string {
+	tags := []string{}
+	for k, v := range t {
+		tags = append(tags, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(tags)
+	return strings.Join(tags, ",")
+}
+
+func (t tagFlags) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("tags must be K=V, not %s", value)
+	}
+	t[parts[0]] = parts[1]
+	return nil
+}
+
+// parseDefinitionRef parses NAME, NAME:VERSION or NAME@ALIAS. A NAME alone refers to the latest version.
+func parseDefinitionRef(ref string) (*models.WorkflowDefinitionRef, error) {
+	if i := strings.Index(ref, "@"); i >= 0 {
+		return &models.WorkflowDefinitionRef{Name: ref[:i], Alias: ref[i+1:], Version: -1}, nil
+	}
+	if i := strings.Index(ref, ":"); i >= 0 {
+		version, err := strconv.ParseInt(ref[i+1:], 10, 64)
+		if err != nil || version < 0 {
+			return nil, fmt.Errorf("invalid version in %s", ref)
+		}
+		return &models.WorkflowDefinitionRef{Name: ref[:i], Version: version}, nil
+	}
+	return &models.WorkflowDefinitionRef{Name: ref, Version: -1}, nil
+}
+
+// readInput reads an input given as JSON, or as @FILE to read it from a file.
+func readInput(input string) (string, error) {
+	if !strings.HasPrefix(input, "@") {
+		return input, nil
+	}
+	contents, err := ioutil.ReadFile(input[1:])
+	return string(contents), err
+}
+
+func startWorkflow(ctx context.Context, c *cli, args []string) error {
+	flags := newFlagSet("start")
+	definition := flags.String("definition", "", "definition to start, as NAME, NAME:VERSION or NAME@ALIAS")
+	input := flags.String("input", "", "input of the workflow, as JSON or @FILE")
+	namespace := flags.String("namespace", "", "namespace of the workflow's resources")
+	queue := flags.String("queue", "default", "queue of the workflow")
+	tags := tagFlags{}
+	flags.Var(tags, "tag", "tag of the workflow as K=V, can be repeated")
+	if _, err := parseArgs(flags, args, 0, 0); err != nil {
+		return err
+	}
+	if *definition == "" {
+		flags.Usage()
+		return fmt.Errorf("-definition is required")
+	}
+
+	ref, err := parseDefinitionRef(*definition)
+	if err != nil {
+		return err
+	}
+	workflowInput, err := readInput(*input)
+	if err != nil {
+		return err
+	}
+	req := &models.StartWorkflowRequest{
+		WorkflowDefinition: ref,
+		Input:              workflowInput,
+		Namespace:          *namespace,
+		Queue:              *queue,
+	}
+	if len(tags) > 0 {
+		req.Tags = tags
+	}
+	workflow, err := c.wfm.StartWorkflow(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.printWorkflows(workflow, []models.Workflow{*workflow})
+}
+
+func getWorkflow(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("get"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	workflow, err := c.wfm.GetWorkflowByID(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	if c.output == outputJSON {
+		return c.print(workflow, nil, nil)
+	}
+
+	if err := c.printWorkflows(workflow, []models.Workflow{*workflow}); err != nil {
+		return err
+	}
+	if workflow.StatusReason != "" {
+		fmt.Fprintf(c.out, "\nreason: %s\n", workflow.StatusReason)
+	}
+	fmt.Fprintln(c.out)
+	rows := [][]string{}
+	for _, job := range workflow.Jobs {
+		rows = append(rows, []string{
+			job.State, job.ID, string(job.Status), strconv.Itoa(len(job.Attempts) + 1),
+			formatTime(job.StartedAt), formatTime(job.StoppedAt), job.StatusReason,
+		})
+	}
+	c.printTable([]string{"STATE", "JOB", "STATUS", "ATTEMPTS", "STARTED", "STOPPED", "REASON"}, rows)
+	return nil
+}
+
+func listWorkflows(ctx context.Context, c *cli, args []string) error {
+	flags := newFlagSet("list")
+	definition := flags.String("definition", "", "name of the definition whose workflows are listed")
+	status := flags.String("status", "", "only list workflows with this status")
+	resolved := flags.String("resolved", "", "only list workflows that are (true) or aren't (false) resolved by a user")
+	limit := flags.Int64("limit", 10, "maximum number of workflows to list")
+	oldestFirst := flags.Bool("oldest-first", false, "list the oldest workflows first")
+	if _, err := parseArgs(flags, args, 0, 0); err != nil {
+		return err
+	}
+	if *definition == "" {
+		flags.Usage()
+		return fmt.Errorf("-definition is required")
+	}
+
+	input := &models.GetWorkflowsInput{
+		WorkflowDefinitionName: *definition,
+		Limit:                  limit,
+		OldestFirst:            oldestFirst,
+		// the table doesn't need the jobs of the workflows
+		SummaryOnly: swag.Bool(c.output == outputTable),
+	}
+	if *status != "" {
+		input.Status = status
+	}
+	if *resolved != "" {
+		resolvedByUser, err := strconv.ParseBool(*resolved)
+		if err != nil {
+			return fmt.Errorf("-resolved must be true or false")
+		}
+		input.ResolvedByUser = &resolvedByUser
+	}
+	workflows, err := c.wfm.GetWorkflows(ctx, input)
+	if err != nil {
+		return err
+	}
+	return c.printWorkflows(workflows, workflows)
+}
+
+// watchWorkflow polls a workflow until it's done, printing the transitions of its jobs. It fails
+// if the workflow doesn't succeed, so that scripts can wait on workflows.
+func watchWorkflow(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("watch"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	var status models.WorkflowStatus
+	jobStatuses := map[string]models.JobStatus{}
+	for {
+		workflow, err := c.wfm.GetWorkflowByID(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		for _, job := range workflow.Jobs {
+			if previous, ok := jobStatuses[job.ID]; !ok || previous != job.Status {
+				transition := string(job.Status)
+				if ok {
+					transition = fmt.Sprintf("%s -> %s", previous, job.Status)
+				}
+				fmt.Fprintf(c.out, "%s  %s (job %s): %s\n", now, job.State, job.ID, transition)
+				jobStatuses[job.ID] = job.Status
+			}
+		}
+		if workflow.Status != status {
+			fmt.Fprintf(c.out, "%s  workflow %s: %s\n", now, workflow.ID, workflow.Status)
+			status = workflow.Status
+		}
+
+		if resources.WorkflowIsDone(workflow) {
+			if workflow.Status != models.WorkflowStatusSucceeded {
+				return fmt.Errorf("workflow %s %s: %s", workflow.ID, workflow.Status, workflow.StatusReason)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+func cancelWorkflow(ctx context.Context, c *cli, args []string) error {
+	flags := newFlagSet("cancel")
+	reason := flags.String("reason", "", "why the workflow is cancelled")
+	args, err := parseArgs(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *reason == "" {
+		flags.Usage()
+		return fmt.Errorf("-reason is required")
+	}
+	return c.wfm.CancelWorkflow(ctx, &models.CancelWorkflowInput{
+		WorkflowID: args[0],
+		Reason:     &models.CancelReason{Reason: *reason},
+	})
+}
+
+func resumeWorkflow(ctx context.Context, c *cli, args []string) error {
+	flags := newFlagSet("resume")
+	state := flags.String("state", "", "state to resume the workflow from")
+	input := flags.String("input", "", "input of the state as JSON or @FILE, by default the input it had")
+	version := flags.Int64("version", -1, "version of the definition to resume on, by default the workflow's version")
+	args, err := parseArgs(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *state == "" {
+		flags.Usage()
+		return fmt.Errorf("-state is required")
+	}
+
+	stateInput, err := readInput(*input)
+	if err != nil {
+		return err
+	}
+	overrides := &models.WorkflowDefinitionOverrides{StartAt: *state, Input: stateInput}
+	if *version >= 0 {
+		overrides.WorkflowDefinitionVersion = version
+	}
+	workflow, err := c.wfm.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: args[0],
+		Overrides:  overrides,
+	})
+	if err != nil {
+		return err
+	}
+	return c.printWorkflows(workflow, []models.Workflow{*workflow})
+}
+
+func resolveWorkflow(ctx context.Context, c *cli, args []string) error {
+	args, err := parseArgs(newFlagSet("resolve"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+	return c.wfm.ResolveWorkflowByID(ctx, args[0])
+}
+
+// printWorkflows prints a result with workflows, as JSON or as a table of the workflows.
+func (c *cli) printWorkflows(result interface{}, workflows []models.Workflow) error {
+	rows := [][]string{}
+	for _, workflow := range workflows {
+		definition := ""
+		if workflow.WorkflowDefinition != nil {
+			definition = fmt.Sprintf("%s:%d", workflow.WorkflowDefinition.Name, workflow.WorkflowDefinition.Version)
+		}
+		rows = append(rows, []string{
+			workflow.ID, definition, string(workflow.Status), workflow.Namespace, workflow.Queue,
+			formatTime(workflow.CreatedAt), formatTime(workflow.StoppedAt),
+		})
+	}
+	return c.print(result, []string{"ID", "DEFINITION", "STATUS", "NAMESPACE", "QUEUE", "CREATED", "STOPPED"}, rows)
+}
@@ -8,10 +8,12 @@ import (
 	"math/rand"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Clever/workflow-manager/embedded/sfnfunction"
 	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/executor/sfnhistory"
 	"github.com/Clever/workflow-manager/gen-go/client"
 	"github.com/Clever/workflow-manager/gen-go/models"
 	"github.com/Clever/workflow-manager/resources"
@@ -28,15 +30,26 @@ import (
 
 // Embedded ...
 type Embedded struct {
-	environment         string
-	app                 string
-	sfnAccountID        string
-	sfnRegion           string
-	sfnRoleArn          string
-	sfnAPI              sfniface.SFNAPI
-	resources           map[string]*sfnfunction.Resource
+	environment   string
+	app           string
+	sfnAccountID  string
+	sfnRegion     string
+	sfnRoleArn    string
+	sfnAPI        sfniface.SFNAPI
+	resources     map[string]*sfnfunction.Resource
+	workerName    string
+	workerVersion string
+	registry      client.Client
+	pollers       map[string]PollerOptions
+
+	// mu guards the state that the embedded workflow-manager keeps in memory
+	mu sync.RWMutex
+	// workflowDefinitions holds the latest version of each workflow definition
 	workflowDefinitions []models.WorkflowDefinition
-	workerName          string
+	// workflowDefinitionVersions holds every version of each workflow definition, oldest first
+	workflowDefinitionVersions map[string][]models.WorkflowDefinition
+	stateResources             map[string]models.StateResource
+	resolvedWorkflows          map[string]bool
 }
 
 var _ client.Client = &Embedded{}
@@ -52,6 +65,13 @@ type Config struct {
 	Resources           map[string]interface{}
 	WorkflowDefinitions []byte
 	WorkerName          string
+	// Registry, if set, is the workflow-manager that PollForWork registers and heartbeats the
+	// worker to, so that it shows up in the workers of its resources.
+	Registry      client.Client
+	WorkerVersion string
+	// Pollers configure how PollForWork polls for the tasks of each resource, by resource name.
+	// Resources without options poll for and process one task at a time.
+	Pollers map[string]PollerOptions
 }
 
 func (c Config) validate() error {
@@ -73,6 +93,14 @@ func (c Config) validate() error {
 	if c.Resources == nil {
 		return errors.New("must configure resources")
 	}
+	for resourceName, options := range c.Pollers {
+		if _, ok := c.Resources[resourceName]; !ok {
+			return fmt.Errorf("pollers configured for unknown resource '%s'", resourceName)
+		}
+		if err := options.validate(); err != nil {
+			return fmt.Errorf("pollers of resource '%s': %s", resourceName, err)
+		}
+	}
 	return nil
 }
 
@@ -108,16 +136,26 @@ func New(config *Config) (*Embedded, error) {
 		hn, _ := os.Hostname()
 		wn = fmt.Sprintf("%s-%s-%s", an, hn, randString(5))
 	}
+	versions := map[string][]models.WorkflowDefinition{}
+	for _, wfdef := range wfdefs {
+		versions[wfdef.Name] = []models.WorkflowDefinition{wfdef}
+	}
 	return &Embedded{
-		environment:         config.Environment,
-		app:                 config.App,
-		sfnAccountID:        config.SFNAccountID,
-		sfnRegion:           config.SFNRegion,
-		sfnRoleArn:          config.SFNRoleArn,
-		sfnAPI:              config.SFNAPI,
-		resources:           r,
-		workflowDefinitions: wfdefs,
-		workerName:          wn,
+		environment:                config.Environment,
+		app:                        config.App,
+		sfnAccountID:               config.SFNAccountID,
+		sfnRegion:                  config.SFNRegion,
+		sfnRoleArn:                 config.SFNRoleArn,
+		sfnAPI:                     config.SFNAPI,
+		resources:                  r,
+		workerName:                 wn,
+		workerVersion:              config.WorkerVersion,
+		registry:                   config.Registry,
+		pollers:                    config.Pollers,
+		workflowDefinitions:        wfdefs,
+		workflowDefinitionVersions: versions,
+		stateResources:             map[string]models.StateResource{},
+		resolvedWorkflows:          map[string]bool{},
 	}, nil
 }
 
@@ -224,68 +262,20 @@ func (e *Embedded) HealthCheck(ctx context.Context) error {
 
 // GetWorkflowDefinitions ...
 func (e *Embedded) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
-	return e.workflowDefinitions, nil
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return append([]models.WorkflowDefinition{}, e.workflowDefinitions...), nil
 }
 
 // GetWorkflowDefinitionByNameAndVersion ...
 func (e *Embedded) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
-	for _, wd := range e.workflowDefinitions {
-		if wd.Name == i.Name {
-			return &wd, nil
-		}
-	}
-	return nil, models.NotFound{}
-}
-
-// GetWorkflows ...
-func (e *Embedded) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, error) {
-	var validation error
-	if i.Limit != nil {
-		validation = multierror.Append(validation, errors.New("Limit not supported"))
-	}
-	if i.OldestFirst != nil {
-		validation = multierror.Append(validation, errors.New("OldestFirst not supported"))
-	}
-	if i.PageToken != nil {
-		validation = multierror.Append(validation, errors.New("PageToken not supported"))
-	}
-	if i.Status != nil {
-		validation = multierror.Append(validation, errors.New("Status not supported"))
-	}
-	if i.ResolvedByUser != nil {
-		validation = multierror.Append(validation, errors.New("ResolvedByUser not supported"))
-	}
-	if i.SummaryOnly != nil {
-		validation = multierror.Append(validation, errors.New("SummaryOnly not supported"))
-	}
-	if validation != nil {
-		return nil, validation
-	}
-
-	wd, err := e.GetWorkflowDefinitionByNameAndVersion(ctx, &models.GetWorkflowDefinitionByNameAndVersionInput{Name: i.WorkflowDefinitionName})
-	if err != nil {
-		return nil, err
-	}
-	smArn := sfnconventions.StateMachineArn(e.sfnRegion, e.sfnAccountID, wd.Name, wd.Version, e.environment, wd.StateMachine.StartAt)
-	out, err := e.sfnAPI.ListExecutionsWithContext(ctx, &sfn.ListExecutionsInput{
-		StateMachineArn: aws.String(smArn),
-	})
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	wd, err := e.workflowDefinitionVersion(i.Name, i.Version)
 	if err != nil {
 		return nil, err
 	}
-	wfs := []models.Workflow{}
-	for _, e := range out.Executions {
-		wfs = append(wfs, models.Workflow{
-			WorkflowSummary: models.WorkflowSummary{
-				ID:                 aws.StringValue(e.Name),
-				CreatedAt:          strfmt.DateTime(aws.TimeValue(e.StartDate)),
-				Status:             resources.SFNStatusToWorkflowStatus(aws.StringValue(e.Status)),
-				StoppedAt:          strfmt.DateTime(aws.TimeValue(e.StopDate)),
-				WorkflowDefinition: wd,
-			},
-		})
-	}
-	return wfs, nil
+	return &wd, nil
 }
 
 // StartWorkflow ...
@@ -307,28 +297,64 @@ func (e *Embedded) StartWorkflow(ctx context.Context, i *models.StartWorkflowReq
 		return nil, validation
 	}
 
-	// generate state machine
-	wd, err := e.GetWorkflowDefinitionByNameAndVersion(ctx, &models.GetWorkflowDefinitionByNameAndVersionInput{Name: i.WorkflowDefinition.Name})
+	wd, err := e.latestWorkflowDefinition(i.WorkflowDefinition.Name)
 	if err != nil {
 		return nil, fmt.Errorf("GetWorkflowDefinitionByNameAndVersion: %s", err.Error())
 	}
+	stateMachineName, stateMachineArn, err := e.describeOrCreateStateMachine(ctx, wd, i.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// start execution!
+	var inputJSON interface{}
+	if err := json.Unmarshal([]byte(i.Input), &inputJSON); err != nil {
+		return nil, models.BadRequest{
+			Message: fmt.Sprintf("input is not a valid JSON object: %s ", err),
+		}
+	}
+	workflow := &models.Workflow{
+		WorkflowSummary: models.WorkflowSummary{
+			ID:                 workflowID(stateMachineName),
+			CreatedAt:          strfmt.DateTime(time.Now()),
+			LastUpdated:        strfmt.DateTime(time.Now()),
+			WorkflowDefinition: &wd,
+			Status:             models.WorkflowStatusQueued,
+			Namespace:          i.Namespace,
+			Input:              i.Input,
+		},
+	}
+	if _, err := e.sfnAPI.StartExecutionWithContext(ctx, &sfn.StartExecutionInput{
+		StateMachineArn: aws.String(stateMachineArn),
+		Input:           aws.String(i.Input),
+		Name:            aws.String(workflow.ID),
+	}); err != nil {
+		return nil, fmt.Errorf("StartExecution: %s", err.Error())
+	}
+	return workflow, nil
+}
+
+// describeOrCreateStateMachine finds or creates the state machine of a workflow definition in a
+// namespace, returning its name and ARN.
+func (e *Embedded) describeOrCreateStateMachine(ctx context.Context, wd models.WorkflowDefinition, namespace string) (string, string, error) {
+	// generate state machine
 	stateMachine := deepcopy.Copy(wd.StateMachine).(*models.SLStateMachine)
 	for stateName, s := range stateMachine.States {
 		state := deepcopy.Copy(s).(models.SLState)
 		if state.Type != models.SLStateTypeTask {
 			continue
 		}
-		state.Resource = sfnconventions.EmbeddedResourceArn(state.Resource, e.sfnRegion, e.sfnAccountID, i.Namespace, e.app)
+		state.Resource = sfnconventions.EmbeddedResourceArn(state.Resource, e.sfnRegion, e.sfnAccountID, namespace, e.app)
 		stateMachine.States[stateName] = state
 	}
 	stateMachineDefBytes, err := json.MarshalIndent(stateMachine, "", "  ")
 	if err != nil {
-		return nil, fmt.Errorf("json marshal: %s", err.Error())
+		return "", "", fmt.Errorf("json marshal: %s", err.Error())
 	}
 
 	// find or create the state machine in AWS
-	stateMachineName := sfnconventions.StateMachineName(wd.Name, wd.Version, i.Namespace, wd.StateMachine.StartAt)
-	stateMachineArn := sfnconventions.StateMachineArn(e.sfnRegion, e.sfnAccountID, wd.Name, wd.Version, i.Namespace, wd.StateMachine.StartAt)
+	stateMachineName := sfnconventions.StateMachineName(wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)
+	stateMachineArn := sfnconventions.StateMachineArn(e.sfnRegion, e.sfnAccountID, wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)
 	out, err := e.sfnAPI.DescribeStateMachineWithContext(ctx, &sfn.DescribeStateMachineInput{
 		StateMachineArn: aws.String(stateMachineArn),
 	})
@@ -340,10 +366,10 @@ func (e *Embedded) StartWorkflow(ctx context.Context, i *models.StartWorkflowReq
 				Definition: aws.String(string(stateMachineDefBytes)),
 				RoleArn:    aws.String(e.sfnRoleArn),
 			}); err != nil {
-				return nil, fmt.Errorf("CreateStateMachine error: %s", err.Error())
+				return "", "", fmt.Errorf("CreateStateMachine error: %s", err.Error())
 			}
 		} else {
-			return nil, err
+			return "", "", err
 		}
 	} else if wd.Version == -1 /* state machine already exists and allows mutation (version == -1) */ {
 		if _, err := e.sfnAPI.UpdateStateMachine(&sfn.UpdateStateMachineInput{
@@ -351,7 +377,7 @@ func (e *Embedded) StartWorkflow(ctx context.Context, i *models.StartWorkflowReq
 			RoleArn:         aws.String(e.sfnRoleArn),
 			StateMachineArn: out.StateMachineArn,
 		}); err != nil {
-			return nil, fmt.Errorf("UpdateStateMachine: %s", err.Error())
+			return "", "", fmt.Errorf("UpdateStateMachine: %s", err.Error())
 		}
 		// Control for "Executions started immediately after calling UpdateStateMachine might use the previous state machine definition and roleArn."
 		// https://docs.aws.amazon.com/step-functions/latest/dg/concepts-read-consistency.html
@@ -362,10 +388,10 @@ func (e *Embedded) StartWorkflow(ctx context.Context, i *models.StartWorkflowReq
 		// a new name
 		var existingStateMachine models.SLStateMachine
 		if err := json.Unmarshal([]byte(aws.StringValue(out.Definition)), &existingStateMachine); err != nil {
-			return nil, err
+			return "", "", err
 		}
 		if *out.Definition != string(stateMachineDefBytes) {
-			return nil, fmt.Errorf(`existing state machine differs from new state machine.
+			return "", "", fmt.Errorf(`existing state machine differs from new state machine.
 State machines are immutable. Please rename the state machine or set version to -1 to allow mutation. Existing state machine:
 %s
 New state machine:
@@ -373,32 +399,7 @@ New state machine:
 		}
 	}
 
-	// start execution!
-	var inputJSON interface{}
-	if err := json.Unmarshal([]byte(i.Input), &inputJSON); err != nil {
-		return nil, models.BadRequest{
-			Message: fmt.Sprintf("input is not a valid JSON object: %s ", err),
-		}
-	}
-	workflow := &models.Workflow{
-		WorkflowSummary: models.WorkflowSummary{
-			ID:                 workflowID(stateMachineName),
-			CreatedAt:          strfmt.DateTime(time.Now()),
-			LastUpdated:        strfmt.DateTime(time.Now()),
-			WorkflowDefinition: wd,
-			Status:             models.WorkflowStatusQueued,
-			Namespace:          i.Namespace,
-			Input:              i.Input,
-		},
-	}
-	if _, err := e.sfnAPI.StartExecutionWithContext(ctx, &sfn.StartExecutionInput{
-		StateMachineArn: aws.String(stateMachineArn),
-		Input:           aws.String(i.Input),
-		Name:            aws.String(workflow.ID),
-	}); err != nil {
-		return nil, fmt.Errorf("StartExecution: %s", err.Error())
-	}
-	return workflow, nil
+	return stateMachineName, stateMachineArn, nil
 }
 
 // CancelWorkflow ...
@@ -429,18 +430,20 @@ func (e *Embedded) GetWorkflowByID(ctx context.Context, workflowID string) (*mod
 		return nil, err
 	}
 	wd, err := e.GetWorkflowDefinitionByNameAndVersion(ctx, &models.GetWorkflowDefinitionByNameAndVersionInput{
-		Name: smNameParts.WDName,
+		Name:    smNameParts.WDName,
+		Version: smNameParts.WDVersion,
 	})
 	if err != nil {
 		return nil, err
 	}
+	executionArn := sfnconventions.ExecutionArn(e.sfnRegion, e.sfnAccountID, smName, workflowID)
 	out, err := e.sfnAPI.DescribeExecutionWithContext(ctx, &sfn.DescribeExecutionInput{
-		ExecutionArn: aws.String(sfnconventions.ExecutionArn(e.sfnRegion, e.sfnAccountID, smName, workflowID)),
+		ExecutionArn: aws.String(executionArn),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &models.Workflow{
+	workflow := &models.Workflow{
 		Output: aws.StringValue(out.Output),
 		WorkflowSummary: models.WorkflowSummary{
 			ID:                 workflowID,
@@ -450,14 +453,36 @@ func (e *Embedded) GetWorkflowByID(ctx context.Context, workflowID string) (*mod
 			Status:             resources.SFNStatusToWorkflowStatus(aws.StringValue(out.Status)),
 			Namespace:          smNameParts.Namespace,
 			Input:              aws.StringValue(out.Input),
+			ResolvedByUser:     e.isResolved(workflowID),
 		},
-	}, nil
+	}
+	if out.StopDate != nil {
+		workflow.StoppedAt = strfmt.DateTime(aws.TimeValue(out.StopDate))
+	}
+
+	// parse jobs from the execution history the same way workflow-manager does
+	parser := sfnhistory.NewParser(workflow, func(arn, resource string) models.StateResourceSource {
+		// embedded Task states always run on the application's own functions
+		return models.StateResourceSourceConvention
+	})
+	if err := e.sfnAPI.GetExecutionHistoryPagesWithContext(ctx, &sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionArn),
+	}, func(page *sfn.GetExecutionHistoryOutput, lastPage bool) bool {
+		parser.AddEvents(page.Events)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	workflow.Jobs = parser.Jobs()
+	return workflow, nil
 }
 
 // NewWorkflowDefinition creates a new workflow definition.
 func (e *Embedded) NewWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
-	if e.workflowDefinitions == nil {
-		e.workflowDefinitions = []models.WorkflowDefinition{}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.workflowDefinitionVersions == nil {
+		e.workflowDefinitionVersions = map[string][]models.WorkflowDefinition{}
 	}
 	for _, wfd := range e.workflowDefinitions {
 		if wfd.Name == i.Name {
@@ -465,16 +490,15 @@ func (e *Embedded) NewWorkflowDefinition(ctx context.Context, i *models.NewWorkf
 		}
 	}
 
-	wfd := models.WorkflowDefinition{
-		CreatedAt:    strfmt.DateTime(time.Now()),
-		DefaultTags:  i.DefaultTags,
-		Name:         i.Name,
-		StateMachine: i.StateMachine,
+	wfd, err := newWorkflowDefinition(i)
+	if err != nil {
+		return nil, err
 	}
 	if err := validateWorkflowDefinition(wfd, e.resources); err != nil {
 		return nil, fmt.Errorf("could not validate state machine: %s", err)
 	}
 	e.workflowDefinitions = append(e.workflowDefinitions, wfd)
+	e.workflowDefinitionVersions[wfd.Name] = []models.WorkflowDefinition{wfd}
 	return &wfd, nil
 }
 
@@ -0,0 +1,333 @@
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/gen-go/client"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/go-openapi/strfmt"
+)
+
+// defaultGetWorkflowsLimit matches the default limit of GET /workflows.
+const defaultGetWorkflowsLimit = 10
+
+// executionStatuses maps a workflow status to the SFN execution statuses it's listed by. Executions
+// start as soon as they're created, so embedded workflows are never queued.
+var executionStatuses = map[models.WorkflowStatus][]string{
+	models.WorkflowStatusQueued:    {},
+	models.WorkflowStatusRunning:   {sfn.ExecutionStatusRunning},
+	models.WorkflowStatusSucceeded: {sfn.ExecutionStatusSucceeded},
+	models.WorkflowStatusFailed:    {sfn.ExecutionStatusFailed, sfn.ExecutionStatusTimedOut},
+	models.WorkflowStatusCancelled: {sfn.ExecutionStatusAborted},
+}
+
+// GetWorkflows lists the workflows of a workflow definition, newest first, across all of its
+// versions and the state machines resumed workflows run on. To get the next page, set PageToken to
+// the ID of the last workflow of a page.
+func (e *Embedded) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, error) {
+	limit := int64(defaultGetWorkflowsLimit)
+	if i.Limit != nil {
+		limit = *i.Limit
+	}
+	lister, err := e.newWorkflowLister(ctx, i, limit)
+	if err != nil {
+		return nil, err
+	}
+	workflows := []models.Workflow{}
+	for int64(len(workflows)) < limit {
+		workflow, err := lister.next()
+		if err != nil {
+			return nil, err
+		}
+		if workflow == nil {
+			break
+		}
+		workflows = append(workflows, *workflow)
+	}
+	return workflows, nil
+}
+
+// NewGetWorkflowsIter returns an iterator over the workflows of a workflow definition, listing
+// Limit executions of each state machine at a time.
+func (e *Embedded) NewGetWorkflowsIter(ctx context.Context, i *models.GetWorkflowsInput) (client.GetWorkflowsIter, error) {
+	lister, err := e.newWorkflowLister(ctx, i, aws.Int64Value(i.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &getWorkflowsIter{lister: lister}, nil
+}
+
+type getWorkflowsIter struct {
+	lister *workflowLister
+	err    error
+}
+
+// Next assigns the next workflow to v. It returns false once there are no workflows left or
+// listing fails.
+func (i *getWorkflowsIter) Next(v *models.Workflow) bool {
+	if i.err != nil {
+		return false
+	}
+	workflow, err := i.lister.next()
+	if err != nil {
+		i.err = err
+		return false
+	}
+	if workflow == nil {
+		return false
+	}
+	*v = *workflow
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *getWorkflowsIter) Err() error {
+	return i.err
+}
+
+// executionSource pages through the executions of one state machine with one status.
+type executionSource struct {
+	wd        models.WorkflowDefinition
+	namespace string
+	input     *sfn.ListExecutionsInput
+	page      []*sfn.ExecutionListItem
+	done      bool
+}
+
+// head returns the newest execution of the source that hasn't been listed yet, or nil once the
+// source is exhausted.
+func (s *executionSource) head(ctx context.Context, sfnAPI sfniface.SFNAPI) (*sfn.ExecutionListItem, error) {
+	for len(s.page) == 0 && !s.done {
+		out, err := sfnAPI.ListExecutionsWithContext(ctx, s.input)
+		if err != nil {
+			return nil, err
+		}
+		s.page = out.Executions
+		s.input.NextToken = out.NextToken
+		s.done = out.NextToken == nil
+	}
+	if len(s.page) == 0 {
+		return nil, nil
+	}
+	return s.page[0], nil
+}
+
+// workflowLister merges the executions of a workflow definition's state machines into a single
+// list, newest first.
+type workflowLister struct {
+	e       *Embedded
+	ctx     context.Context
+	input   *models.GetWorkflowsInput
+	sources []*executionSource
+	// cursor is the execution of PageToken. Executions up to and including it are skipped.
+	cursor *sfn.ExecutionListItem
+	// oldestFirst holds every execution, oldest first, once drained for OldestFirst.
+	oldestFirst []listedExecution
+	drained     bool
+}
+
+// listedExecution is an execution in a list, along with the source that listed it.
+type listedExecution struct {
+	source    *executionSource
+	execution *sfn.ExecutionListItem
+}
+
+func (e *Embedded) newWorkflowLister(ctx context.Context, i *models.GetWorkflowsInput, pageSize int64) (*workflowLister, error) {
+	wd, err := e.latestWorkflowDefinition(i.WorkflowDefinitionName)
+	if err != nil {
+		return nil, err
+	}
+	statuses := []string{""}
+	if i.Status != nil && *i.Status != "" {
+		var ok bool
+		if statuses, ok = executionStatuses[models.WorkflowStatus(*i.Status)]; !ok {
+			return nil, models.BadRequest{Message: fmt.Sprintf("invalid status %s", *i.Status)}
+		}
+	}
+
+	// state machine names replace characters SFN doesn't allow, so compare normalized names
+	normalized, err := sfnconventions.StateMachineNameParts(sfnconventions.StateMachineName(wd.Name, 0, e.environment, "start"))
+	if err != nil {
+		return nil, err
+	}
+	l := &workflowLister{e: e, ctx: ctx, input: i}
+	if err := e.sfnAPI.ListStateMachinesPagesWithContext(ctx, &sfn.ListStateMachinesInput{}, func(page *sfn.ListStateMachinesOutput, lastPage bool) bool {
+		for _, stateMachine := range page.StateMachines {
+			parts, err := sfnconventions.StateMachineNameParts(aws.StringValue(stateMachine.Name))
+			if err != nil || parts.WDName != normalized.WDName || parts.Namespace != normalized.Namespace {
+				continue
+			}
+			e.mu.RLock()
+			version, err := e.workflowDefinitionVersion(wd.Name, parts.WDVersion)
+			e.mu.RUnlock()
+			if err != nil {
+				// versions are tracked in memory, so ones from before a restart are unknown
+				version = wd
+			}
+			for _, status := range statuses {
+				input := &sfn.ListExecutionsInput{StateMachineArn: stateMachine.StateMachineArn}
+				if status != "" {
+					input.StatusFilter = aws.String(status)
+				}
+				if pageSize > 0 {
+					input.MaxResults = aws.Int64(pageSize)
+				}
+				l.sources = append(l.sources, &executionSource{wd: version, namespace: parts.Namespace, input: input})
+			}
+		}
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(l.sources, func(a, b int) bool {
+		arnA, arnB := aws.StringValue(l.sources[a].input.StateMachineArn), aws.StringValue(l.sources[b].input.StateMachineArn)
+		if arnA != arnB {
+			return arnA < arnB
+		}
+		return aws.StringValue(l.sources[a].input.StatusFilter) < aws.StringValue(l.sources[b].input.StatusFilter)
+	})
+
+	if i.PageToken != nil && *i.PageToken != "" {
+		if l.cursor, err = e.pageTokenExecution(ctx, *i.PageToken); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// pageTokenExecution describes the execution of the workflow a page token refers to.
+func (e *Embedded) pageTokenExecution(ctx context.Context, pageToken string) (*sfn.ExecutionListItem, error) {
+	widParts, err := parseWorkflowID(pageToken)
+	if err != nil {
+		return nil, models.BadRequest{Message: fmt.Sprintf("invalid page token: %s", err)}
+	}
+	out, err := e.sfnAPI.DescribeExecutionWithContext(ctx, &sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(sfnconventions.ExecutionArn(e.sfnRegion, e.sfnAccountID, widParts.SMName, pageToken)),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == sfn.ErrCodeExecutionDoesNotExist {
+			return nil, models.BadRequest{Message: fmt.Sprintf("invalid page token: %s", pageToken)}
+		}
+		return nil, err
+	}
+	return &sfn.ExecutionListItem{Name: aws.String(pageToken), StartDate: out.StartDate}, nil
+}
+
+// newerExecution orders executions newest first, by start date and then name.
+func newerExecution(a, b *sfn.ExecutionListItem) bool {
+	startA, startB := aws.TimeValue(a.StartDate), aws.TimeValue(b.StartDate)
+	if !startA.Equal(startB) {
+		return startA.After(startB)
+	}
+	return aws.StringValue(a.Name) > aws.StringValue(b.Name)
+}
+
+// next returns the next workflow, or nil once there are none left.
+func (l *workflowLister) next() (*models.Workflow, error) {
+	if !aws.BoolValue(l.input.OldestFirst) {
+		listed, err := l.nextNewest()
+		if err != nil || listed == nil {
+			return nil, err
+		}
+		return l.workflow(*listed)
+	}
+	if !l.drained {
+		// SFN only lists executions newest first, so list all of them and reverse the order.
+		// Workflows are only loaded for the executions that are returned.
+		for {
+			listed, err := l.nextNewest()
+			if err != nil {
+				return nil, err
+			}
+			if listed == nil {
+				break
+			}
+			l.oldestFirst = append(l.oldestFirst, *listed)
+		}
+		for i, j := 0, len(l.oldestFirst)-1; i < j; i, j = i+1, j-1 {
+			l.oldestFirst[i], l.oldestFirst[j] = l.oldestFirst[j], l.oldestFirst[i]
+		}
+		l.drained = true
+	}
+	if len(l.oldestFirst) == 0 {
+		return nil, nil
+	}
+	listed := l.oldestFirst[0]
+	l.oldestFirst = l.oldestFirst[1:]
+	return l.workflow(listed)
+}
+
+// nextNewest returns the next execution in newest first order, or nil once there are none left.
+func (l *workflowLister) nextNewest() (*listedExecution, error) {
+	for {
+		var newest *executionSource
+		var newestExecution *sfn.ExecutionListItem
+		for _, source := range l.sources {
+			execution, err := source.head(l.ctx, l.e.sfnAPI)
+			if err != nil {
+				return nil, err
+			}
+			if execution != nil && (newestExecution == nil || newerExecution(execution, newestExecution)) {
+				newest, newestExecution = source, execution
+			}
+		}
+		if newest == nil {
+			return nil, nil
+		}
+		newest.page = newest.page[1:]
+
+		if l.cursor != nil && !l.pastCursor(newestExecution) {
+			continue
+		}
+		if l.input.ResolvedByUser != nil && l.e.isResolved(aws.StringValue(newestExecution.Name)) != *l.input.ResolvedByUser {
+			continue
+		}
+		return &listedExecution{source: newest, execution: newestExecution}, nil
+	}
+}
+
+// workflow returns the workflow of a listed execution, loading all of its details unless
+// SummaryOnly is set.
+func (l *workflowLister) workflow(listed listedExecution) (*models.Workflow, error) {
+	if aws.BoolValue(l.input.SummaryOnly) {
+		workflow := l.e.executionToWorkflow(listed.source.wd, listed.source.namespace, listed.execution)
+		return &workflow, nil
+	}
+	return l.e.GetWorkflowByID(l.ctx, aws.StringValue(listed.execution.Name))
+}
+
+// pastCursor returns whether an execution comes after the page token's execution.
+func (l *workflowLister) pastCursor(execution *sfn.ExecutionListItem) bool {
+	if aws.BoolValue(l.input.OldestFirst) {
+		return newerExecution(execution, l.cursor)
+	}
+	return newerExecution(l.cursor, execution)
+}
+
+// executionToWorkflow returns the summary of a workflow from its execution in a list.
+func (e *Embedded) executionToWorkflow(wd models.WorkflowDefinition, namespace string, execution *sfn.ExecutionListItem) models.Workflow {
+	workflow := models.Workflow{
+		WorkflowSummary: models.WorkflowSummary{
+			ID:                 aws.StringValue(execution.Name),
+			CreatedAt:          strfmt.DateTime(aws.TimeValue(execution.StartDate)),
+			LastUpdated:        strfmt.DateTime(time.Now()),
+			Status:             resources.SFNStatusToWorkflowStatus(aws.StringValue(execution.Status)),
+			WorkflowDefinition: &wd,
+			Namespace:          namespace,
+			ResolvedByUser:     e.isResolved(aws.StringValue(execution.Name)),
+		},
+	}
+	if execution.StopDate != nil {
+		workflow.StoppedAt = strfmt.DateTime(aws.TimeValue(execution.StopDate))
+	}
+	return workflow
+}
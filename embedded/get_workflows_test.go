@@ -0,0 +1,239 @@
+package embedded
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/mocks"
+)
+
+// expectStateMachines makes the SFN API list state machines of the "hello" workflow definition
+// starting at each of startAts, along with state machines of other definitions.
+func expectStateMachines(mockSFNAPI *mocks.MockSFNAPI, startAts ...string) {
+	stateMachines := []*sfn.StateMachineListItem{
+		{Name: aws.String("test--other--0--hello"), StateMachineArn: aws.String(sfnconventions.StateMachineArn("us-west-2", "000000000000", "other", 0, "test", "hello"))},
+		{Name: aws.String("production--hello--0--hello"), StateMachineArn: aws.String(sfnconventions.StateMachineArn("us-west-2", "000000000000", "hello", 0, "production", "hello"))},
+	}
+	for _, startAt := range startAts {
+		stateMachines = append(stateMachines, &sfn.StateMachineListItem{
+			Name:            aws.String(sfnconventions.StateMachineName("hello", 0, "test", startAt)),
+			StateMachineArn: aws.String(sfnconventions.StateMachineArn("us-west-2", "000000000000", "hello", 0, "test", startAt)),
+		})
+	}
+	mockSFNAPI.EXPECT().
+		ListStateMachinesPagesWithContext(gomock.Any(), &sfn.ListStateMachinesInput{}, gomock.Any()).
+		Do(func(
+			ctx aws.Context,
+			input *sfn.ListStateMachinesInput,
+			cb func(page *sfn.ListStateMachinesOutput, lastPage bool) bool,
+		) {
+			cb(&sfn.ListStateMachinesOutput{StateMachines: stateMachines}, true)
+		}).
+		Return(nil)
+}
+
+func TestGetWorkflows(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	e := newTestEmbedded(mockSFNAPI)
+
+	helloArn := sfnconventions.StateMachineArn("us-west-2", "000000000000", "hello", 0, "test", "hello")
+	worldArn := sfnconventions.StateMachineArn("us-west-2", "000000000000", "hello", 0, "test", "world")
+	now := time.Now()
+	execution := func(name string, minutesAgo int, status string) *sfn.ExecutionListItem {
+		return &sfn.ExecutionListItem{
+			Name:      aws.String(name),
+			StartDate: aws.Time(now.Add(-time.Duration(minutesAgo) * time.Minute)),
+			Status:    aws.String(status),
+		}
+	}
+	// executions of each state machine and status, newest first, as SFN lists them
+	executions := map[string][]*sfn.ExecutionListItem{
+		helloArn + sfn.ExecutionStatusFailed: {
+			execution("test--hello--0--hello--a", 1, sfn.ExecutionStatusFailed),
+			execution("test--hello--0--hello--c", 5, sfn.ExecutionStatusFailed),
+		},
+		helloArn + sfn.ExecutionStatusTimedOut: {
+			execution("test--hello--0--hello--b", 3, sfn.ExecutionStatusTimedOut),
+		},
+		worldArn + sfn.ExecutionStatusFailed: {
+			execution("test--hello--0--world--d", 2, sfn.ExecutionStatusFailed),
+			execution("test--hello--0--world--e", 7, sfn.ExecutionStatusFailed),
+		},
+		worldArn + sfn.ExecutionStatusTimedOut: {},
+	}
+	mockSFNAPI.EXPECT().
+		ListExecutionsWithContext(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx aws.Context, input *sfn.ListExecutionsInput, opts ...interface{}) (*sfn.ListExecutionsOutput, error) {
+			assert.Equal(t, int64(2), aws.Int64Value(input.MaxResults))
+			items, ok := executions[aws.StringValue(input.StateMachineArn)+aws.StringValue(input.StatusFilter)]
+			require.True(t, ok, "unexpected ListExecutions %s", input)
+			if input.NextToken != nil {
+				return &sfn.ListExecutionsOutput{Executions: items[2:]}, nil
+			}
+			if len(items) > 2 {
+				return &sfn.ListExecutionsOutput{Executions: items[:2], NextToken: aws.String("next")}, nil
+			}
+			return &sfn.ListExecutionsOutput{Executions: items}, nil
+		}).
+		AnyTimes()
+	ids := func(workflows []models.Workflow) []string {
+		ids := []string{}
+		for _, workflow := range workflows {
+			ids = append(ids, workflow.ID)
+		}
+		return ids
+	}
+
+	t.Log("Workflows of every state machine are listed newest first, filtered by status")
+	expectStateMachines(mockSFNAPI, "hello", "world")
+	workflows, err := e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String(string(models.WorkflowStatusFailed)),
+		SummaryOnly:            aws.Bool(true),
+		Limit:                  aws.Int64(2),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test--hello--0--hello--a", "test--hello--0--world--d"}, ids(workflows))
+	assert.Equal(t, models.WorkflowStatusFailed, workflows[0].Status)
+	assert.Equal(t, "test", workflows[0].Namespace)
+
+	t.Log("The next page starts after the workflow of the page token")
+	expectStateMachines(mockSFNAPI, "hello", "world")
+	mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(sfnconventions.ExecutionArn("us-west-2", "000000000000", "test--hello--0--world", "test--hello--0--world--d")),
+		}).
+		Return(&sfn.DescribeExecutionOutput{StartDate: executions[worldArn+sfn.ExecutionStatusFailed][0].StartDate}, nil)
+	workflows, err = e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String(string(models.WorkflowStatusFailed)),
+		SummaryOnly:            aws.Bool(true),
+		Limit:                  aws.Int64(2),
+		PageToken:              aws.String("test--hello--0--world--d"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test--hello--0--hello--b", "test--hello--0--hello--c"}, ids(workflows))
+
+	t.Log("OldestFirst reverses the order")
+	expectStateMachines(mockSFNAPI, "hello", "world")
+	workflows, err = e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String(string(models.WorkflowStatusFailed)),
+		SummaryOnly:            aws.Bool(true),
+		OldestFirst:            aws.Bool(true),
+		Limit:                  aws.Int64(2),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test--hello--0--world--e", "test--hello--0--hello--c"}, ids(workflows))
+
+	t.Log("OldestFirst only loads the workflows it returns")
+	expectStateMachines(mockSFNAPI, "hello", "world")
+	mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{Status: aws.String(sfn.ExecutionStatusFailed)}, nil).
+		Times(2)
+	expectHistory(mockSFNAPI, gomock.Any(), failedHistory()).Times(2)
+	workflows, err = e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String(string(models.WorkflowStatusFailed)),
+		OldestFirst:            aws.Bool(true),
+		Limit:                  aws.Int64(2),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test--hello--0--world--e", "test--hello--0--hello--c"}, ids(workflows))
+
+	t.Log("Workflows are filtered by whether a user resolved them")
+	e.resolvedWorkflows["test--hello--0--hello--a"] = true
+	expectStateMachines(mockSFNAPI, "hello", "world")
+	workflows, err = e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String(string(models.WorkflowStatusFailed)),
+		SummaryOnly:            aws.Bool(true),
+		ResolvedByUser:         aws.Bool(true),
+		Limit:                  aws.Int64(2),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"test--hello--0--hello--a"}, ids(workflows))
+	assert.True(t, workflows[0].ResolvedByUser)
+
+	t.Log("Embedded workflows are never queued")
+	expectStateMachines(mockSFNAPI, "hello", "world")
+	workflows, err = e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String(string(models.WorkflowStatusQueued)),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, workflows)
+
+	t.Log("Unknown statuses are rejected")
+	_, err = e.GetWorkflows(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Status:                 aws.String("unknown"),
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+}
+
+func TestGetWorkflowsIter(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	e := newTestEmbedded(mockSFNAPI)
+
+	expectStateMachines(mockSFNAPI, "hello")
+	stateMachineArn := sfnconventions.StateMachineArn("us-west-2", "000000000000", "hello", 0, "test", "hello")
+	mockSFNAPI.EXPECT().
+		ListExecutionsWithContext(gomock.Any(), &sfn.ListExecutionsInput{
+			StateMachineArn: aws.String(stateMachineArn),
+			MaxResults:      aws.Int64(1),
+		}).
+		Return(&sfn.ListExecutionsOutput{
+			Executions: []*sfn.ExecutionListItem{{Name: aws.String("test--hello--0--hello--b")}},
+			NextToken:  aws.String("next"),
+		}, nil)
+	mockSFNAPI.EXPECT().
+		ListExecutionsWithContext(gomock.Any(), &sfn.ListExecutionsInput{
+			StateMachineArn: aws.String(stateMachineArn),
+			MaxResults:      aws.Int64(1),
+			NextToken:       aws.String("next"),
+		}).
+		Return(&sfn.ListExecutionsOutput{
+			Executions: []*sfn.ExecutionListItem{{Name: aws.String("test--hello--0--hello--a")}},
+		}, nil)
+	t.Log("Workflows include their input and output unless SummaryOnly is set")
+	mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{
+			Status: aws.String(sfn.ExecutionStatusSucceeded),
+			Input:  aws.String(`{"a":1}`),
+			Output: aws.String(`{"b":2}`),
+		}, nil).
+		Times(2)
+	expectHistory(mockSFNAPI, gomock.Any(), failedHistory()).Times(2)
+
+	iter, err := e.NewGetWorkflowsIter(ctx, &models.GetWorkflowsInput{
+		WorkflowDefinitionName: "hello",
+		Limit:                  aws.Int64(1),
+	})
+	require.NoError(t, err)
+	ids := []string{}
+	var workflow models.Workflow
+	for iter.Next(&workflow) {
+		ids = append(ids, workflow.ID)
+		assert.Equal(t, `{"b":2}`, workflow.Output)
+	}
+	require.NoError(t, iter.Err())
+	assert.Equal(t, []string{"test--hello--0--hello--b", "test--hello--0--hello--a"}, ids)
+}
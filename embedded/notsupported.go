@@ -4,45 +4,80 @@ import (
 	"context"
 	"errors"
 
-	"github.com/Clever/workflow-manager/gen-go/client"
 	"github.com/Clever/workflow-manager/gen-go/models"
 )
 
 // ErrNotSupported is returned when the method is not supported.
 var ErrNotSupported = errors.New("not supported")
 
-func (e *Embedded) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
+func (e *Embedded) StartBulkOperation(ctx context.Context, i *models.BulkOperationRequest) (*models.BulkOperation, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) DeleteStateResource(ctx context.Context, i *models.DeleteStateResourceInput) error {
+func (e *Embedded) GetBulkOperationByID(ctx context.Context, operationID string) (*models.BulkOperation, error) {
+	return nil, ErrNotSupported
+}
+
+func (e *Embedded) SignalWorkflowState(ctx context.Context, i *models.SignalWorkflowStateInput) error {
 	return ErrNotSupported
 }
 
-func (e *Embedded) GetStateResource(ctx context.Context, i *models.GetStateResourceInput) (*models.StateResource, error) {
+func (e *Embedded) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) PutStateResource(ctx context.Context, i *models.PutStateResourceInput) (*models.StateResource, error) {
+func (e *Embedded) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *models.GetWorkflowDefinitionVersionsByNameInput) ([]models.WorkflowDefinition, error) {
+func (e *Embedded) SetWorkflowDefinitionAlias(ctx context.Context, i *models.SetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	return nil, ErrNotSupported
+}
+
+func (e *Embedded) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	return ErrNotSupported
+}
+
+func (e *Embedded) GetWorkflowDefinitionRollout(ctx context.Context, name string) (*models.WorkflowDefinitionRollout, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) UpdateWorkflowDefinition(ctx context.Context, i *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error) {
+func (e *Embedded) SetWorkflowDefinitionRollout(ctx context.Context, i *models.SetWorkflowDefinitionRolloutInput) (*models.WorkflowDefinitionRollout, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) NewGetWorkflowsIter(ctx context.Context, i *models.GetWorkflowsInput) (client.GetWorkflowsIter, error) {
+func (e *Embedded) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	return ErrNotSupported
+}
+
+func (e *Embedded) EvaluatePath(ctx context.Context, i *models.PathEvaluationRequest) (*models.PathEvaluation, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) ResumeWorkflowByID(ctx context.Context, i *models.ResumeWorkflowByIDInput) (*models.Workflow, error) {
+func (e *Embedded) GetWorkflowChoices(ctx context.Context, workflowID string) ([]models.ChoiceEvaluation, error) {
 	return nil, ErrNotSupported
 }
 
-func (e *Embedded) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
+func (e *Embedded) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.WorkflowGraph, error) {
+	return nil, ErrNotSupported
+}
+
+func (e *Embedded) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.WorkflowGraph, error) {
+	return nil, ErrNotSupported
+}
+
+func (e *Embedded) HeartbeatWorker(ctx context.Context, i *models.WorkerHeartbeat) (*models.Worker, error) {
+	return nil, ErrNotSupported
+}
+
+func (e *Embedded) GetWorkers(ctx context.Context, i *models.GetWorkersInput) ([]models.ResourceWorkers, error) {
+	return nil, ErrNotSupported
+}
+
+func (e *Embedded) GetWorkflowEvents(ctx context.Context, workflowID string) error {
+	return ErrNotSupported
+}
+
+func (e *Embedded) GetWorkflowDefinitionEvents(ctx context.Context, name string) error {
 	return ErrNotSupported
 }
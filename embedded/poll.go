@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Clever/workflow-manager/embedded/sfnfunction"
 	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/gen-go/models"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -28,9 +30,7 @@ func (e *Embedded) PollForWork(ctx context.Context) error {
 	// register activities with AWS and spawn GetActivityTask polling loops
 	g, ctx := errgroup.WithContext(ctx)
 	for resourceName, resource := range e.resources {
-		activityArn := sfnconventions.EmbeddedResourceArn(resourceName, e.sfnRegion, e.sfnAccountID, e.environment, e.app)
-		activityArnParts := strings.Split(activityArn, ":")
-		activityName := activityArnParts[len(activityArnParts)-1]
+		activityName := e.activityName(resourceName)
 		createOutput, err := e.sfnAPI.CreateActivityWithContext(ctx, &sfn.CreateActivityInput{
 			Name: aws.String(activityName),
 		})
@@ -39,49 +39,123 @@ func (e *Embedded) PollForWork(ctx context.Context) error {
 		}
 		log.InfoD("startup", logger.M{"activity": *createOutput.ActivityArn})
 		r := resource
+		options := e.pollers[resourceName].withDefaults()
 		g.Go(func() error {
-			return e.pollGetActivityTask(ctx, r, *createOutput.ActivityArn)
+			return e.pollGetActivityTask(ctx, r, *createOutput.ActivityArn, options)
+		})
+	}
+	if e.registry != nil {
+		g.Go(func() error {
+			e.heartbeatWorker(ctx)
+			return nil
 		})
 	}
 	return g.Wait()
 }
 
-func (e *Embedded) pollGetActivityTask(ctx context.Context, resource *sfnfunction.Resource, activityArn string) error {
-	// allow one GetActivityTask per second, max 1 at a time
-	limiter := rate.NewLimiter(rate.Every(1*time.Second), 1)
-	for ctx.Err() == nil {
-		if err := limiter.Wait(ctx); err != nil {
-			continue
+// activityName is the name of the activity that a resource is polled on.
+func (e *Embedded) activityName(resourceName string) string {
+	activityArn := sfnconventions.EmbeddedResourceArn(resourceName, e.sfnRegion, e.sfnAccountID, e.environment, e.app)
+	activityArnParts := strings.Split(activityArn, ":")
+	return activityArnParts[len(activityArnParts)-1]
+}
+
+// workerHeartbeatInterval is how often a polling worker heartbeats to the registry.
+const workerHeartbeatInterval = 30 * time.Second
+
+// heartbeatWorker heartbeats the worker for each of its resources until the context is canceled.
+// Workers are registered with the activities they poll, which are specific to the app, rather than
+// the resource names shared with the registry's own workflows.
+// Failed heartbeats are logged, since polling doesn't depend on the registry.
+func (e *Embedded) heartbeatWorker(ctx context.Context) {
+	ticker := time.NewTicker(workerHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		for resourceName := range e.resources {
+			if _, err := e.registry.HeartbeatWorker(ctx, &models.WorkerHeartbeat{
+				Name:      e.workerName,
+				Namespace: e.environment,
+				Resource:  e.activityName(resourceName),
+				Version:   e.workerVersion,
+			}); err != nil && ctx.Err() == nil {
+				log.ErrorD("heartbeatworker-error", logger.M{"resource": resourceName, "error": err.Error()})
+			}
 		}
 		select {
 		case <-ctx.Done():
-			log.Info("getactivitytask-stop")
-		default:
-			log.TraceD("getactivitytask-start", logger.M{"activity-arn": activityArn, "worker-name": e.workerName})
-			out, err := e.sfnAPI.GetActivityTaskWithContext(ctx, &sfn.GetActivityTaskInput{
-				ActivityArn: aws.String(activityArn),
-				WorkerName:  aws.String(e.workerName),
-			})
-			if err != nil {
-				if err == context.Canceled || awsErr(err, request.CanceledErrorCode) {
-					log.Info("getactivitytask-stop")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollGetActivityTask runs options.Concurrency pollers for the tasks of an activity. Tasks are
+// processed in parallel, up to options.MaxInFlight at a time. It returns once the context is
+// canceled and the tasks in flight have been processed.
+func (e *Embedded) pollGetActivityTask(ctx context.Context, resource *sfnfunction.Resource, activityArn string, options PollerOptions) error {
+	limiter := options.limiter()
+	// a slot is taken before polling, so that tasks aren't taken from SFN until they can be processed
+	slots := make(chan struct{}, options.MaxInFlight)
+	var tasks sync.WaitGroup
+	defer tasks.Wait()
+
+	var pollers sync.WaitGroup
+	for i := 0; i < options.Concurrency; i++ {
+		pollers.Add(1)
+		go func() {
+			defer pollers.Done()
+			for ctx.Err() == nil {
+				select {
+				case <-ctx.Done():
 					continue
+				case slots <- struct{}{}:
 				}
-				log.ErrorD("getactivitytask-error", logger.M{"error": err.Error()})
-				continue
-			}
-			if out.TaskToken == nil {
-				continue
+				token, input, ok := e.getActivityTask(ctx, limiter, activityArn)
+				if !ok {
+					<-slots
+					continue
+				}
+				tasks.Add(1)
+				go func() {
+					defer tasks.Done()
+					defer func() { <-slots }()
+					e.handleTask(ctx, resource, token, input)
+				}()
 			}
-			input := *out.Input
-			token := *out.TaskToken
-			log.TraceD("getactivitytask", logger.M{"input": input, "token": shortToken(token)})
-			e.handleTask(ctx, resource, token, input)
-		}
+		}()
 	}
+	pollers.Wait()
+	log.Info("getactivitytask-stop")
 	return nil
 }
 
+// getActivityTask waits for the rate limiter and then polls for a task of an activity. It returns
+// false if there was no task.
+func (e *Embedded) getActivityTask(ctx context.Context, limiter *rate.Limiter, activityArn string) (string, string, bool) {
+	if err := limiter.Wait(ctx); err != nil {
+		return "", "", false
+	}
+	log.TraceD("getactivitytask-start", logger.M{"activity-arn": activityArn, "worker-name": e.workerName})
+	out, err := e.sfnAPI.GetActivityTaskWithContext(ctx, &sfn.GetActivityTaskInput{
+		ActivityArn: aws.String(activityArn),
+		WorkerName:  aws.String(e.workerName),
+	})
+	if err != nil {
+		if err == context.Canceled || awsErr(err, request.CanceledErrorCode) {
+			return "", "", false
+		}
+		log.ErrorD("getactivitytask-error", logger.M{"error": err.Error()})
+		return "", "", false
+	}
+	if out.TaskToken == nil {
+		return "", "", false
+	}
+	input := aws.StringValue(out.Input)
+	token := *out.TaskToken
+	log.TraceD("getactivitytask", logger.M{"input": input, "token": shortToken(token)})
+	return token, input, true
+}
+
 func shortToken(token string) string {
 	shasum := fmt.Sprintf("%x", md5.Sum([]byte(token)))
 	if len(shasum) > 5 {
@@ -0,0 +1,123 @@
+package embedded
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/embedded/sfnfunction"
+	"github.com/Clever/workflow-manager/gen-go/client"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/mocks"
+)
+
+func TestPollerOptions(t *testing.T) {
+	assert.Equal(t, PollerOptions{Concurrency: 1, PollRate: 1, MaxInFlight: 1}, PollerOptions{}.withDefaults())
+	assert.Equal(t, PollerOptions{Concurrency: 4, PollRate: 1, MaxInFlight: 4}, PollerOptions{Concurrency: 4}.withDefaults())
+	assert.Equal(t, PollerOptions{Concurrency: 1, PollRate: 10, MaxInFlight: 8}, PollerOptions{PollRate: 10, MaxInFlight: 8}.withDefaults())
+
+	config := Config{
+		Environment:  "test",
+		App:          "app",
+		SFNAccountID: "000000000000",
+		SFNRegion:    "us-west-2",
+		SFNAPI:       mocks.NewMockSFNAPI(gomock.NewController(t)),
+		Resources:    map[string]interface{}{"first": func() {}},
+	}
+	config.Pollers = map[string]PollerOptions{"unknown": {}}
+	assert.Error(t, config.validate())
+	config.Pollers = map[string]PollerOptions{"first": {MaxInFlight: -1}}
+	assert.Error(t, config.validate())
+	config.Pollers = map[string]PollerOptions{"first": {Concurrency: 2, PollRate: 5, MaxInFlight: 10}}
+	assert.NoError(t, config.validate())
+}
+
+func TestPollGetActivityTaskMaxInFlight(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	e := newTestEmbedded(mockSFNAPI)
+
+	mockSFNAPI.EXPECT().
+		GetActivityTaskWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.GetActivityTaskOutput{TaskToken: aws.String("token"), Input: aws.String(`{}`)}, nil).
+		AnyTimes()
+	mockSFNAPI.EXPECT().
+		SendTaskSuccessWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.SendTaskSuccessOutput{}, nil).
+		AnyTimes()
+
+	started := make(chan struct{}, 100)
+	release := make(chan struct{})
+	resource, err := sfnfunction.New("first", func(ctx context.Context) error {
+		started <- struct{}{}
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	done := make(chan error)
+	go func() {
+		done <- e.pollGetActivityTask(ctx, resource, "activity", PollerOptions{Concurrency: 2, PollRate: 1000, MaxInFlight: 3})
+	}()
+
+	t.Log("Tasks are processed in parallel, up to MaxInFlight at a time")
+	for i := 0; i < 3; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatalf("only %d tasks started", i)
+		}
+	}
+	select {
+	case <-started:
+		t.Fatal("more than MaxInFlight tasks started")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	t.Log("Polling stops once the context is canceled and the tasks in flight are done")
+	cancel()
+	close(release)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("polling didn't stop")
+	}
+}
+
+func TestHeartbeatWorker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockRegistry := client.NewMockClient(mockController)
+	e := newTestEmbedded(mocks.NewMockSFNAPI(mockController))
+	e.registry = mockRegistry
+	e.workerName = "worker"
+	e.workerVersion = "v1"
+
+	t.Log("Workers are registered with the activities they poll")
+	for _, activity := range []string{"test--app-first", "test--app-second"} {
+		mockRegistry.EXPECT().
+			HeartbeatWorker(gomock.Any(), &models.WorkerHeartbeat{
+				Name:      "worker",
+				Namespace: "test",
+				Resource:  activity,
+				Version:   "v1",
+			}).
+			Return(&models.Worker{}, nil)
+	}
+	cancel()
+	e.heartbeatWorker(ctx)
+}
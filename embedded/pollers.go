@@ -0,0 +1,52 @@
+package embedded
+
+import (
+	"fmt"
+
+	"golang.org/x/time/rate"
+)
+
+// PollerOptions configure how the activity of a resource is polled for tasks.
+type PollerOptions struct {
+	// Concurrency is the number of GetActivityTask calls that can wait for a task at once.
+	// Defaults to 1.
+	Concurrency int
+	// PollRate is the maximum number of GetActivityTask calls per second. Defaults to 1.
+	PollRate float64
+	// MaxInFlight is the maximum number of tasks that are processed at once. Pollers wait for a
+	// task to finish before polling for more once it's reached. Defaults to Concurrency.
+	MaxInFlight int
+}
+
+func (o PollerOptions) validate() error {
+	if o.Concurrency < 0 {
+		return fmt.Errorf("Concurrency must not be negative: %d", o.Concurrency)
+	}
+	if o.PollRate < 0 {
+		return fmt.Errorf("PollRate must not be negative: %f", o.PollRate)
+	}
+	if o.MaxInFlight < 0 {
+		return fmt.Errorf("MaxInFlight must not be negative: %d", o.MaxInFlight)
+	}
+	return nil
+}
+
+// withDefaults fills in the options that aren't set. The defaults poll for and process one task
+// at a time.
+func (o PollerOptions) withDefaults() PollerOptions {
+	if o.Concurrency == 0 {
+		o.Concurrency = 1
+	}
+	if o.PollRate == 0 {
+		o.PollRate = 1
+	}
+	if o.MaxInFlight == 0 {
+		o.MaxInFlight = o.Concurrency
+	}
+	return o
+}
+
+// limiter returns the rate limiter of GetActivityTask calls.
+func (o PollerOptions) limiter() *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(o.PollRate), 1)
+}
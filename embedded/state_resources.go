@@ -0,0 +1,59 @@
+package embedded
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+)
+
+// State resources are kept in memory, so that clients of workflow-manager can use an Embedded
+// in its place. Task states of embedded workflows always run on the application's own functions.
+
+func stateResourceKey(name, namespace string) string {
+	return fmt.Sprintf("%s--%s", namespace, name)
+}
+
+// PostStateResource creates or updates a state resource.
+func (e *Embedded) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
+	stateResource := resources.NewStateResource(i.Name, i.Namespace, i.URI, i.Type)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.stateResources[stateResourceKey(i.Name, i.Namespace)] = *stateResource
+	return stateResource, nil
+}
+
+// PutStateResource creates or updates the state resource for a name and namespace.
+func (e *Embedded) PutStateResource(ctx context.Context, i *models.PutStateResourceInput) (*models.StateResource, error) {
+	if i.NewStateResource == nil || i.Name != i.NewStateResource.Name {
+		return nil, models.BadRequest{Message: "StateResource.Name does not match name in path"}
+	}
+	if i.Namespace != i.NewStateResource.Namespace {
+		return nil, models.BadRequest{Message: "StateResource.Namespace does not match namespace in path"}
+	}
+	return e.PostStateResource(ctx, i.NewStateResource)
+}
+
+// GetStateResource fetches a state resource by name and namespace.
+func (e *Embedded) GetStateResource(ctx context.Context, i *models.GetStateResourceInput) (*models.StateResource, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	stateResource, ok := e.stateResources[stateResourceKey(i.Name, i.Namespace)]
+	if !ok {
+		return nil, models.NotFound{Message: stateResourceKey(i.Name, i.Namespace)}
+	}
+	return &stateResource, nil
+}
+
+// DeleteStateResource removes a state resource by name and namespace.
+func (e *Embedded) DeleteStateResource(ctx context.Context, i *models.DeleteStateResourceInput) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	key := stateResourceKey(i.Name, i.Namespace)
+	if _, ok := e.stateResources[key]; !ok {
+		return models.NotFound{Message: key}
+	}
+	delete(e.stateResources, key)
+	return nil
+}
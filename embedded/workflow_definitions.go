@@ -0,0 +1,122 @@
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// GetWorkflowDefinitionVersionsByName returns the latest version of a workflow definition, or all
+// of its versions, oldest first, when Latest is false.
+func (e *Embedded) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *models.GetWorkflowDefinitionVersionsByNameInput) ([]models.WorkflowDefinition, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	versions, ok := e.workflowDefinitionVersions[i.Name]
+	if !ok {
+		return nil, models.NotFound{Message: i.Name}
+	}
+	if i.Latest == nil || *i.Latest {
+		return []models.WorkflowDefinition{versions[len(versions)-1]}, nil
+	}
+	return append([]models.WorkflowDefinition{}, versions...), nil
+}
+
+// UpdateWorkflowDefinition creates a new version of a workflow definition. Versions are kept in
+// memory, so they only last as long as the process. Definitions with version -1 allow mutation,
+// and are updated in place instead.
+func (e *Embedded) UpdateWorkflowDefinition(ctx context.Context, i *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error) {
+	req := i.NewWorkflowDefinitionRequest
+	if req == nil || req.Name != i.Name {
+		return nil, models.BadRequest{Message: "Name in path must match WorkflowDefinition object"}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	versions, ok := e.workflowDefinitionVersions[i.Name]
+	if !ok {
+		return nil, models.NotFound{Message: i.Name}
+	}
+	latest := versions[len(versions)-1]
+
+	wfd, err := newWorkflowDefinition(req)
+	if err != nil {
+		return nil, err
+	}
+	wfd.Version = latest.Version + 1
+	if latest.Version == -1 {
+		wfd.Version = -1
+	}
+	if err := validateWorkflowDefinition(wfd, e.resources); err != nil {
+		return nil, models.BadRequest{Message: fmt.Sprintf("could not validate state machine: %s", err)}
+	}
+
+	if wfd.Version == -1 {
+		versions[len(versions)-1] = wfd
+	} else {
+		e.workflowDefinitionVersions[i.Name] = append(versions, wfd)
+	}
+	for idx, existing := range e.workflowDefinitions {
+		if existing.Name == wfd.Name {
+			e.workflowDefinitions[idx] = wfd
+		}
+	}
+	return &wfd, nil
+}
+
+// newWorkflowDefinition creates the first version of a workflow definition from a request. Fields
+// that the embedded workflow-manager doesn't act on are rejected rather than ignored.
+func newWorkflowDefinition(req *models.NewWorkflowDefinitionRequest) (models.WorkflowDefinition, error) {
+	unsupported := []string{}
+	if req.AutoRetry != nil {
+		unsupported = append(unsupported, "autoRetry")
+	}
+	if req.Deadlines != nil {
+		unsupported = append(unsupported, "deadlines")
+	}
+	if len(req.Triggers) > 0 {
+		unsupported = append(unsupported, "triggers")
+	}
+	if req.InputSchema != nil {
+		unsupported = append(unsupported, "inputSchema")
+	}
+	if len(req.OutputSchemas) > 0 {
+		unsupported = append(unsupported, "outputSchemas")
+	}
+	if len(unsupported) > 0 {
+		return models.WorkflowDefinition{}, models.BadRequest{
+			Message: fmt.Sprintf("%s not supported", strings.Join(unsupported, ", ")),
+		}
+	}
+	return models.WorkflowDefinition{
+		CreatedAt:    strfmt.DateTime(time.Now()),
+		DefaultTags:  req.DefaultTags,
+		Name:         req.Name,
+		StateMachine: req.StateMachine,
+	}, nil
+}
+
+// latestWorkflowDefinition returns the latest version of a workflow definition.
+func (e *Embedded) latestWorkflowDefinition(name string) (models.WorkflowDefinition, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	versions, ok := e.workflowDefinitionVersions[name]
+	if !ok {
+		return models.WorkflowDefinition{}, models.NotFound{Message: name}
+	}
+	return versions[len(versions)-1], nil
+}
+
+// workflowDefinitionVersion returns a version of a workflow definition. A definition with version -1
+// only has that version, which is returned for any version. Callers must hold e.mu.
+func (e *Embedded) workflowDefinitionVersion(name string, version int64) (models.WorkflowDefinition, error) {
+	for _, wfd := range e.workflowDefinitionVersions[name] {
+		if wfd.Version == version || wfd.Version == -1 {
+			return wfd, nil
+		}
+	}
+	return models.WorkflowDefinition{}, models.NotFound{Message: fmt.Sprintf("%s version %d", name, version)}
+}
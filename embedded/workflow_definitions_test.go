@@ -0,0 +1,116 @@
+package embedded
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/mohae/deepcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+func TestUpdateWorkflowDefinition(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEmbedded(nil)
+
+	stateMachine := deepcopy.Copy(e.workflowDefinitions[0].StateMachine).(*models.SLStateMachine)
+	stateMachine.Comment = "version 1"
+	updated, err := e.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name:                         "hello",
+		NewWorkflowDefinitionRequest: &models.NewWorkflowDefinitionRequest{Name: "hello", StateMachine: stateMachine},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), updated.Version)
+
+	t.Log("Versions are tracked, and the latest version is listed")
+	versions, err := e.GetWorkflowDefinitionVersionsByName(ctx, &models.GetWorkflowDefinitionVersionsByNameInput{
+		Name: "hello", Latest: aws.Bool(false),
+	})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, []int64{0, 1}, []int64{versions[0].Version, versions[1].Version})
+	definitions, err := e.GetWorkflowDefinitions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "version 1", definitions[0].StateMachine.Comment)
+	original, err := e.GetWorkflowDefinitionByNameAndVersion(ctx, &models.GetWorkflowDefinitionByNameAndVersionInput{Name: "hello", Version: 0})
+	require.NoError(t, err)
+	assert.Equal(t, "", original.StateMachine.Comment)
+
+	t.Log("Updates are validated")
+	_, err = e.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name: "hello",
+		NewWorkflowDefinitionRequest: &models.NewWorkflowDefinitionRequest{Name: "hello", StateMachine: &models.SLStateMachine{
+			States: map[string]models.SLState{"unknown": {Type: models.SLStateTypeTask, Resource: "unknown", End: true}},
+		}},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Fields that aren't supported are rejected")
+	_, err = e.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name: "hello",
+		NewWorkflowDefinitionRequest: &models.NewWorkflowDefinitionRequest{
+			Name:          "hello",
+			StateMachine:  stateMachine,
+			AutoRetry:     &models.AutoRetryPolicy{MaxAttempts: 1},
+			Deadlines:     &models.WorkflowDeadlines{MaxAgeSeconds: 60},
+			Triggers:      []*models.WorkflowTrigger{{On: models.WorkflowStatusSucceeded, WorkflowDefinitionName: "next"}},
+			InputSchema:   map[string]interface{}{"type": "object"},
+			OutputSchemas: map[string]interface{}{"hello": map[string]interface{}{"type": "object"}},
+		},
+	})
+	require.IsType(t, models.BadRequest{}, err)
+	assert.Equal(t, "autoRetry, deadlines, triggers, inputSchema, outputSchemas not supported", err.Error())
+	_, err = e.NewWorkflowDefinition(ctx, &models.NewWorkflowDefinitionRequest{
+		Name:         "other",
+		StateMachine: stateMachine,
+		Deadlines:    &models.WorkflowDeadlines{MaxAgeSeconds: 60},
+	})
+	require.IsType(t, models.BadRequest{}, err)
+	assert.Equal(t, "deadlines not supported", err.Error())
+
+	t.Log("Mutable definitions are updated in place")
+	e.workflowDefinitionVersions["hello"] = []models.WorkflowDefinition{{Name: "hello", Version: -1, StateMachine: stateMachine}}
+	updated, err = e.UpdateWorkflowDefinition(ctx, &models.UpdateWorkflowDefinitionInput{
+		Name:                         "hello",
+		NewWorkflowDefinitionRequest: &models.NewWorkflowDefinitionRequest{Name: "hello", StateMachine: stateMachine},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), updated.Version)
+	versions, err = e.GetWorkflowDefinitionVersionsByName(ctx, &models.GetWorkflowDefinitionVersionsByNameInput{
+		Name: "hello", Latest: aws.Bool(false),
+	})
+	require.NoError(t, err)
+	assert.Len(t, versions, 1)
+}
+
+func TestStateResources(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEmbedded(nil)
+
+	_, err := e.GetStateResource(ctx, &models.GetStateResourceInput{Name: "name", Namespace: "namespace"})
+	assert.IsType(t, models.NotFound{}, err)
+
+	_, err = e.PutStateResource(ctx, &models.PutStateResourceInput{
+		Name: "name", Namespace: "namespace",
+		NewStateResource: &models.NewStateResource{Name: "other", Namespace: "namespace"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	arn := "arn:aws:lambda:us-west-2:000000000000:function:namespace--name"
+	_, err = e.PutStateResource(ctx, &models.PutStateResourceInput{
+		Name: "name", Namespace: "namespace",
+		NewStateResource: &models.NewStateResource{Name: "name", Namespace: "namespace", URI: arn},
+	})
+	require.NoError(t, err)
+	stateResource, err := e.GetStateResource(ctx, &models.GetStateResourceInput{Name: "name", Namespace: "namespace"})
+	require.NoError(t, err)
+	assert.Equal(t, arn, stateResource.URI)
+	assert.Equal(t, models.StateResourceTypeLambdaFunctionARN, stateResource.Type)
+
+	require.NoError(t, e.DeleteStateResource(ctx, &models.DeleteStateResourceInput{Name: "name", Namespace: "namespace"}))
+	_, err = e.GetStateResource(ctx, &models.GetStateResourceInput{Name: "name", Namespace: "namespace"})
+	assert.IsType(t, models.NotFound{}, err)
+}
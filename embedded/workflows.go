@@ -0,0 +1,129 @@
+package embedded
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/go-openapi/strfmt"
+)
+
+// ResumeWorkflowByID starts a new workflow from a state of a finished workflow. Like
+// SFNWorkflowManager.RetryWorkflow, it runs on a state machine that starts at that state.
+func (e *Embedded) ResumeWorkflowByID(ctx context.Context, i *models.ResumeWorkflowByIDInput) (*models.Workflow, error) {
+	overrides := i.Overrides
+	if overrides == nil || overrides.StartAt == "" {
+		return nil, models.BadRequest{Message: "Overrides.StartAt is required"}
+	}
+	if overrides.Input != "" && overrides.InputPatch != "" {
+		return nil, models.BadRequest{Message: "Input and InputPatch cannot both be set"}
+	}
+
+	workflow, err := e.GetWorkflowByID(ctx, i.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	if !resources.WorkflowIsDone(workflow) {
+		return nil, fmt.Errorf("Workflow %s active: %s", workflow.ID, workflow.Status)
+	}
+
+	// resume on a different version of the definition, e.g. one containing a bug fix
+	wd := *workflow.WorkflowDefinition
+	if overrides.WorkflowDefinitionVersion != nil {
+		e.mu.RLock()
+		wd, err = e.workflowDefinitionVersion(wd.Name, *overrides.WorkflowDefinitionVersion)
+		e.mu.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := wd.StateMachine.States[overrides.StartAt]; !ok {
+		return nil, models.BadRequest{Message: fmt.Sprintf("Invalid StartAt state %s", overrides.StartAt)}
+	}
+
+	input := overrides.Input
+	if input == "" {
+		if input, err = stateInput(*workflow, overrides.StartAt); err != nil {
+			return nil, err
+		}
+	}
+	if overrides.InputPatch != "" {
+		if input, err = resources.MergePatchInput(input, overrides.InputPatch); err != nil {
+			return nil, models.BadRequest{Message: err.Error()}
+		}
+	}
+
+	newDef := resources.CopyWorkflowDefinition(wd)
+	newDef.StateMachine.StartAt = overrides.StartAt
+	if err := resources.RemoveInactiveStates(newDef.StateMachine); err != nil {
+		return nil, err
+	}
+	stateMachineName, stateMachineArn, err := e.describeOrCreateStateMachine(ctx, newDef, workflow.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	resumed := &models.Workflow{
+		WorkflowSummary: models.WorkflowSummary{
+			ID:                 workflowID(stateMachineName),
+			CreatedAt:          strfmt.DateTime(time.Now()),
+			LastUpdated:        strfmt.DateTime(time.Now()),
+			WorkflowDefinition: &newDef,
+			Status:             models.WorkflowStatusQueued,
+			Namespace:          workflow.Namespace,
+			Input:              input,
+			RetryFor:           workflow.ID,
+		},
+	}
+	if _, err := e.sfnAPI.StartExecutionWithContext(ctx, &sfn.StartExecutionInput{
+		StateMachineArn: aws.String(stateMachineArn),
+		Input:           aws.String(input),
+		Name:            aws.String(resumed.ID),
+	}); err != nil {
+		return nil, fmt.Errorf("StartExecution: %s", err.Error())
+	}
+	return resumed, nil
+}
+
+// stateInput finds the input a state received in a workflow, from the workflow's jobs.
+func stateInput(workflow models.Workflow, state string) (string, error) {
+	for _, job := range workflow.Jobs {
+		if job.State != state {
+			continue
+		}
+		if job.Status == models.JobStatusCreated || job.Status == models.JobStatusQueued {
+			return "", models.BadRequest{
+				Message: fmt.Sprintf("Job %s for StartAt %s was not started for Workflow: %s. Could not infer input", job.ID, state, workflow.ID),
+			}
+		}
+		return job.Input, nil
+	}
+	return "", models.BadRequest{
+		Message: fmt.Sprintf("StartAt %s was not started for Workflow: %s. Could not infer input", state, workflow.ID),
+	}
+}
+
+// ResolveWorkflowByID marks a workflow as resolved by a user. Resolutions are kept in memory, so
+// they only last as long as the process.
+func (e *Embedded) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
+	if _, err := e.GetWorkflowByID(ctx, workflowID); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.resolvedWorkflows[workflowID] {
+		return models.Conflict{Message: fmt.Sprintf("workflow %s already resolved", workflowID)}
+	}
+	e.resolvedWorkflows[workflowID] = true
+	return nil
+}
+
+func (e *Embedded) isResolved(workflowID string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.resolvedWorkflows[workflowID]
+}
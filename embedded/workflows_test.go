@@ -0,0 +1,228 @@
+package embedded
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/embedded/sfnfunction"
+	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/mocks"
+)
+
+// newTestEmbedded returns an Embedded with a "hello" workflow definition that runs the "first" and
+// then the "second" function.
+func newTestEmbedded(sfnAPI sfniface.SFNAPI) *Embedded {
+	wd := models.WorkflowDefinition{
+		Name: "hello",
+		StateMachine: &models.SLStateMachine{
+			StartAt: "hello",
+			States: map[string]models.SLState{
+				"hello": {Type: models.SLStateTypeTask, Resource: "first", Next: "world"},
+				"world": {Type: models.SLStateTypeTask, Resource: "second", End: true},
+			},
+		},
+	}
+	return &Embedded{
+		environment:  "test",
+		app:          "app",
+		sfnAccountID: "000000000000",
+		sfnRegion:    "us-west-2",
+		sfnRoleArn:   "role",
+		sfnAPI:       sfnAPI,
+		resources: map[string]*sfnfunction.Resource{
+			"first":  nil,
+			"second": nil,
+		},
+		workflowDefinitions:        []models.WorkflowDefinition{wd},
+		workflowDefinitionVersions: map[string][]models.WorkflowDefinition{"hello": {wd}},
+		stateResources:             map[string]models.StateResource{},
+		resolvedWorkflows:          map[string]bool{},
+	}
+}
+
+// expectHistory makes the SFN API return a history of events for an execution.
+func expectHistory(mockSFNAPI *mocks.MockSFNAPI, executionArn interface{}, events []*sfn.HistoryEvent) *gomock.Call {
+	return mockSFNAPI.EXPECT().
+		GetExecutionHistoryPagesWithContext(gomock.Any(), executionArn, gomock.Any()).
+		Do(func(
+			ctx aws.Context,
+			input *sfn.GetExecutionHistoryInput,
+			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
+		) {
+			cb(&sfn.GetExecutionHistoryOutput{Events: events}, true)
+		}).
+		Return(nil)
+}
+
+// failedHistory is the history of an execution of the "hello" workflow definition whose "world"
+// state failed.
+func failedHistory() []*sfn.HistoryEvent {
+	event := func(id, previousID int64, eventType string) *sfn.HistoryEvent {
+		return &sfn.HistoryEvent{
+			Id:              aws.Int64(id),
+			PreviousEventId: aws.Int64(previousID),
+			Type:            aws.String(eventType),
+			Timestamp:       aws.Time(time.Now()),
+		}
+	}
+	events := []*sfn.HistoryEvent{
+		event(1, 0, sfn.HistoryEventTypeExecutionStarted),
+		event(2, 1, sfn.HistoryEventTypeTaskStateEntered),
+		event(3, 2, sfn.HistoryEventTypeActivityScheduled),
+		event(4, 3, sfn.HistoryEventTypeActivityStarted),
+		event(5, 4, sfn.HistoryEventTypeActivitySucceeded),
+		event(6, 5, sfn.HistoryEventTypeTaskStateExited),
+		event(7, 6, sfn.HistoryEventTypeTaskStateEntered),
+		event(8, 7, sfn.HistoryEventTypeActivityScheduled),
+		event(9, 8, sfn.HistoryEventTypeActivityStarted),
+		event(10, 9, sfn.HistoryEventTypeActivityFailed),
+		event(11, 10, sfn.HistoryEventTypeExecutionFailed),
+	}
+	events[1].StateEnteredEventDetails = &sfn.StateEnteredEventDetails{Name: aws.String("hello"), Input: aws.String(`{"a":1}`)}
+	events[2].ActivityScheduledEventDetails = &sfn.ActivityScheduledEventDetails{Resource: aws.String("arn:aws:states:us-west-2:000000000000:activity:test--app--first")}
+	events[5].StateExitedEventDetails = &sfn.StateExitedEventDetails{Name: aws.String("hello"), Output: aws.String(`{"b":2}`)}
+	events[6].StateEnteredEventDetails = &sfn.StateEnteredEventDetails{Name: aws.String("world"), Input: aws.String(`{"b":2}`)}
+	events[7].ActivityScheduledEventDetails = &sfn.ActivityScheduledEventDetails{Resource: aws.String("arn:aws:states:us-west-2:000000000000:activity:test--app--second")}
+	events[9].ActivityFailedEventDetails = &sfn.ActivityFailedEventDetails{Cause: aws.String("boom"), Error: aws.String("Error")}
+	events[10].ExecutionFailedEventDetails = &sfn.ExecutionFailedEventDetails{Cause: aws.String("boom"), Error: aws.String("Error")}
+	return events
+}
+
+func TestGetWorkflowByID(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	e := newTestEmbedded(mockSFNAPI)
+
+	const workflowID = "test--hello--0--hello--abcd1234"
+	executionArn := sfnconventions.ExecutionArn("us-west-2", "000000000000", "test--hello--0--hello", workflowID)
+	mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{ExecutionArn: aws.String(executionArn)}).
+		Return(&sfn.DescribeExecutionOutput{Status: aws.String(sfn.ExecutionStatusFailed), Input: aws.String(`{"a":1}`)}, nil)
+	events := failedHistory()
+	// the "world" state failed once before, and was retried
+	retry := append([]*sfn.HistoryEvent{}, events[:10]...)
+	retry = append(retry,
+		&sfn.HistoryEvent{Id: aws.Int64(12), PreviousEventId: aws.Int64(10), Type: aws.String(sfn.HistoryEventTypeActivityScheduled), Timestamp: aws.Time(time.Now())},
+		&sfn.HistoryEvent{Id: aws.Int64(13), PreviousEventId: aws.Int64(12), Type: aws.String(sfn.HistoryEventTypeActivityStarted), Timestamp: aws.Time(time.Now())},
+		&sfn.HistoryEvent{
+			Id: aws.Int64(14), PreviousEventId: aws.Int64(13), Type: aws.String(sfn.HistoryEventTypeActivityFailed), Timestamp: aws.Time(time.Now()),
+			ActivityFailedEventDetails: &sfn.ActivityFailedEventDetails{Cause: aws.String("boom again"), Error: aws.String("Error")},
+		},
+		&sfn.HistoryEvent{
+			Id: aws.Int64(15), PreviousEventId: aws.Int64(14), Type: aws.String(sfn.HistoryEventTypeExecutionFailed), Timestamp: aws.Time(time.Now()),
+			ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{Cause: aws.String("boom again"), Error: aws.String("Error")},
+		},
+	)
+	expectHistory(mockSFNAPI, &sfn.GetExecutionHistoryInput{ExecutionArn: aws.String(executionArn)}, retry)
+
+	workflow, err := e.GetWorkflowByID(ctx, workflowID)
+	require.NoError(t, err)
+	require.Len(t, workflow.Jobs, 2)
+	hello, world := workflow.Jobs[0], workflow.Jobs[1]
+	assert.Equal(t, "hello", hello.State)
+	assert.Equal(t, models.JobStatusSucceeded, hello.Status)
+	assert.Equal(t, `{"b":2}`, hello.Output)
+	assert.Equal(t, "first", hello.StateResource.Name)
+	assert.Equal(t, models.StateResourceSourceConvention, hello.StateResource.Source)
+	assert.Equal(t, "world", world.State)
+	assert.Equal(t, models.JobStatusFailed, world.Status)
+	assert.Equal(t, "boom again\nError", world.StatusReason)
+	require.Len(t, world.Attempts, 1)
+	assert.Equal(t, "boom\nError", world.Attempts[0].Reason)
+}
+
+func TestResumeWorkflowByID(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	e := newTestEmbedded(mockSFNAPI)
+
+	const workflowID = "test--hello--0--hello--abcd1234"
+	executionArn := sfnconventions.ExecutionArn("us-west-2", "000000000000", "test--hello--0--hello", workflowID)
+	describeExecution := mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{ExecutionArn: aws.String(executionArn)})
+
+	t.Log("Active workflows can't be resumed")
+	describeExecution.Return(&sfn.DescribeExecutionOutput{Status: aws.String(sfn.ExecutionStatusRunning)}, nil)
+	expectHistory(mockSFNAPI, &sfn.GetExecutionHistoryInput{ExecutionArn: aws.String(executionArn)}, failedHistory()[:9])
+	_, err := e.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflowID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "world"},
+	})
+	assert.Error(t, err)
+
+	t.Log("Resumes from the input the state received, on a state machine that starts at the state")
+	mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{ExecutionArn: aws.String(executionArn)}).
+		Return(&sfn.DescribeExecutionOutput{
+			Status:    aws.String(sfn.ExecutionStatusFailed),
+			Input:     aws.String(`{"a":1}`),
+			StartDate: aws.Time(time.Now()),
+		}, nil)
+	expectHistory(mockSFNAPI, &sfn.GetExecutionHistoryInput{ExecutionArn: aws.String(executionArn)}, failedHistory())
+	mockSFNAPI.EXPECT().
+		DescribeStateMachineWithContext(gomock.Any(), &sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(sfnconventions.StateMachineArn("us-west-2", "000000000000", "hello", 0, "test", "world")),
+		}).
+		Return(nil, awserr.New(sfn.ErrCodeStateMachineDoesNotExist, "", nil))
+	mockSFNAPI.EXPECT().
+		CreateStateMachine(gomock.Any()).
+		Do(func(input *sfn.CreateStateMachineInput) {
+			assert.Equal(t, "test--hello--0--world", aws.StringValue(input.Name))
+			var stateMachine models.SLStateMachine
+			require.NoError(t, json.Unmarshal([]byte(aws.StringValue(input.Definition)), &stateMachine))
+			assert.Equal(t, "world", stateMachine.StartAt)
+			assert.NotContains(t, stateMachine.States, "hello")
+		}).
+		Return(&sfn.CreateStateMachineOutput{}, nil)
+	mockSFNAPI.EXPECT().
+		StartExecutionWithContext(gomock.Any(), gomock.Any()).
+		Do(func(ctx aws.Context, input *sfn.StartExecutionInput) {
+			assert.JSONEq(t, `{"b":2,"c":3}`, aws.StringValue(input.Input))
+		}).
+		Return(&sfn.StartExecutionOutput{}, nil)
+
+	resumed, err := e.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflowID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "world", InputPatch: `{"c":3}`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, workflowID, resumed.RetryFor)
+	assert.Equal(t, "world", resumed.WorkflowDefinition.StateMachine.StartAt)
+	assert.Equal(t, "hello", e.workflowDefinitions[0].StateMachine.StartAt)
+}
+
+func TestResolveWorkflowByID(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	e := newTestEmbedded(mockSFNAPI)
+
+	const workflowID = "test--hello--0--hello--abcd1234"
+	mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{Status: aws.String(sfn.ExecutionStatusFailed)}, nil).
+		Times(3)
+	expectHistory(mockSFNAPI, gomock.Any(), failedHistory()).Times(3)
+
+	require.NoError(t, e.ResolveWorkflowByID(ctx, workflowID))
+	assert.IsType(t, models.Conflict{}, e.ResolveWorkflowByID(ctx, workflowID))
+	workflow, err := e.GetWorkflowByID(ctx, workflowID)
+	require.NoError(t, err)
+	assert.True(t, workflow.ResolvedByUser)
+}
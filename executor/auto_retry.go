@@ -0,0 +1,162 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/executor/sfnhistory"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/store"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/go-openapi/strfmt"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+)
+
+// scheduleAutoRetry sets AutoRetryAt on a workflow that just failed if its definition has an
+// AutoRetryPolicy matching the failure, and the policy's MaxAttempts has not been reached.
+func (wm *SFNWorkflowManager) scheduleAutoRetry(ctx context.Context, workflow *models.Workflow, execARN string) error {
+	policy := workflow.WorkflowDefinition.AutoRetry
+	if policy == nil || workflow.AutoRetryAt != nil || workflow.AutoRetryAttempt >= policy.MaxAttempts {
+		return nil
+	}
+
+	// the final event of a failed execution describes why it failed
+	historyOutput, err := wm.sfnapi.GetExecutionHistoryWithContext(ctx, &sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(execARN),
+		MaxResults:   aws.Int64(1),
+		ReverseOrder: aws.Bool(true),
+	})
+	if err != nil {
+		return err
+	}
+	var cause, errorName string
+	if len(historyOutput.Events) > 0 {
+		cause, errorName = sfnhistory.CauseAndErrorNameFromFailureEvent(historyOutput.Events[0])
+	}
+
+	matches, err := autoRetryPolicyMatches(policy, errorName, cause)
+	if err != nil || !matches {
+		return err
+	}
+
+	retryAt := strfmt.DateTime(time.Now().Add(autoRetryDelay(policy, workflow.AutoRetryAttempt)))
+	workflow.AutoRetryAt = &retryAt
+	return nil
+}
+
+// startPendingAutoRetry retries a failed workflow once its AutoRetryAt has passed.
+func (wm *SFNWorkflowManager) startPendingAutoRetry(ctx context.Context, workflow *models.Workflow) error {
+	if workflow.AutoRetryAt == nil || workflow.ResolvedByUser ||
+		time.Now().Before(time.Time(*workflow.AutoRetryAt)) {
+		return nil
+	}
+
+	// restart from the first state this workflow ran, unless the failed state can be resumed
+	startAt := workflow.WorkflowDefinition.StateMachine.StartAt
+	input := workflow.Input
+	if workflow.WorkflowDefinition.AutoRetry.Mode != models.AutoRetryModeRestart {
+		if err := wm.UpdateWorkflowHistory(ctx, workflow); err != nil {
+			return err
+		}
+		if job := lastFailedJob(workflow); job != nil {
+			startAt = job.State
+			input = job.Input
+		}
+	}
+
+	// the update loop, GetWorkflowByID and event streams can all get here with copies of the
+	// workflow, so claim the retry in the store first to only start it once
+	retryAt := *workflow.AutoRetryAt
+	if err := wm.store.ClaimAutoRetry(ctx, workflow.ID, retryAt); err != nil {
+		if _, ok := err.(store.ConflictError); ok {
+			workflow.AutoRetryAt = nil
+			return nil
+		}
+		return err
+	}
+
+	ogWorkflow := *workflow
+	ogWorkflow.AutoRetryAt = nil
+	retry, err := wm.retryWorkflow(
+		ctx, ogWorkflow, *workflow.WorkflowDefinition, startAt, input, workflow.AutoRetryAttempt+1,
+	)
+	if err != nil {
+		// give the retry back, so that it's attempted again
+		if updateErr := wm.store.UpdateWorkflow(ctx, *workflow); updateErr != nil {
+			log.ErrorD("restore-auto-retry", logger.M{"workflow-id": workflow.ID, "error": updateErr.Error()})
+		}
+		return err
+	}
+	workflow.AutoRetryAt = nil
+	workflow.Retries = append(workflow.Retries, retry.ID)
+	logAutoRetry(workflow, retry, startAt)
+	return nil
+}
+
+// ClearAutoRetry cancels a workflow's scheduled automatic retry, e.g. because a user resolved,
+// resumed or cancelled it. Like startPendingAutoRetry it claims the retry in the store, so the
+// update loop can't start it concurrently.
+func ClearAutoRetry(ctx context.Context, s store.Store, workflow *models.Workflow) error {
+	if workflow.AutoRetryAt == nil {
+		return nil
+	}
+	if err := s.ClaimAutoRetry(ctx, workflow.ID, *workflow.AutoRetryAt); err != nil {
+		// already claimed, or rescheduled by a later failure
+		if _, ok := err.(store.ConflictError); !ok {
+			return err
+		}
+	}
+	workflow.AutoRetryAt = nil
+	return nil
+}
+
+// autoRetryPolicyMatches checks a failed execution's error name and cause against the policy.
+func autoRetryPolicyMatches(policy *models.AutoRetryPolicy, errorName, cause string) (bool, error) {
+	if len(policy.ErrorEquals) > 0 {
+		matched := false
+		for _, e := range policy.ErrorEquals {
+			if e == errorName || e == "States.ALL" {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil
+		}
+	}
+
+	if policy.StatusReasonPattern != "" {
+		re, err := regexp.Compile(policy.StatusReasonPattern)
+		if err != nil {
+			return false, fmt.Errorf("invalid statusReasonPattern: %s", err)
+		}
+		// same format as Job.StatusReason
+		return re.MatchString(strings.TrimSpace(fmt.Sprintf("%s\n%s", cause, errorName))), nil
+	}
+
+	return true, nil
+}
+
+// autoRetryDelay is the time to wait before an automatic retry, following the policy's backoff.
+func autoRetryDelay(policy *models.AutoRetryPolicy, attempt int64) time.Duration {
+	backoffRate := policy.BackoffRate
+	if backoffRate == 0 {
+		backoffRate = 1.0
+	}
+	seconds := float64(policy.IntervalSeconds) * math.Pow(backoffRate, float64(attempt))
+	return time.Duration(seconds * float64(time.Second))
+}
+
+func lastFailedJob(workflow *models.Workflow) *models.Job {
+	for i := len(workflow.Jobs) - 1; i >= 0; i-- {
+		if workflow.Jobs[i].Status == models.JobStatusFailed {
+			return workflow.Jobs[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/time/rate"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+)
+
+const (
+	// callbackWorkerPrefix prefixes the worker names used to take the tasks of callback states.
+	// Each task is taken by a unique worker name, which SFN records in the ActivityStarted event.
+	callbackWorkerPrefix = "workflow-manager-callback-"
+
+	callbackRegistrationFailed = "workflow-manager.CallbackRegistrationFailed"
+)
+
+// callbackActivities are the activities of callback and child workflow states that workflow-manager polls.
+type callbackActivities struct {
+	mu   sync.Mutex
+	ctx  context.Context
+	arns map[string]callbackActivity
+}
+
+// callbackActivity is the state Resource and namespace an activity was registered for.
+type callbackActivity struct {
+	resource  string
+	namespace string
+}
+
+// registerCallbackActivities creates the activities of a workflow definition's callback and child
+// workflow states, and starts polling them if PollForCallbackTasks is running.
+func (wm *SFNWorkflowManager) registerCallbackActivities(ctx context.Context, wd models.WorkflowDefinition, namespace string) error {
+	for _, state := range wd.StateMachine.States {
+		if state.Type != models.SLStateTypeTask {
+			continue
+		}
+		var activityArn string
+		if resources.IsCallbackResource(state.Resource) {
+			activityArn = sfnconventions.CallbackResource(state.Resource, wm.region, wm.accountID, namespace)
+		} else if resources.IsChildWorkflowResource(state.Resource) {
+			activityArn = sfnconventions.ChildWorkflowResource(state.Resource, wm.region, wm.accountID, namespace)
+		} else {
+			continue
+		}
+		wm.callbacks.mu.Lock()
+		_, ok := wm.callbacks.arns[activityArn]
+		wm.callbacks.mu.Unlock()
+		if ok {
+			continue
+		}
+
+		// CreateActivity is idempotent, so it is safe to call for activities that already exist
+		activityArnParts := strings.Split(activityArn, ":")
+		if _, err := wm.sfnapi.CreateActivityWithContext(ctx, &sfn.CreateActivityInput{
+			Name: aws.String(activityArnParts[len(activityArnParts)-1]),
+		}); err != nil {
+			return err
+		}
+
+		wm.callbacks.mu.Lock()
+		if _, ok := wm.callbacks.arns[activityArn]; !ok {
+			wm.callbacks.arns[activityArn] = callbackActivity{resource: state.Resource, namespace: namespace}
+			if wm.callbacks.ctx != nil {
+				go wm.pollCallbackActivity(wm.callbacks.ctx, activityArn)
+			}
+		}
+		wm.callbacks.mu.Unlock()
+	}
+	return nil
+}
+
+// PollForCallbackTasks takes the tasks of callback states as they start, and saves their task tokens
+// so the states can be completed by SignalWorkflow. Tasks of child workflow states start the child
+// workflow, and are completed once it is done. It blocks until the context is done.
+func (wm *SFNWorkflowManager) PollForCallbackTasks(ctx context.Context) {
+	wm.callbacks.mu.Lock()
+	wm.callbacks.ctx = ctx
+	for activityArn := range wm.callbacks.arns {
+		go wm.pollCallbackActivity(ctx, activityArn)
+	}
+	wm.callbacks.mu.Unlock()
+
+	<-ctx.Done()
+	log.Info("poll-for-callback-tasks-done")
+}
+
+func (wm *SFNWorkflowManager) pollCallbackActivity(ctx context.Context, activityArn string) {
+	// allow one GetActivityTask per second, max 1 at a time
+	limiter := rate.NewLimiter(rate.Every(1*time.Second), 1)
+	for ctx.Err() == nil {
+		if err := limiter.Wait(ctx); err != nil {
+			continue
+		}
+		if err := wm.takeCallbackTask(ctx, activityArn); err != nil {
+			if err == context.Canceled {
+				continue
+			}
+			if aerr, ok := err.(awserr.Error); ok && aerr.Code() == request.CanceledErrorCode {
+				continue
+			}
+			log.ErrorD("take-callback-task", logger.M{"activity-arn": activityArn, "error": err.Error()})
+		}
+	}
+}
+
+// takeCallbackTask waits for a task of a callback state and saves its task token.
+func (wm *SFNWorkflowManager) takeCallbackTask(ctx context.Context, activityArn string) error {
+	wm.callbacks.mu.Lock()
+	activity := wm.callbacks.arns[activityArn]
+	wm.callbacks.mu.Unlock()
+
+	workerName := callbackWorkerPrefix + uuid.NewV4().String()
+	if resources.IsChildWorkflowResource(activity.resource) {
+		workerName = childWorkflowWorkerPrefix + uuid.NewV4().String()
+	}
+	out, err := wm.sfnapi.GetActivityTaskWithContext(ctx, &sfn.GetActivityTaskInput{
+		ActivityArn: aws.String(activityArn),
+		WorkerName:  aws.String(workerName),
+	})
+	if err != nil {
+		return err
+	}
+	if out.TaskToken == nil {
+		return nil
+	}
+	if resources.IsChildWorkflowResource(activity.resource) {
+		return wm.startChildWorkflow(ctx, activity, *out.TaskToken, aws.StringValue(out.Input))
+	}
+
+	if err := wm.store.SaveTaskToken(ctx, workerName, *out.TaskToken); err != nil {
+		// fail the state rather than leave it waiting for a signal that can never be sent
+		if _, sendErr := wm.sfnapi.SendTaskFailureWithContext(ctx, &sfn.SendTaskFailureInput{
+			TaskToken: out.TaskToken,
+			Error:     aws.String(callbackRegistrationFailed),
+			Cause:     aws.String(err.Error()),
+		}); sendErr != nil {
+			log.ErrorD("send-task-failure", logger.M{"activity-arn": activityArn, "error": sendErr.Error()})
+		}
+		return err
+	}
+	log.TraceD("take-callback-task", logger.M{"activity-arn": activityArn, "worker-name": workerName})
+	return nil
+}
+
+// setTaskTokens exposes the task tokens of callback states that are waiting for a signal on their jobs.
+func (wm *SFNWorkflowManager) setTaskTokens(ctx context.Context, jobs []*models.Job) error {
+	for _, job := range jobs {
+		if job.Status != models.JobStatusRunning || !strings.HasPrefix(job.Container, callbackWorkerPrefix) {
+			continue
+		}
+		token, err := wm.store.GetTaskToken(ctx, job.Container)
+		if err != nil {
+			if _, ok := err.(models.NotFound); ok {
+				continue
+			}
+			return err
+		}
+		job.TaskToken = token
+	}
+	return nil
+}
+
+// SignalWorkflow completes a callback state that is waiting for a signal, failing it if the
+// signal has an error.
+func (wm *SFNWorkflowManager) SignalWorkflow(ctx context.Context, workflow *models.Workflow, state string, signal models.SignalRequest) error {
+	if err := wm.UpdateWorkflowHistory(ctx, workflow); err != nil {
+		return err
+	}
+	var token string
+	for _, job := range workflow.Jobs {
+		if job.State == state && job.Status == models.JobStatusRunning && job.TaskToken != "" {
+			token = job.TaskToken
+		}
+	}
+	if token == "" {
+		return models.Conflict{
+			Message: fmt.Sprintf("state %s of workflow %s is not waiting for a signal", state, workflow.ID),
+		}
+	}
+
+	var err error
+	if signal.Error != "" {
+		_, err = wm.sfnapi.SendTaskFailureWithContext(ctx, &sfn.SendTaskFailureInput{
+			TaskToken: aws.String(token),
+			Error:     aws.String(signal.Error),
+			Cause:     aws.String(signal.Cause),
+		})
+	} else {
+		output := signal.Output
+		if output == "" {
+			output = "{}"
+		}
+		_, err = wm.sfnapi.SendTaskSuccessWithContext(ctx, &sfn.SendTaskSuccessInput{
+			TaskToken: aws.String(token),
+			Output:    aws.String(output),
+		})
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case sfn.ErrCodeTaskDoesNotExist, sfn.ErrCodeTaskTimedOut:
+			return models.Conflict{Message: aerr.Message()}
+		case sfn.ErrCodeInvalidOutput:
+			return models.BadRequest{Message: aerr.Message()}
+		}
+	}
+	return err
+}
@@ -0,0 +1,205 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+)
+
+const (
+	// childWorkflowWorkerPrefix prefixes the worker names used to take the tasks of child workflow states.
+	childWorkflowWorkerPrefix = "workflow-manager-workflow-"
+
+	childWorkflowStartFailed = "workflow-manager.ChildWorkflowStartFailed"
+	childWorkflowFailed      = "workflow-manager.ChildWorkflowFailed"
+)
+
+// childWorkflowParameters are the Parameters of child workflow states. They pass the parent's
+// workflow ID, i.e. its execution name, from the context object along with the state's input, since
+// the _EXECUTION_NAME of the execution's input doesn't survive InputPath or ResultPath.
+var childWorkflowParameters = map[string]interface{}{
+	"_PARENT_WORKFLOW_ID.$": "$$.Execution.Name",
+	"_INPUT.$":              "$",
+}
+
+// childWorkflowTask is the input of a child workflow state's task, as passed by childWorkflowParameters.
+type childWorkflowTask struct {
+	ParentWorkflowID string          `json:"_PARENT_WORKFLOW_ID"`
+	Input            json.RawMessage `json:"_INPUT"`
+}
+
+// withChildWorkflowParameters adds childWorkflowParameters to the child workflow states of a
+// state machine's definition. SLState doesn't model Parameters, so they're added to its JSON.
+func withChildWorkflowParameters(definition []byte, sm models.SLStateMachine) ([]byte, error) {
+	childStates := []string{}
+	for name, state := range sm.States {
+		if state.Type == models.SLStateTypeTask && resources.IsChildWorkflowResource(state.Resource) {
+			childStates = append(childStates, name)
+		}
+	}
+	if len(childStates) == 0 {
+		return definition, nil
+	}
+
+	var def map[string]interface{}
+	if err := json.Unmarshal(definition, &def); err != nil {
+		return nil, err
+	}
+	states, _ := def["States"].(map[string]interface{})
+	for _, name := range childStates {
+		if state, ok := states[name].(map[string]interface{}); ok {
+			state["Parameters"] = childWorkflowParameters
+		}
+	}
+	return json.MarshalIndent(def, "", "  ")
+}
+
+// startChildWorkflow starts the child workflow for the task of a child workflow state, failing the
+// state if the child can't be started.
+func (wm *SFNWorkflowManager) startChildWorkflow(ctx context.Context, activity callbackActivity, token, input string) error {
+	child, err := wm.createChildWorkflow(ctx, activity, token, input)
+	if err != nil {
+		if _, sendErr := wm.sfnapi.SendTaskFailureWithContext(ctx, &sfn.SendTaskFailureInput{
+			TaskToken: aws.String(token),
+			Error:     aws.String(childWorkflowStartFailed),
+			Cause:     aws.String(err.Error()),
+		}); sendErr != nil {
+			log.ErrorD("send-task-failure", logger.M{"resource": activity.resource, "error": sendErr.Error()})
+		}
+		return err
+	}
+	if child.ParentWorkflowID == "" {
+		return nil
+	}
+
+	return wm.store.AppendChildWorkflowID(ctx, child.ParentWorkflowID, child.ID)
+}
+
+func (wm *SFNWorkflowManager) createChildWorkflow(ctx context.Context, activity callbackActivity, token, input string) (*models.Workflow, error) {
+	name, version, err := resources.ParseChildWorkflowResource(activity.resource)
+	if err != nil {
+		return nil, err
+	}
+	var def models.WorkflowDefinition
+	if version < 0 {
+		def, err = wm.store.LatestWorkflowDefinition(ctx, name)
+	} else {
+		def, err = wm.store.GetWorkflowDefinition(ctx, name, version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	parentID, stateInput, err := parseChildWorkflowTask(input)
+	if err != nil {
+		return nil, err
+	}
+	var inputJSON map[string]interface{}
+	if err := json.Unmarshal(stateInput, &inputJSON); err != nil {
+		return nil, fmt.Errorf("input is not a valid JSON object: %s", err)
+	}
+	delete(inputJSON, "_EXECUTION_NAME")
+	childInput, err := json.Marshal(inputJSON)
+	if err != nil {
+		return nil, err
+	}
+	if err := resources.ValidateInput(def, string(childInput)); err != nil {
+		return nil, err
+	}
+
+	queue := "default"
+	if parentID != "" {
+		parent, err := wm.store.GetWorkflowByID(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		queue = parent.Queue
+	}
+
+	return wm.createWorkflow(
+		ctx, def, string(childInput), activity.namespace, queue, map[string]interface{}{},
+		func(child *models.Workflow) error {
+			child.ParentWorkflowID = parentID
+			// save the task token first so that it can be found however quickly the child finishes
+			return wm.store.SaveTaskToken(ctx, child.ID, token)
+		},
+	)
+}
+
+// parseChildWorkflowTask returns the parent's workflow ID and the state's input from the input of a
+// child workflow state's task.
+func parseChildWorkflowTask(input string) (string, []byte, error) {
+	var task childWorkflowTask
+	if err := json.Unmarshal([]byte(input), &task); err != nil {
+		return "", nil, fmt.Errorf("input is not a valid JSON object: %s", err)
+	}
+	if task.ParentWorkflowID != "" {
+		return task.ParentWorkflowID, task.Input, nil
+	}
+
+	// state machines created before child workflow states had Parameters pass the state's input
+	// as is, which has the parent's execution name unless InputPath dropped it
+	var inputJSON map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &inputJSON); err != nil {
+		return "", nil, fmt.Errorf("input is not a valid JSON object: %s", err)
+	}
+	parentID, _ := inputJSON["_EXECUTION_NAME"].(string)
+	return parentID, []byte(input), nil
+}
+
+// completeParentTask completes the parent's child workflow state once the child workflow is done,
+// with the child's output if it succeeded.
+func (wm *SFNWorkflowManager) completeParentTask(ctx context.Context, workflow *models.Workflow) error {
+	token, err := wm.store.GetTaskToken(ctx, workflow.ID)
+	if err != nil {
+		if _, ok := err.(models.NotFound); ok {
+			return nil
+		}
+		return err
+	}
+
+	if workflow.Status == models.WorkflowStatusSucceeded {
+		output := workflow.Output
+		if output == "" {
+			output = "{}"
+		}
+		_, err = wm.sfnapi.SendTaskSuccessWithContext(ctx, &sfn.SendTaskSuccessInput{
+			TaskToken: aws.String(token),
+			Output:    aws.String(output),
+		})
+		return err
+	}
+	_, err = wm.sfnapi.SendTaskFailureWithContext(ctx, &sfn.SendTaskFailureInput{
+		TaskToken: aws.String(token),
+		Error:     aws.String(childWorkflowFailed),
+		Cause: aws.String(strings.TrimSpace(fmt.Sprintf(
+			"child workflow %s %s\n%s", workflow.ID, workflow.Status, workflow.StatusReason,
+		))),
+	})
+	return err
+}
+
+// cancelChildWorkflows cancels the active child workflows of a cancelled workflow.
+func (wm *SFNWorkflowManager) cancelChildWorkflows(ctx context.Context, workflow *models.Workflow, reason string) error {
+	for _, childID := range workflow.ChildWorkflowIDs {
+		child, err := wm.store.GetWorkflowByID(ctx, childID)
+		if err != nil {
+			return err
+		}
+		if resources.WorkflowStatusIsDone(&child) {
+			continue
+		}
+		childReason := fmt.Sprintf("parent workflow %s cancelled: %s", workflow.ID, reason)
+		if err := wm.CancelWorkflow(ctx, &child, childReason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package executor
+
+import (
+	"context"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+)
+
+// enforceDeadlines flags an active workflow that has passed its soft deadline, and cancels one
+// that has passed its max age.
+func (wm *SFNWorkflowManager) enforceDeadlines(ctx context.Context, workflow *models.Workflow) error {
+	deadlines := workflow.WorkflowDefinition.Deadlines
+	if deadlines == nil {
+		return nil
+	}
+	age := time.Since(time.Time(workflow.CreatedAt))
+
+	if deadlines.MaxAgeSeconds > 0 && age > time.Duration(deadlines.MaxAgeSeconds)*time.Second {
+		// the workflow stays running until SFN stops the execution, so only cancel it once
+		if workflow.StatusReason == resources.StatusReasonWorkflowMaxAgeExceeded {
+			return nil
+		}
+		logMaxAgeExceeded(workflow, age)
+		return wm.CancelWorkflow(ctx, workflow, resources.StatusReasonWorkflowMaxAgeExceeded)
+	}
+
+	if deadlines.SoftDeadlineSeconds > 0 && !workflow.SoftDeadlineBreached &&
+		age > time.Duration(deadlines.SoftDeadlineSeconds)*time.Second {
+		workflow.SoftDeadlineBreached = true
+		logSoftDeadlineBreached(workflow, age)
+	}
+
+	return nil
+}
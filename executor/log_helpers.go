@@ -23,6 +23,17 @@ func logJobStatus(job *models.Job, workflow *models.Workflow) {
 	})
 }
 
+func logJobContractViolation(job *models.Job, workflow *models.Workflow) {
+	log.WarnD("job-contract-violation", logger.M{
+		"id":          job.ID,
+		"workflow-id": workflow.ID,
+		"name":        workflow.WorkflowDefinition.Name,
+		"version":     workflow.WorkflowDefinition.Version,
+		"state":       job.State,
+		"violation":   job.ContractViolation,
+	})
+}
+
 func logWorkflowStatusChange(workflow *models.Workflow, previousStatus models.WorkflowStatus) {
 	// If the status was not changed, ignore logging
 	if previousStatus == workflow.Status {
@@ -35,11 +46,64 @@ func logWorkflowStatusChange(workflow *models.Workflow, previousStatus models.Wo
 		"version":         workflow.WorkflowDefinition.Version,
 		"previous-status": previousStatus,
 		"status":          workflow.Status,
+		"rollout-arm":     workflow.RolloutArm,
 		// 0 -> running; 1 -> failed; -1 -> cancelled
 		"value": resources.WorkflowStatusToInt(workflow.Status),
 	})
 }
 
+func logAutoRetry(workflow *models.Workflow, retry *models.Workflow, startAt string) {
+	log.InfoD("workflow-auto-retry", logger.M{
+		"id":       workflow.ID,
+		"retry-id": retry.ID,
+		"name":     workflow.WorkflowDefinition.Name,
+		"version":  workflow.WorkflowDefinition.Version,
+		"start-at": startAt,
+		"attempt":  retry.AutoRetryAttempt,
+	})
+}
+
+func logSoftDeadlineBreached(workflow *models.Workflow, age time.Duration) {
+	log.WarnD("workflow-soft-deadline-breached", logger.M{
+		"id":          workflow.ID,
+		"name":        workflow.WorkflowDefinition.Name,
+		"version":     workflow.WorkflowDefinition.Version,
+		"status":      workflow.Status,
+		"age-seconds": int(age / time.Second),
+	})
+}
+
+func logMaxAgeExceeded(workflow *models.Workflow, age time.Duration) {
+	log.WarnD("workflow-max-age-exceeded", logger.M{
+		"id":          workflow.ID,
+		"name":        workflow.WorkflowDefinition.Name,
+		"version":     workflow.WorkflowDefinition.Version,
+		"status":      workflow.Status,
+		"age-seconds": int(age / time.Second),
+	})
+}
+
+func logWorkflowTriggered(workflow *models.Workflow, triggered *models.Workflow) {
+	log.InfoD("workflow-triggered", logger.M{
+		"id":           workflow.ID,
+		"triggered-id": triggered.ID,
+		"name":         workflow.WorkflowDefinition.Name,
+		"status":       workflow.Status,
+		"trigger-name": triggered.WorkflowDefinition.Name,
+		"depth":        triggered.TriggerDepth,
+	})
+}
+
+func logTriggerDepthExceeded(workflow *models.Workflow, trigger *models.WorkflowTrigger) {
+	log.WarnD("workflow-trigger-depth-exceeded", logger.M{
+		"id":           workflow.ID,
+		"name":         workflow.WorkflowDefinition.Name,
+		"status":       workflow.Status,
+		"trigger-name": trigger.WorkflowDefinitionName,
+		"depth":        workflow.TriggerDepth,
+	})
+}
+
 func logPendingWorkflowUpdateLag(wf models.Workflow) {
 	log.TraceD("pending-workflow-update-lag", logger.M{
 		"id": wf.ID,
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/Clever/kayvee-go.v6/logger"
@@ -34,6 +35,97 @@ func TestRoutingRules(t *testing.T) {
 		assert.Equal(t, 1, counts["update-loop-lag-alert"])
 	})
 
+	t.Run("workflow-auto-retry", func(t *testing.T) {
+		mocklog := logger.NewMockCountLogger("workflow-manager")
+		log = mocklog
+		logAutoRetry(&models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				ID:                 "id",
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "name"},
+			},
+		}, &models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{ID: "retry-id", AutoRetryAttempt: 1},
+		}, "start-state")
+		counts := mocklog.RuleCounts()
+		assert.Equal(t, 1, len(counts))
+		assert.Contains(t, counts, "workflow-auto-retry")
+		assert.Equal(t, 1, counts["workflow-auto-retry"])
+	})
+
+	t.Run("workflow-deadlines", func(t *testing.T) {
+		mocklog := logger.NewMockCountLogger("workflow-manager")
+		log = mocklog
+		workflow := &models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				ID:                 "id",
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "name"},
+			},
+		}
+		logSoftDeadlineBreached(workflow, time.Hour)
+		logMaxAgeExceeded(workflow, 2*time.Hour)
+		counts := mocklog.RuleCounts()
+		assert.Equal(t, 2, len(counts))
+		assert.Equal(t, 1, counts["workflow-soft-deadline-breached"])
+		assert.Equal(t, 1, counts["workflow-max-age-exceeded"])
+	})
+
+	t.Run("workflow-triggers", func(t *testing.T) {
+		mocklog := logger.NewMockCountLogger("workflow-manager")
+		log = mocklog
+		workflow := &models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				ID:                 "id",
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "name"},
+			},
+		}
+		logWorkflowTriggered(workflow, &models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				ID:                 "triggered-id",
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "trigger-name"},
+				TriggerDepth:       1,
+			},
+		})
+		logTriggerDepthExceeded(workflow, &models.WorkflowTrigger{WorkflowDefinitionName: "trigger-name"})
+		counts := mocklog.RuleCounts()
+		assert.Equal(t, 2, len(counts))
+		assert.Equal(t, 1, counts["workflow-triggered"])
+		assert.Equal(t, 1, counts["workflow-trigger-depth-exceeded"])
+	})
+
+	t.Run("workflow-status-by-version", func(t *testing.T) {
+		mocklog := logger.NewMockCountLogger("workflow-manager")
+		log = mocklog
+		logWorkflowStatusChange(&models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				ID:                 "id",
+				Status:             models.WorkflowStatusFailed,
+				RolloutArm:         resources.RolloutArmCanary,
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "name", Version: 2},
+			},
+		}, models.WorkflowStatusRunning)
+		counts := mocklog.RuleCounts()
+		assert.Equal(t, 1, len(counts))
+		assert.Equal(t, 1, counts["workflow-status-by-version"])
+	})
+
+	t.Run("job-contract-violation", func(t *testing.T) {
+		mocklog := logger.NewMockCountLogger("workflow-manager")
+		log = mocklog
+		logJobContractViolation(&models.Job{
+			ID:                "1",
+			State:             "state",
+			ContractViolation: "(root): id is required",
+		}, &models.Workflow{
+			WorkflowSummary: models.WorkflowSummary{
+				ID:                 "id",
+				WorkflowDefinition: &models.WorkflowDefinition{Name: "name"},
+			},
+		})
+		counts := mocklog.RuleCounts()
+		assert.Equal(t, 1, len(counts))
+		assert.Equal(t, 1, counts["job-contract-violation"])
+	})
+
 	t.Run("aws-sdk-go-counter", func(t *testing.T) {
 		mocklog := logger.NewMockCountLogger("workflow-manager")
 		log = mocklog
@@ -0,0 +1,128 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
+	lru "github.com/hashicorp/golang-lru"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// resourceCacheTTL is how long a resource is remembered to exist, so that workflows can be started
+// without describing all of their resources each time.
+const resourceCacheTTL = 5 * time.Minute
+
+// accessDeniedCode is the error code of SFN and lambda API calls that aren't permitted.
+const accessDeniedCode = "AccessDeniedException"
+
+// resourceChecker checks that the activities and lambda functions of state machines exist.
+// Executions that run into a missing resource otherwise fail with a cryptic "Internal Error", see
+// sfnhistory.IsActivityDoesntExistFailure.
+type resourceChecker struct {
+	sfnapi    sfniface.SFNAPI
+	lambdaapi lambdaiface.LambdaAPI
+	// found holds when each resource was last found to exist
+	found *lru.Cache
+}
+
+func newResourceChecker(sfnapi sfniface.SFNAPI, lambdaapi lambdaiface.LambdaAPI) *resourceChecker {
+	found, err := lru.New(1000)
+	if err != nil {
+		// only happens for sizes <= 0
+		panic(err)
+	}
+	return &resourceChecker{sfnapi: sfnapi, lambdaapi: lambdaapi, found: found}
+}
+
+// checkStateMachine returns a BadRequest naming the resources of a state machine that don't exist.
+func (c *resourceChecker) checkStateMachine(ctx context.Context, sm *sfn.DescribeStateMachineOutput, wd models.WorkflowDefinition, namespace string) error {
+	missing, err := c.missingResources(ctx, aws.StringValue(sm.Definition))
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return models.BadRequest{
+		Message: fmt.Sprintf("resources of workflow definition %s don't exist in namespace %s: %s",
+			wd.Name, namespace, strings.Join(missing, ", ")),
+	}
+}
+
+// missingResources returns the ARNs of the Task states of a state machine definition that don't
+// exist. Resources that aren't activities or lambda functions aren't checked.
+func (c *resourceChecker) missingResources(ctx context.Context, definition string) ([]string, error) {
+	if definition == "" {
+		return nil, nil
+	}
+	var sm models.SLStateMachine
+	if err := json.Unmarshal([]byte(definition), &sm); err != nil {
+		return nil, fmt.Errorf("invalid state machine definition: %s", err)
+	}
+
+	missing := []string{}
+	checked := map[string]bool{}
+	for _, state := range sm.States {
+		if state.Type != models.SLStateTypeTask || checked[state.Resource] {
+			continue
+		}
+		checked[state.Resource] = true
+		exists, err := c.exists(ctx, state.Resource)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing = append(missing, state.Resource)
+		}
+	}
+	sort.Strings(missing)
+	return missing, nil
+}
+
+func (c *resourceChecker) exists(ctx context.Context, arn string) (bool, error) {
+	if foundAt, ok := c.found.Get(arn); ok && time.Since(foundAt.(time.Time)) < resourceCacheTTL {
+		return true, nil
+	}
+
+	var err error
+	var notFoundCode string
+	switch {
+	case strings.HasPrefix(arn, "arn:aws:states:") && strings.Contains(arn, ":activity:"):
+		_, err = c.sfnapi.DescribeActivityWithContext(ctx, &sfn.DescribeActivityInput{
+			ActivityArn: aws.String(arn),
+		})
+		notFoundCode = sfn.ErrCodeActivityDoesNotExist
+	case strings.HasPrefix(arn, "arn:aws:lambda:") && c.lambdaapi != nil:
+		_, err = c.lambdaapi.GetFunctionWithContext(ctx, &lambda.GetFunctionInput{
+			FunctionName: aws.String(arn),
+		})
+		notFoundCode = lambda.ErrCodeResourceNotFoundException
+	default:
+		return true, nil
+	}
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == notFoundCode {
+			return false, nil
+		} else if ok && aerr.Code() == accessDeniedCode {
+			// deployments without states:DescribeActivity or lambda:GetFunction can still start
+			// workflows, they just aren't checked
+			log.ErrorD("check-resource-access-denied", logger.M{"arn": arn, "error": err.Error()})
+			return true, nil
+		}
+		return false, err
+	}
+	c.found.Add(arn, time.Now())
+	return true, nil
+}
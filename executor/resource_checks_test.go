@@ -0,0 +1,80 @@
+package executor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/mocks"
+)
+
+func TestResourceChecker(t *testing.T) {
+	ctx := context.Background()
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	mockLambdaAPI := mocks.NewMockLambdaAPI(mockController)
+	checker := newResourceChecker(mockSFNAPI, mockLambdaAPI)
+
+	const (
+		activity        = "arn:aws:states:us-west-2:000000000000:activity:namespace--worker"
+		missingActivity = "arn:aws:states:us-west-2:000000000000:activity:namespace--missing-worker"
+		function        = "arn:aws:lambda:us-west-2:000000000000:function:namespace--function"
+		missingFunction = "arn:aws:lambda:us-west-2:000000000000:function:namespace--missing-function"
+	)
+	definition := `{
+		"StartAt": "worker",
+		"States": {
+			"worker": {"Type": "Task", "Resource": "` + activity + `", "Next": "again"},
+			"again": {"Type": "Task", "Resource": "` + activity + `", "Next": "missing-worker"},
+			"missing-worker": {"Type": "Task", "Resource": "` + missingActivity + `", "Next": "function"},
+			"function": {"Type": "Task", "Resource": "` + function + `", "Next": "missing-function"},
+			"missing-function": {"Type": "Task", "Resource": "` + missingFunction + `", "Next": "done"},
+			"done": {"Type": "Succeed"}
+		}
+	}`
+
+	// resources that exist are only described once, since they are cached
+	mockSFNAPI.EXPECT().
+		DescribeActivityWithContext(gomock.Any(), &sfn.DescribeActivityInput{ActivityArn: aws.String(activity)}).
+		Return(&sfn.DescribeActivityOutput{}, nil)
+	mockSFNAPI.EXPECT().
+		DescribeActivityWithContext(gomock.Any(), &sfn.DescribeActivityInput{ActivityArn: aws.String(missingActivity)}).
+		Return(nil, awserr.New(sfn.ErrCodeActivityDoesNotExist, "", nil)).
+		Times(2)
+	mockLambdaAPI.EXPECT().
+		GetFunctionWithContext(gomock.Any(), &lambda.GetFunctionInput{FunctionName: aws.String(function)}).
+		Return(&lambda.GetFunctionOutput{}, nil)
+	mockLambdaAPI.EXPECT().
+		GetFunctionWithContext(gomock.Any(), &lambda.GetFunctionInput{FunctionName: aws.String(missingFunction)}).
+		Return(nil, awserr.New(lambda.ErrCodeResourceNotFoundException, "", nil)).
+		Times(2)
+
+	for i := 0; i < 2; i++ {
+		missing, err := checker.missingResources(ctx, definition)
+		require.NoError(t, err)
+		assert.Equal(t, []string{missingFunction, missingActivity}, missing)
+	}
+
+	t.Log("Errors other than missing resources are returned")
+	mockSFNAPI.EXPECT().
+		DescribeActivityWithContext(gomock.Any(), gomock.Any()).
+		Return(nil, awserr.New("ThrottlingException", "", nil))
+	_, err := checker.missingResources(ctx, `{"States": {"worker": {"Type": "Task", "Resource": "`+missingActivity+`"}}}`)
+	assert.Error(t, err)
+
+	t.Log("Resources that can't be described for lack of permissions aren't reported missing")
+	mockSFNAPI.EXPECT().
+		DescribeActivityWithContext(gomock.Any(), gomock.Any()).
+		Return(nil, awserr.New("AccessDeniedException", "", nil))
+	missing, err := checker.missingResources(ctx, `{"States": {"worker": {"Type": "Task", "Resource": "`+missingActivity+`"}}}`)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+}
@@ -23,7 +23,8 @@ func New(sfnapi sfniface.SFNAPI) (sfniface.SFNAPI, error) {
 	}, nil
 }
 
-// DescribeStateMachine is cached aggressively since state machines are immutable.
+// DescribeStateMachine is cached aggressively since state machines are only changed through
+// UpdateStateMachine.
 func (s *SFNCache) DescribeStateMachine(i *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error) {
 	cacheKey := i.String()
 	cacheVal, ok := s.describeStateMachineCache.Get(cacheKey)
@@ -37,3 +38,10 @@ func (s *SFNCache) DescribeStateMachine(i *sfn.DescribeStateMachineInput) (*sfn.
 	s.describeStateMachineCache.Add(cacheKey, out)
 	return out, nil
 }
+
+// UpdateStateMachine evicts the cached description of the state machine it updates.
+func (s *SFNCache) UpdateStateMachine(i *sfn.UpdateStateMachineInput) (*sfn.UpdateStateMachineOutput, error) {
+	out, err := s.SFNAPI.UpdateStateMachine(i)
+	s.describeStateMachineCache.Remove((&sfn.DescribeStateMachineInput{StateMachineArn: i.StateMachineArn}).String())
+	return out, err
+}
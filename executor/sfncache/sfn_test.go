@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Clever/workflow-manager/mocks"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -26,3 +27,34 @@ func TestDescribeStateMachineCache(t *testing.T) {
 		require.Equal(t, expectedOutput, output)
 	}
 }
+
+func TestUpdateStateMachineEvictsCache(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+	arn := "arn:aws:states:us-west-2:000000000000:stateMachine:sm"
+	mockSFNAPI := mocks.NewMockSFNAPI(mockController)
+	gomock.InOrder(
+		mockSFNAPI.EXPECT().
+			DescribeStateMachine(gomock.Any()).
+			Return(&sfn.DescribeStateMachineOutput{Definition: aws.String("old")}, nil),
+		mockSFNAPI.EXPECT().
+			UpdateStateMachine(gomock.Any()).
+			Return(&sfn.UpdateStateMachineOutput{}, nil),
+		mockSFNAPI.EXPECT().
+			DescribeStateMachine(gomock.Any()).
+			Return(&sfn.DescribeStateMachineOutput{Definition: aws.String("new")}, nil),
+	)
+	cachedSFN, err := New(mockSFNAPI)
+	require.Nil(t, err)
+	output, err := cachedSFN.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: aws.String(arn)})
+	require.Nil(t, err)
+	require.Equal(t, "old", aws.StringValue(output.Definition))
+	_, err = cachedSFN.UpdateStateMachine(&sfn.UpdateStateMachineInput{
+		StateMachineArn: aws.String(arn),
+		Definition:      aws.String("new"),
+	})
+	require.Nil(t, err)
+	output, err = cachedSFN.DescribeStateMachine(&sfn.DescribeStateMachineInput{StateMachineArn: aws.String(arn)})
+	require.Nil(t, err)
+	require.Equal(t, "new", aws.StringValue(output.Definition))
+}
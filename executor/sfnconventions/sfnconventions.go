@@ -59,6 +59,18 @@ func LambdaResource(wdResource, region, accountID, namespace string) string {
 	return fmt.Sprintf("arn:aws:lambda:%s:%s:function:%s--%s", region, accountID, namespace, strings.TrimPrefix(wdResource, "lambda:"))
 }
 
+// CallbackResource is the activity ARN workflow-manager polls for the tasks of callback states.
+func CallbackResource(wdResource, region, accountID, namespace string) string {
+	return fmt.Sprintf("arn:aws:states:%s:%s:activity:%s--workflow-manager-callback-%s", region, accountID, namespace, strings.TrimPrefix(wdResource, "callback:"))
+}
+
+// ChildWorkflowResource is the activity ARN workflow-manager polls for the tasks of child workflow
+// states, e.g. "workflow:name:2" is polled through the activity "<namespace>--workflow-manager-workflow-name--2".
+func ChildWorkflowResource(wdResource, region, accountID, namespace string) string {
+	name := strings.Replace(strings.TrimPrefix(wdResource, "workflow:"), ":", "--", -1)
+	return fmt.Sprintf("arn:aws:states:%s:%s:activity:%s--workflow-manager-workflow-%s", region, accountID, namespace, name)
+}
+
 // EmbeddedResourceArn is the activity ARN registered by embedded WFM.
 func EmbeddedResourceArn(wdResource, region, accountID, namespace string, app string) string {
 	return fmt.Sprintf("arn:aws:states:%s:%s:activity:%s--%s-%s", region, accountID, namespace, app, wdResource)
@@ -0,0 +1,310 @@
+package sfnhistory
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/go-openapi/strfmt"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+)
+
+const maxFailureReasonLines = 3
+
+var log = logger.New("workflow-manager")
+
+// ResourceSourceFunc reports whether the ARN a Task state with a Resource ran with came from a
+// registered StateResource or from naming convention.
+type ResourceSourceFunc func(arn, resource string) models.StateResourceSource
+
+// Parser builds the jobs of a workflow from the events of its SFN execution history.
+// Each Job corresponds to a type={Task,Choice,Succeed} state, i.e. States we have currently tested and supported completely
+// We only create a Job object if the State has been entered.
+// Execution history events contain a "previous" event ID which is the "parent" event within the execution tree.
+// E.g., if a state machine has two parallel Task states, the events for these states will overlap in the history, but the event IDs + previous event IDs will link together the parallel execution paths.
+// In order to correctly associate events with the job they correspond to, maintain a map from event ID to job.
+type Parser struct {
+	workflow       *models.Workflow
+	resourceSource ResourceSourceFunc
+	jobs           []*models.Job
+	eventIDToJob   map[int64]*models.Job
+}
+
+// NewParser creates a Parser for the history of a workflow. resourceSource may be nil, in which
+// case the Source of the jobs' StateResources is left unset.
+func NewParser(workflow *models.Workflow, resourceSource ResourceSourceFunc) *Parser {
+	return &Parser{
+		workflow:       workflow,
+		resourceSource: resourceSource,
+		jobs:           []*models.Job{},
+		eventIDToJob:   map[int64]*models.Job{},
+	}
+}
+
+// Jobs returns the jobs parsed so far, in the order their states were entered.
+func (p *Parser) Jobs() []*models.Job {
+	return p.jobs
+}
+
+func (p *Parser) eventToJob(evt *sfn.HistoryEvent) *models.Job {
+	eventID := aws.Int64Value(evt.Id)
+	parentEventID := aws.Int64Value(evt.PreviousEventId)
+	switch *evt.Type {
+	case sfn.HistoryEventTypeExecutionStarted:
+		// very first event for an execution, so there are no jobs yet
+		return nil
+	case sfn.HistoryEventTypePassStateEntered, sfn.HistoryEventTypePassStateExited,
+		sfn.HistoryEventTypeParallelStateEntered, sfn.HistoryEventTypeParallelStateExited,
+		sfn.HistoryEventTypeWaitStateEntered, sfn.HistoryEventTypeWaitStateExited,
+		sfn.HistoryEventTypeFailStateEntered:
+		// only create Jobs for Task, Choice and Succeed states
+		return nil
+	case sfn.HistoryEventTypeTaskStateEntered, sfn.HistoryEventTypeChoiceStateEntered, sfn.HistoryEventTypeSucceedStateEntered:
+		// a job is created when a supported state is entered
+		job := &models.Job{}
+		p.jobs = append(p.jobs, job)
+		p.eventIDToJob[eventID] = job
+		return job
+	case sfn.HistoryEventTypeExecutionAborted, sfn.HistoryEventTypeExecutionFailed, sfn.HistoryEventTypeExecutionTimedOut:
+		// Execution-level event - update last seen job.
+		if len(p.jobs) == 0 {
+			return nil
+		}
+		return p.jobs[len(p.jobs)-1]
+	default:
+		// associate this event with the same job as its parent event
+		job, ok := p.eventIDToJob[parentEventID]
+		if !ok {
+			// we should investigate these cases, since it means we have a gap in our interpretation of the event history
+			log.ErrorD("event-with-unknown-job", logger.M{"event-id": eventID, "workflow-id": p.workflow.ID})
+			return nil
+		}
+		p.eventIDToJob[eventID] = job
+		return job
+	}
+}
+
+// AddEvents updates the jobs with a page of history events, oldest first.
+func (p *Parser) AddEvents(events []*sfn.HistoryEvent) {
+	wd := p.workflow.WorkflowDefinition
+	for _, evt := range events {
+		job := p.eventToJob(evt)
+		if job == nil {
+			continue
+		}
+		switch aws.StringValue(evt.Type) {
+		case sfn.HistoryEventTypeTaskStateEntered, sfn.HistoryEventTypeChoiceStateEntered, sfn.HistoryEventTypeSucceedStateEntered:
+			// event IDs start at 1 and are only unique to the execution, so this might not be ideal
+			job.ID = fmt.Sprintf("%d", aws.Int64Value(evt.Id))
+			job.Attempts = []*models.JobAttempt{}
+			job.CreatedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			if *evt.Type != sfn.HistoryEventTypeTaskStateEntered {
+				// Non-task states technically start immediately, since they don't wait on resources:
+				job.StartedAt = job.CreatedAt
+			}
+			job.Status = models.JobStatusCreated
+			if details := evt.StateEnteredEventDetails; details != nil {
+				stateName := aws.StringValue(details.Name)
+				var stateResourceName string
+				var stateResourceType models.StateResourceType
+				stateDef, ok := wd.StateMachine.States[stateName]
+				if ok {
+					if strings.HasPrefix(stateDef.Resource, "lambda:") {
+						stateResourceName = strings.TrimPrefix(stateDef.Resource, "lambda:")
+						stateResourceType = models.StateResourceTypeLambdaFunctionARN
+					} else if resources.IsCallbackResource(stateDef.Resource) {
+						stateResourceName = strings.TrimPrefix(stateDef.Resource, "callback:")
+						stateResourceType = models.StateResourceTypeActivityARN
+					} else if resources.IsChildWorkflowResource(stateDef.Resource) {
+						stateResourceName = strings.TrimPrefix(stateDef.Resource, "workflow:")
+						stateResourceType = models.StateResourceTypeActivityARN
+					} else {
+						stateResourceName = stateDef.Resource
+						stateResourceType = models.StateResourceTypeActivityARN
+					}
+				}
+				job.Input = aws.StringValue(details.Input)
+				job.State = stateName
+
+				job.StateResource = &models.StateResource{
+					Name:        stateResourceName,
+					Type:        stateResourceType,
+					Namespace:   p.workflow.Namespace,
+					LastUpdated: strfmt.DateTime(aws.TimeValue(evt.Timestamp)),
+				}
+			}
+		case sfn.HistoryEventTypeActivityScheduled, sfn.HistoryEventTypeLambdaFunctionScheduled:
+			if job.Status == models.JobStatusFailed {
+				// this is a retry, copy job data to attempt array, re-initialize job data
+				oldJobData := *job
+				*job = models.Job{}
+				job.ID = fmt.Sprintf("%d", aws.Int64Value(evt.Id))
+				job.Attempts = append(oldJobData.Attempts, &models.JobAttempt{
+					Reason:    oldJobData.StatusReason,
+					CreatedAt: oldJobData.CreatedAt,
+					StartedAt: oldJobData.StartedAt,
+					StoppedAt: oldJobData.StoppedAt,
+					TaskARN:   oldJobData.Container,
+				})
+				job.CreatedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+				job.Input = oldJobData.Input
+				job.State = oldJobData.State
+				job.StateResource = &models.StateResource{
+					Name:        oldJobData.StateResource.Name,
+					Type:        oldJobData.StateResource.Type,
+					Namespace:   oldJobData.StateResource.Namespace,
+					LastUpdated: strfmt.DateTime(aws.TimeValue(evt.Timestamp)),
+				}
+			}
+			job.Status = models.JobStatusQueued
+			if job.StateResource != nil {
+				var arn string
+				if details := evt.ActivityScheduledEventDetails; details != nil {
+					arn = aws.StringValue(details.Resource)
+				} else if details := evt.LambdaFunctionScheduledEventDetails; details != nil {
+					arn = aws.StringValue(details.Resource)
+				}
+				if stateDef, ok := wd.StateMachine.States[job.State]; ok && arn != "" {
+					job.StateResource.URI = arn
+					if p.resourceSource != nil {
+						job.StateResource.Source = p.resourceSource(arn, stateDef.Resource)
+					}
+				}
+			}
+		case sfn.HistoryEventTypeActivityStarted, sfn.HistoryEventTypeLambdaFunctionStarted:
+			job.Status = models.JobStatusRunning
+			job.StartedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			if details := evt.ActivityStartedEventDetails; details != nil {
+				job.Container = aws.StringValue(details.WorkerName)
+			}
+		case sfn.HistoryEventTypeActivityFailed, sfn.HistoryEventTypeLambdaFunctionFailed, sfn.HistoryEventTypeLambdaFunctionScheduleFailed, sfn.HistoryEventTypeLambdaFunctionStartFailed:
+			job.Status = models.JobStatusFailed
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			cause, errorName := CauseAndErrorNameFromFailureEvent(evt)
+			// TODO: need more natural place to put error name...
+			job.StatusReason = strings.TrimSpace(fmt.Sprintf(
+				"%s\n%s",
+				getLastFewLines(cause),
+				errorName,
+			))
+		case sfn.HistoryEventTypeActivityTimedOut, sfn.HistoryEventTypeLambdaFunctionTimedOut:
+			job.Status = models.JobStatusFailed
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			cause, errorName := CauseAndErrorNameFromFailureEvent(evt)
+			job.StatusReason = strings.TrimSpace(fmt.Sprintf(
+				"%s\n%s\n%s",
+				resources.StatusReasonJobTimedOut,
+				errorName,
+				getLastFewLines(cause),
+			))
+		case sfn.HistoryEventTypeActivitySucceeded, sfn.HistoryEventTypeLambdaFunctionSucceeded:
+			job.Status = models.JobStatusSucceeded
+		case sfn.HistoryEventTypeExecutionAborted:
+			job.Status = models.JobStatusAbortedByUser
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			if details := evt.ExecutionAbortedEventDetails; details != nil {
+				job.StatusReason = aws.StringValue(details.Cause)
+			}
+		case sfn.HistoryEventTypeExecutionFailed:
+			job.Status = models.JobStatusFailed
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			if details := evt.ExecutionFailedEventDetails; details != nil {
+				if IsActivityDoesntExistFailure(evt.ExecutionFailedEventDetails) {
+					job.StatusReason = "State resource does not exist"
+				} else if isActivityTimedOutFailure(evt.ExecutionFailedEventDetails) {
+					// do not update job status reason -- it should already be updated based on the ActivityTimedOut event
+				} else {
+					// set unknown errors to StatusReason
+					job.StatusReason = strings.TrimSpace(fmt.Sprintf(
+						"%s\n%s",
+						getLastFewLines(aws.StringValue(details.Cause)),
+						aws.StringValue(details.Error),
+					))
+				}
+			}
+		case sfn.HistoryEventTypeExecutionTimedOut:
+			job.Status = models.JobStatusFailed
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			if details := evt.ExecutionTimedOutEventDetails; details != nil {
+				job.StatusReason = strings.TrimSpace(fmt.Sprintf(
+					"%s\n%s\n%s",
+					resources.StatusReasonWorkflowTimedOut,
+					aws.StringValue(details.Error),
+					getLastFewLines(aws.StringValue(details.Cause)),
+				))
+			} else {
+				job.StatusReason = resources.StatusReasonWorkflowTimedOut
+			}
+		case sfn.HistoryEventTypeTaskStateExited:
+			stateExited := evt.StateExitedEventDetails
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			if stateExited.Output != nil {
+				job.Output = aws.StringValue(stateExited.Output)
+			}
+			if job.Status == models.JobStatusSucceeded {
+				job.ContractViolation = resources.OutputContractViolation(*wd, job.State, job.Output)
+			}
+		case sfn.HistoryEventTypeChoiceStateExited, sfn.HistoryEventTypeSucceedStateExited:
+			job.Status = models.JobStatusSucceeded
+			job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
+			details := evt.StateExitedEventDetails
+			if details.Output != nil {
+				job.Output = aws.StringValue(details.Output)
+			}
+		}
+	}
+}
+
+// IsActivityDoesntExistFailure checks if an execution failed because an activity doesn't exist.
+// This currently results in a cryptic AWS error, so the logic is probably over-broad: https://console.aws.amazon.com/support/home?region=us-west-2#/case/?displayId=4514731511&language=en
+// If SFN creates a more descriptive error event we should change this.
+func IsActivityDoesntExistFailure(details *sfn.ExecutionFailedEventDetails) bool {
+	return aws.StringValue(details.Error) == "States.Runtime" &&
+		strings.Contains(aws.StringValue(details.Cause), "Internal Error")
+}
+
+// isActivityTimedOutFailure checks if an execution failed because an activity timed out,
+// based on the details of an 'execution failed' history event.
+func isActivityTimedOutFailure(details *sfn.ExecutionFailedEventDetails) bool {
+	return aws.StringValue(details.Error) == "States.Timeout"
+}
+
+func getLastFewLines(rawLines string) string {
+	lastFewLines := strings.Split(strings.TrimSpace(rawLines), "\n")
+	if len(lastFewLines) > maxFailureReasonLines {
+		lastFewLines = lastFewLines[len(lastFewLines)-maxFailureReasonLines:]
+	}
+
+	return strings.Join(lastFewLines, "\n")
+}
+
+// CauseAndErrorNameFromFailureEvent returns the cause and error name of a failure event.
+func CauseAndErrorNameFromFailureEvent(evt *sfn.HistoryEvent) (string, string) {
+	switch aws.StringValue(evt.Type) {
+	case sfn.HistoryEventTypeActivityFailed:
+		return aws.StringValue(evt.ActivityFailedEventDetails.Cause), aws.StringValue(evt.ActivityFailedEventDetails.Error)
+	case sfn.HistoryEventTypeActivityScheduleFailed:
+		return aws.StringValue(evt.ActivityScheduleFailedEventDetails.Cause), aws.StringValue(evt.ActivityScheduleFailedEventDetails.Error)
+	case sfn.HistoryEventTypeActivityTimedOut:
+		return aws.StringValue(evt.ActivityTimedOutEventDetails.Cause), aws.StringValue(evt.ActivityTimedOutEventDetails.Error)
+	case sfn.HistoryEventTypeExecutionAborted:
+		return aws.StringValue(evt.ExecutionAbortedEventDetails.Cause), aws.StringValue(evt.ExecutionAbortedEventDetails.Error)
+	case sfn.HistoryEventTypeExecutionFailed:
+		return aws.StringValue(evt.ExecutionFailedEventDetails.Cause), aws.StringValue(evt.ExecutionFailedEventDetails.Error)
+	case sfn.HistoryEventTypeExecutionTimedOut:
+		return aws.StringValue(evt.ExecutionTimedOutEventDetails.Cause), aws.StringValue(evt.ExecutionTimedOutEventDetails.Error)
+	case sfn.HistoryEventTypeLambdaFunctionFailed:
+		return aws.StringValue(evt.LambdaFunctionFailedEventDetails.Cause), aws.StringValue(evt.LambdaFunctionFailedEventDetails.Error)
+	case sfn.HistoryEventTypeLambdaFunctionScheduleFailed:
+		return aws.StringValue(evt.LambdaFunctionScheduleFailedEventDetails.Cause), aws.StringValue(evt.LambdaFunctionScheduleFailedEventDetails.Error)
+	case sfn.HistoryEventTypeLambdaFunctionStartFailed:
+		return aws.StringValue(evt.LambdaFunctionStartFailedEventDetails.Cause), aws.StringValue(evt.LambdaFunctionStartFailedEventDetails.Error)
+	case sfn.HistoryEventTypeLambdaFunctionTimedOut:
+		return aws.StringValue(evt.LambdaFunctionTimedOutEventDetails.Cause), aws.StringValue(evt.LambdaFunctionTimedOutEventDetails.Error)
+	default:
+		return "", ""
+	}
+}
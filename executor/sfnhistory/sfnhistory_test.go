@@ -0,0 +1,448 @@
+package sfnhistory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var historyStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// historyEvent creates an event of a type, which happened minutes after the start of the history.
+func historyEvent(id, previousID int64, eventType string, minutes int) *sfn.HistoryEvent {
+	return &sfn.HistoryEvent{
+		Id:              aws.Int64(id),
+		PreviousEventId: aws.Int64(previousID),
+		Timestamp:       aws.Time(historyStart.Add(time.Duration(minutes) * time.Minute)),
+		Type:            aws.String(eventType),
+	}
+}
+
+func stateEntered(id int64, state string) *sfn.HistoryEvent {
+	evt := historyEvent(id, id-1, sfn.HistoryEventTypeTaskStateEntered, 0)
+	evt.StateEnteredEventDetails = &sfn.StateEnteredEventDetails{
+		Name:  aws.String(state),
+		Input: aws.String(`{"input": true}`),
+	}
+	return evt
+}
+
+func activityScheduled(id, previousID int64) *sfn.HistoryEvent {
+	evt := historyEvent(id, previousID, sfn.HistoryEventTypeActivityScheduled, 1)
+	evt.ActivityScheduledEventDetails = &sfn.ActivityScheduledEventDetails{
+		Resource: aws.String("arn:aws:states:us-west-2:000000000000:activity:namespace--fake-resource-1"),
+	}
+	return evt
+}
+
+func activityStarted(id, previousID int64, workerName string) *sfn.HistoryEvent {
+	evt := historyEvent(id, previousID, sfn.HistoryEventTypeActivityStarted, 2)
+	evt.ActivityStartedEventDetails = &sfn.ActivityStartedEventDetails{WorkerName: aws.String(workerName)}
+	return evt
+}
+
+func activityFailed(id, previousID int64, errorName, cause string) *sfn.HistoryEvent {
+	evt := historyEvent(id, previousID, sfn.HistoryEventTypeActivityFailed, 3)
+	evt.ActivityFailedEventDetails = &sfn.ActivityFailedEventDetails{
+		Error: aws.String(errorName),
+		Cause: aws.String(cause),
+	}
+	return evt
+}
+
+func executionFailed(id int64, errorName, cause string) *sfn.HistoryEvent {
+	evt := historyEvent(id, id-1, sfn.HistoryEventTypeExecutionFailed, 4)
+	evt.ExecutionFailedEventDetails = &sfn.ExecutionFailedEventDetails{
+		Error: aws.String(errorName),
+		Cause: aws.String(cause),
+	}
+	return evt
+}
+
+func taskStateExited(id, previousID int64, output string) *sfn.HistoryEvent {
+	evt := historyEvent(id, previousID, sfn.HistoryEventTypeTaskStateExited, 5)
+	evt.StateExitedEventDetails = &sfn.StateExitedEventDetails{
+		Name:   aws.String("start-state"),
+		Output: aws.String(output),
+	}
+	return evt
+}
+
+// wantJob is the part of a parsed job that the Parser tests check.
+type wantJob struct {
+	ID             string
+	State          string
+	Status         models.JobStatus
+	StatusReason   string
+	Container      string
+	Output         string
+	AttemptReasons []string
+}
+
+func TestParser(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []*sfn.HistoryEvent
+		want   []wantJob
+	}{
+		{
+			name:   "a job is created when its state is entered",
+			events: []*sfn.HistoryEvent{stateEntered(1, "start-state")},
+			want:   []wantJob{{ID: "1", State: "start-state", Status: models.JobStatusCreated}},
+		},
+		{
+			name: "jobs run on the worker that started their activity",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				activityScheduled(2, 1),
+				activityStarted(3, 2, "worker-1"),
+			},
+			want: []wantJob{{ID: "1", State: "start-state", Status: models.JobStatusRunning, Container: "worker-1"}},
+		},
+		{
+			name: "callback tasks are taken by a worker that the task token is saved for",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "callback-state"),
+				activityScheduled(2, 1),
+				activityStarted(3, 2, "workflow-manager-callback-1234"),
+			},
+			want: []wantJob{{
+				ID:        "1",
+				State:     "callback-state",
+				Status:    models.JobStatusRunning,
+				Container: "workflow-manager-callback-1234",
+			}},
+		},
+		{
+			name: "succeeded jobs have the output of their state",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				activityScheduled(2, 1),
+				activityStarted(3, 2, "worker-1"),
+				historyEvent(4, 3, sfn.HistoryEventTypeActivitySucceeded, 3),
+				taskStateExited(5, 4, `{"output": true}`),
+			},
+			want: []wantJob{{
+				ID:        "1",
+				State:     "start-state",
+				Status:    models.JobStatusSucceeded,
+				Container: "worker-1",
+				Output:    `{"output": true}`,
+			}},
+		},
+		{
+			name: "failed jobs report the last lines of the cause and the error",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				activityScheduled(2, 1),
+				activityStarted(3, 2, "worker-1"),
+				activityFailed(4, 3, "States.TaskFailed", "line1\nline2\nline3\nline4\nline5\nline6\n\n"),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: "line4\nline5\nline6\nStates.TaskFailed",
+				Container:    "worker-1",
+			}},
+		},
+		{
+			name: "retried jobs keep their failed attempts",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				activityScheduled(2, 1),
+				activityStarted(3, 2, "worker-1"),
+				activityFailed(4, 3, "States.TaskFailed", "first attempt"),
+				activityScheduled(5, 4),
+				activityStarted(6, 5, "worker-2"),
+				activityFailed(7, 6, "States.TaskFailed", "second attempt"),
+				activityScheduled(8, 7),
+				activityStarted(9, 8, "worker-3"),
+				historyEvent(10, 9, sfn.HistoryEventTypeActivitySucceeded, 3),
+			},
+			want: []wantJob{{
+				ID:        "8",
+				State:     "start-state",
+				Status:    models.JobStatusSucceeded,
+				Container: "worker-3",
+				AttemptReasons: []string{
+					"first attempt\nStates.TaskFailed",
+					"second attempt\nStates.TaskFailed",
+				},
+			}},
+		},
+		{
+			name: "lambda function failures are reported like activity failures",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "lambda-state"),
+				historyEvent(2, 1, sfn.HistoryEventTypeLambdaFunctionScheduled, 1),
+				func() *sfn.HistoryEvent {
+					evt := historyEvent(3, 2, sfn.HistoryEventTypeLambdaFunctionFailed, 2)
+					evt.LambdaFunctionFailedEventDetails = &sfn.LambdaFunctionFailedEventDetails{
+						Error: aws.String("Lambda.Unknown"),
+						Cause: aws.String("out of memory"),
+					}
+					return evt
+				}(),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "lambda-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: "out of memory\nLambda.Unknown",
+			}},
+		},
+		{
+			name: "timed out activities keep their reason when the execution fails",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				activityScheduled(2, 1),
+				activityStarted(3, 2, "worker-1"),
+				func() *sfn.HistoryEvent {
+					evt := historyEvent(4, 3, sfn.HistoryEventTypeActivityTimedOut, 3)
+					evt.ActivityTimedOutEventDetails = &sfn.ActivityTimedOutEventDetails{
+						Error: aws.String("States.Timeout"),
+						Cause: aws.String("no heartbeat"),
+					}
+					return evt
+				}(),
+				executionFailed(5, "States.Timeout", ""),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: resources.StatusReasonJobTimedOut + "\nStates.Timeout\nno heartbeat",
+				Container:    "worker-1",
+			}},
+		},
+		{
+			name: "executions that fail with an internal error ran a resource that doesn't exist",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				executionFailed(2, "States.Runtime", "Internal Error (49b863bd-3367-4035-a76d-bfb2e777ece3)"),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: "State resource does not exist",
+			}},
+		},
+		{
+			name: "execution failures fail the last job",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				activityScheduled(2, 1),
+				executionFailed(3, "States.Runtime", "invalid path"),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: "invalid path\nStates.Runtime",
+			}},
+		},
+		{
+			name: "aborted executions abort the last job",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				func() *sfn.HistoryEvent {
+					evt := historyEvent(2, 1, sfn.HistoryEventTypeExecutionAborted, 4)
+					evt.ExecutionAbortedEventDetails = &sfn.ExecutionAbortedEventDetails{Cause: aws.String("cancelled by user")}
+					return evt
+				}(),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusAbortedByUser,
+				StatusReason: "cancelled by user",
+			}},
+		},
+		{
+			name: "timed out executions fail the last job",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				historyEvent(2, 1, sfn.HistoryEventTypeExecutionTimedOut, 4),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: resources.StatusReasonWorkflowTimedOut,
+			}},
+		},
+		{
+			name: "timed out executions report their error and cause",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				func() *sfn.HistoryEvent {
+					evt := historyEvent(2, 1, sfn.HistoryEventTypeExecutionTimedOut, 4)
+					evt.ExecutionTimedOutEventDetails = &sfn.ExecutionTimedOutEventDetails{
+						Error: aws.String("States.Timeout"),
+						Cause: aws.String("TimeoutSeconds exceeded"),
+					}
+					return evt
+				}(),
+			},
+			want: []wantJob{{
+				ID:           "1",
+				State:        "start-state",
+				Status:       models.JobStatusFailed,
+				StatusReason: resources.StatusReasonWorkflowTimedOut + "\nStates.Timeout\nTimeoutSeconds exceeded",
+			}},
+		},
+		{
+			name: "executions that fail before any job have no jobs",
+			events: []*sfn.HistoryEvent{
+				historyEvent(1, 0, sfn.HistoryEventTypeExecutionStarted, 0),
+				executionFailed(2, "States.Runtime", "invalid input"),
+			},
+			want: []wantJob{},
+		},
+		{
+			name: "executions that time out before any job have no jobs",
+			events: []*sfn.HistoryEvent{
+				historyEvent(1, 0, sfn.HistoryEventTypeExecutionStarted, 0),
+				historyEvent(2, 1, sfn.HistoryEventTypeExecutionTimedOut, 4),
+			},
+			want: []wantJob{},
+		},
+		{
+			name: "events of unknown jobs are ignored",
+			events: []*sfn.HistoryEvent{
+				stateEntered(1, "start-state"),
+				historyEvent(2, 99, sfn.HistoryEventTypeActivitySucceeded, 3),
+			},
+			want: []wantJob{{ID: "1", State: "start-state", Status: models.JobStatusCreated}},
+		},
+	}
+
+	wd := resources.KitchenSinkWorkflowDefinition(t)
+	wd.StateMachine.States["callback-state"] = models.SLState{Type: models.SLStateTypeTask, Resource: "callback:approval", End: true}
+	wd.StateMachine.States["lambda-state"] = models.SLState{Type: models.SLStateTypeTask, Resource: "lambda:function", End: true}
+	workflow := resources.NewWorkflow(wd, `{}`, "namespace", "queue", map[string]interface{}{})
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parser := NewParser(workflow, nil)
+			parser.AddEvents(test.events)
+
+			jobs := []wantJob{}
+			for _, job := range parser.Jobs() {
+				var attemptReasons []string
+				for _, attempt := range job.Attempts {
+					attemptReasons = append(attemptReasons, attempt.Reason)
+				}
+				jobs = append(jobs, wantJob{
+					ID:             job.ID,
+					State:          job.State,
+					Status:         job.Status,
+					StatusReason:   job.StatusReason,
+					Container:      job.Container,
+					Output:         job.Output,
+					AttemptReasons: attemptReasons,
+				})
+			}
+			assert.Equal(t, test.want, jobs)
+		})
+	}
+}
+
+func TestParserJobData(t *testing.T) {
+	wd := resources.KitchenSinkWorkflowDefinition(t)
+	workflow := resources.NewWorkflow(wd, `{}`, "namespace", "queue", map[string]interface{}{})
+	parser := NewParser(workflow, func(arn, resource string) models.StateResourceSource {
+		return models.StateResourceSourceRegistered
+	})
+
+	t.Log("Events can be added a page at a time")
+	parser.AddEvents([]*sfn.HistoryEvent{stateEntered(1, "start-state"), activityScheduled(2, 1)})
+	parser.AddEvents([]*sfn.HistoryEvent{
+		activityStarted(3, 2, "worker-1"),
+		activityFailed(4, 3, "States.TaskFailed", "failed"),
+		activityScheduled(5, 4),
+	})
+	require.Len(t, parser.Jobs(), 1)
+	job := parser.Jobs()[0]
+
+	t.Log("Jobs have the input of their state and the times of their events")
+	assert.Equal(t, `{"input": true}`, job.Input)
+	assert.Equal(t, historyStart.Add(time.Minute), time.Time(job.CreatedAt))
+	require.Len(t, job.Attempts, 1)
+	assert.Equal(t, historyStart, time.Time(job.Attempts[0].CreatedAt))
+	assert.Equal(t, historyStart.Add(2*time.Minute), time.Time(job.Attempts[0].StartedAt))
+	assert.Equal(t, historyStart.Add(3*time.Minute), time.Time(job.Attempts[0].StoppedAt))
+	assert.Equal(t, "worker-1", job.Attempts[0].TaskARN)
+
+	t.Log("Jobs report the StateResource they were scheduled on")
+	require.NotNil(t, job.StateResource)
+	assert.Equal(t, "fake-resource-1", job.StateResource.Name)
+	assert.Equal(t, "namespace", job.StateResource.Namespace)
+	assert.Equal(t, models.StateResourceTypeActivityARN, job.StateResource.Type)
+	assert.Equal(t, *activityScheduled(2, 1).ActivityScheduledEventDetails.Resource, job.StateResource.URI)
+	assert.Equal(t, models.StateResourceSourceRegistered, job.StateResource.Source)
+}
+
+func TestCauseAndErrorNameFromFailureEvent(t *testing.T) {
+	tests := []struct {
+		event *sfn.HistoryEvent
+	}{
+		{event: &sfn.HistoryEvent{
+			Type:                       aws.String(sfn.HistoryEventTypeActivityFailed),
+			ActivityFailedEventDetails: &sfn.ActivityFailedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                               aws.String(sfn.HistoryEventTypeActivityScheduleFailed),
+			ActivityScheduleFailedEventDetails: &sfn.ActivityScheduleFailedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                         aws.String(sfn.HistoryEventTypeActivityTimedOut),
+			ActivityTimedOutEventDetails: &sfn.ActivityTimedOutEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                         aws.String(sfn.HistoryEventTypeExecutionAborted),
+			ExecutionAbortedEventDetails: &sfn.ExecutionAbortedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                        aws.String(sfn.HistoryEventTypeExecutionFailed),
+			ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                          aws.String(sfn.HistoryEventTypeExecutionTimedOut),
+			ExecutionTimedOutEventDetails: &sfn.ExecutionTimedOutEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                             aws.String(sfn.HistoryEventTypeLambdaFunctionFailed),
+			LambdaFunctionFailedEventDetails: &sfn.LambdaFunctionFailedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                                     aws.String(sfn.HistoryEventTypeLambdaFunctionScheduleFailed),
+			LambdaFunctionScheduleFailedEventDetails: &sfn.LambdaFunctionScheduleFailedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                                  aws.String(sfn.HistoryEventTypeLambdaFunctionStartFailed),
+			LambdaFunctionStartFailedEventDetails: &sfn.LambdaFunctionStartFailedEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+		{event: &sfn.HistoryEvent{
+			Type:                               aws.String(sfn.HistoryEventTypeLambdaFunctionTimedOut),
+			LambdaFunctionTimedOutEventDetails: &sfn.LambdaFunctionTimedOutEventDetails{Cause: aws.String("cause"), Error: aws.String("error")},
+		}},
+	}
+	for _, test := range tests {
+		cause, errorName := CauseAndErrorNameFromFailureEvent(test.event)
+		assert.Equal(t, "cause", cause, *test.event.Type)
+		assert.Equal(t, "error", errorName, *test.event.Type)
+	}
+
+	t.Log("Other events have no cause or error")
+	cause, errorName := CauseAndErrorNameFromFailureEvent(historyEvent(1, 0, sfn.HistoryEventTypeActivitySucceeded, 0))
+	assert.Empty(t, cause)
+	assert.Empty(t, errorName)
+}
@@ -0,0 +1,130 @@
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store"
+)
+
+// stateResourceName returns the name the Resource of a Task state is registered under as a
+// StateResource, e.g. "lambda:name" is registered as "name", and the type of StateResource it can
+// be resolved to. Callback and child workflow resources are polled by workflow-manager itself, so
+// they can't be registered.
+func stateResourceName(resource string) (string, models.StateResourceType, bool) {
+	if resources.IsCallbackResource(resource) || resources.IsChildWorkflowResource(resource) {
+		return "", "", false
+	}
+	if strings.HasPrefix(resource, "lambda:") {
+		return strings.TrimPrefix(resource, "lambda:"), models.StateResourceTypeLambdaFunctionARN, true
+	}
+	return resource, models.StateResourceTypeActivityARN, true
+}
+
+// registeredStateResources returns the StateResources registered in a namespace for the Task
+// states of a state machine, keyed by the states' Resource.
+func registeredStateResources(ctx context.Context, s store.Store, sm models.SLStateMachine, namespace string) (map[string]models.StateResource, error) {
+	registered := map[string]models.StateResource{}
+	for _, state := range sm.States {
+		if state.Type != models.SLStateTypeTask {
+			continue
+		}
+		name, resourceType, ok := stateResourceName(state.Resource)
+		if !ok {
+			continue
+		}
+		if _, ok := registered[state.Resource]; ok {
+			continue
+		}
+		stateResource, err := s.GetStateResource(ctx, name, namespace)
+		if err != nil {
+			if _, ok := err.(models.NotFound); ok {
+				continue
+			}
+			return nil, err
+		}
+		// A lambda function can't be the Resource of an activity Task state or vice versa, and
+		// batch job definitions can't be the Resource of a Task state at all
+		registeredType := stateResource.Type
+		if registeredType == "" {
+			registeredType = resources.StateResourceTypeFromARN(stateResource.URI)
+		}
+		if registeredType != resourceType {
+			continue
+		}
+		registered[state.Resource] = stateResource
+	}
+	return registered, nil
+}
+
+// stateMachineResolveInterval is how long the definition a mutable state machine resolved to is
+// reused before its StateResources are looked up again.
+const stateMachineResolveInterval = time.Minute
+
+// resolvedStateMachines caches the definitions that the state machines of version -1 workflow
+// definitions resolve to, so that their StateResources aren't looked up on every start.
+type resolvedStateMachines struct {
+	mu          sync.Mutex
+	definitions map[string]resolvedStateMachine
+}
+
+// resolvedStateMachine is the definition a state machine resolved to, and the workflow definition
+// state machine it was resolved from.
+type resolvedStateMachine struct {
+	source     string
+	definition string
+	resolvedAt time.Time
+}
+
+// definition returns the definition the state machine at an ARN resolves to for wd, calling
+// resolve unless it was resolved from the same workflow definition recently.
+func (r *resolvedStateMachines) definition(arn string, wd models.WorkflowDefinition, resolve func() (string, error)) (string, error) {
+	sourceBytes, err := json.Marshal(wd.StateMachine)
+	if err != nil {
+		return "", err
+	}
+	source := string(sourceBytes)
+
+	r.mu.Lock()
+	resolved, ok := r.definitions[arn]
+	r.mu.Unlock()
+	if ok && resolved.source == source && time.Since(resolved.resolvedAt) < stateMachineResolveInterval {
+		return resolved.definition, nil
+	}
+
+	definition, err := resolve()
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	r.definitions[arn] = resolvedStateMachine{source: source, definition: definition, resolvedAt: time.Now()}
+	r.mu.Unlock()
+	return definition, nil
+}
+
+// conventionalResourceARN returns the ARN of the Resource of a Task state by naming convention.
+func conventionalResourceARN(resource, region, accountID, namespace string) string {
+	if strings.HasPrefix(resource, "lambda:") {
+		return sfnconventions.LambdaResource(resource, region, accountID, namespace)
+	} else if resources.IsCallbackResource(resource) {
+		return sfnconventions.CallbackResource(resource, region, accountID, namespace)
+	} else if resources.IsChildWorkflowResource(resource) {
+		return sfnconventions.ChildWorkflowResource(resource, region, accountID, namespace)
+	}
+	return sfnconventions.SFNCLIResource(resource, region, accountID, namespace)
+}
+
+// stateResourceSource reports whether the ARN a Task state ran with came from a registered
+// StateResource, i.e. whether it differs from the ARN by naming convention.
+func stateResourceSource(arn, resource, region, accountID, namespace string) models.StateResourceSource {
+	if arn != conventionalResourceARN(resource, region, accountID, namespace) {
+		return models.StateResourceSourceRegistered
+	}
+	return models.StateResourceSourceConvention
+}
@@ -0,0 +1,71 @@
+package executor
+
+import (
+	"context"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+)
+
+// maxTriggerDepth limits chains of triggered workflows, which would otherwise run forever if
+// definitions trigger each other in a loop.
+const maxTriggerDepth = 10
+
+// startTriggeredWorkflows starts the workflows triggered by a workflow reaching a terminal status.
+// A trigger that fails to start is logged, and doesn't prevent the others from starting.
+func (wm *SFNWorkflowManager) startTriggeredWorkflows(ctx context.Context, workflow *models.Workflow) {
+	for _, trigger := range workflow.WorkflowDefinition.Triggers {
+		if trigger.On != workflow.Status {
+			continue
+		}
+		if workflow.TriggerDepth >= maxTriggerDepth {
+			logTriggerDepthExceeded(workflow, trigger)
+			continue
+		}
+		triggered, err := wm.startTriggeredWorkflow(ctx, workflow, trigger)
+		if err != nil {
+			log.ErrorD("start-triggered-workflow", logger.M{
+				"workflow-id":              workflow.ID,
+				"workflow-definition-name": trigger.WorkflowDefinitionName,
+				"error":                    err.Error(),
+			})
+			continue
+		}
+		logWorkflowTriggered(workflow, triggered)
+	}
+}
+
+func (wm *SFNWorkflowManager) startTriggeredWorkflow(
+	ctx context.Context,
+	workflow *models.Workflow,
+	trigger *models.WorkflowTrigger,
+) (*models.Workflow, error) {
+	var def models.WorkflowDefinition
+	var err error
+	if trigger.WorkflowDefinitionVersion == nil {
+		def, err = wm.store.LatestWorkflowDefinition(ctx, trigger.WorkflowDefinitionName)
+	} else {
+		def, err = wm.store.GetWorkflowDefinition(ctx, trigger.WorkflowDefinitionName, int(*trigger.WorkflowDefinitionVersion))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := resources.TriggerInput(*workflow, trigger.InputMapping)
+	if err != nil {
+		return nil, err
+	}
+	if err := resources.ValidateInput(def, input); err != nil {
+		return nil, err
+	}
+
+	return wm.createWorkflow(
+		ctx, def, input, workflow.Namespace, workflow.Queue, map[string]interface{}{},
+		func(triggered *models.Workflow) error {
+			triggered.TriggeredBy = workflow.ID
+			triggered.TriggerDepth = workflow.TriggerDepth + 1
+			return nil
+		},
+	)
+}
@@ -19,18 +19,30 @@ import (
 
 // WorkflowManager is the interface for creating, stopping and checking status for Workflows
 type WorkflowManager interface {
-	CreateWorkflow(ctx context.Context, def models.WorkflowDefinition, input string, namespace string, queue string, tags map[string]interface{}) (*models.Workflow, error)
-	RetryWorkflow(ctx context.Context, workflow models.Workflow, startAt, input string) (*models.Workflow, error)
+	CreateWorkflow(ctx context.Context, def models.WorkflowDefinition, input string, namespace string, queue string, tags map[string]interface{}, rolloutArm string) (*models.Workflow, error)
+	RetryWorkflow(ctx context.Context, workflow models.Workflow, def models.WorkflowDefinition, startAt, input string) (*models.Workflow, error)
 	CancelWorkflow(ctx context.Context, workflow *models.Workflow, reason string) error
 	UpdateWorkflowSummary(ctx context.Context, workflow *models.Workflow) error
 	UpdateWorkflowHistory(ctx context.Context, workflow *models.Workflow) error
+	SignalWorkflow(ctx context.Context, workflow *models.Workflow, state string, signal models.SignalRequest) error
+}
+
+// WorkflowWatcher is told about the workflows that the update loop syncs, e.g. to stream their
+// changes to the clients watching them.
+type WorkflowWatcher interface {
+	// IsWatched returns whether a workflow has watchers, in which case the update loop syncs its
+	// jobs from its history as well as its summary.
+	IsWatched(workflowID string) bool
+	// WorkflowUpdated is called with each workflow that the update loop syncs.
+	WorkflowUpdated(workflow models.Workflow)
 }
 
 var backoffDuration = time.Second * 1
 
 // PollForPendingWorkflowsAndUpdateStore polls an SQS queue for workflows needing an update.
-// It will stop polling when the context is done.
-func PollForPendingWorkflowsAndUpdateStore(ctx context.Context, wm WorkflowManager, thestore store.Store, sqsapi sqsiface.SQSAPI, sqsQueueURL string) {
+// It will stop polling when the context is done. The watcher, if not nil, is told about each
+// workflow that's updated.
+func PollForPendingWorkflowsAndUpdateStore(ctx context.Context, wm WorkflowManager, thestore store.Store, sqsapi sqsiface.SQSAPI, sqsQueueURL string, watcher WorkflowWatcher) {
 	for {
 		select {
 		case <-ctx.Done():
@@ -47,7 +59,7 @@ func PollForPendingWorkflowsAndUpdateStore(ctx context.Context, wm WorkflowManag
 			}
 
 			for _, message := range out.Messages {
-				if id, err := updatePendingWorkflow(ctx, message, wm, thestore, sqsapi, sqsQueueURL); err != nil {
+				if id, err := updatePendingWorkflow(ctx, message, wm, thestore, sqsapi, sqsQueueURL, watcher); err != nil {
 					log.ErrorD("update-pending-workflow", logger.M{"id": id, "error": err.Error()})
 
 					// If we're seeing DynamoDB throttling, let's wait before running our next poll loop
@@ -79,7 +91,7 @@ func createPendingWorkflow(ctx context.Context, workflowID string, sqsapi sqsifa
 	return err
 }
 
-func updatePendingWorkflow(ctx context.Context, m *sqs.Message, wm WorkflowManager, thestore store.Store, sqsapi sqsiface.SQSAPI, sqsQueueURL string) (string, error) {
+func updatePendingWorkflow(ctx context.Context, m *sqs.Message, wm WorkflowManager, thestore store.Store, sqsapi sqsiface.SQSAPI, sqsQueueURL string, watcher WorkflowWatcher) (string, error) {
 	deleteMsg := func() {
 		if _, err := sqsapi.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
 			QueueUrl:      aws.String(sqsQueueURL),
@@ -121,7 +133,8 @@ func updatePendingWorkflow(ctx context.Context, m *sqs.Message, wm WorkflowManag
 	// and re-queue a new message if the workflow remains pending.
 	defer func() {
 		deleteMsg()
-		if storeSaveFailed || !resources.WorkflowStatusIsDone(&wf) {
+		// failed workflows with a scheduled auto retry are checked again until the retry starts
+		if storeSaveFailed || !resources.WorkflowStatusIsDone(&wf) || wf.AutoRetryAt != nil {
 			requeueMsg()
 		}
 	}()
@@ -129,5 +142,19 @@ func updatePendingWorkflow(ctx context.Context, m *sqs.Message, wm WorkflowManag
 		return "", err
 	}
 	storeSaveFailed = false
+
+	// only some workflows are worth a fetch of their history: watched workflows share it with their
+	// watchers, and GET /workers reports the stored jobs queued for activity workers, so those are
+	// synced until they've started
+	watched := watcher != nil && watcher.IsWatched(wfID)
+	queued := !resources.WorkflowStatusIsDone(&wf) && resources.HasQueuedActivityJob(&wf)
+	if watched || queued {
+		if err := wm.UpdateWorkflowHistory(ctx, &wf); err != nil {
+			log.ErrorD("update-pending-workflow-history", logger.M{"id": wfID, "error": err.Error()})
+		}
+	}
+	if watcher != nil {
+		watcher.WorkflowUpdated(wf)
+	}
 	return wfID, nil
 }
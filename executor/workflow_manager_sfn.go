@@ -4,15 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/Clever/workflow-manager/executor/sfnconventions"
+	"github.com/Clever/workflow-manager/executor/sfnhistory"
 	"github.com/Clever/workflow-manager/gen-go/models"
 	"github.com/Clever/workflow-manager/resources"
 	"github.com/Clever/workflow-manager/store"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sfn/sfniface"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
@@ -23,7 +24,6 @@ import (
 )
 
 const (
-	maxFailureReasonLines   = 3
 	sfncliCommandTerminated = "sfncli.CommandTerminated"
 )
 
@@ -46,9 +46,14 @@ type SFNWorkflowManager struct {
 	roleARN     string
 	accountID   string
 	sqsQueueURL string
+	callbacks   *callbackActivities
+	resources   *resourceChecker
+	resolved    *resolvedStateMachines
 }
 
-func NewSFNWorkflowManager(sfnapi sfniface.SFNAPI, sqsapi sqsiface.SQSAPI, store store.Store, roleARN, region, accountID, sqsQueueURL string) *SFNWorkflowManager {
+// NewSFNWorkflowManager creates an SFNWorkflowManager. lambdaapi is used to check that the lambda
+// functions of workflows exist, and may be nil to skip those checks.
+func NewSFNWorkflowManager(sfnapi sfniface.SFNAPI, sqsapi sqsiface.SQSAPI, lambdaapi lambdaiface.LambdaAPI, store store.Store, roleARN, region, accountID, sqsQueueURL string) *SFNWorkflowManager {
 	return &SFNWorkflowManager{
 		sfnapi:      sfnapi,
 		sqsapi:      sqsapi,
@@ -57,23 +62,27 @@ func NewSFNWorkflowManager(sfnapi sfniface.SFNAPI, sqsapi sqsiface.SQSAPI, store
 		region:      region,
 		accountID:   accountID,
 		sqsQueueURL: sqsQueueURL,
+		callbacks:   &callbackActivities{arns: map[string]callbackActivity{}},
+		resources:   newResourceChecker(sfnapi, lambdaapi),
+		resolved:    &resolvedStateMachines{definitions: map[string]resolvedStateMachine{}},
 	}
 }
 
 // stateMachineWithFullActivityARNs converts resource names in states to full activity ARNs. It returns a new state machine.
 // Our workflow definitions contain state machine definitions with short-hand for resource names, e.g. "Resource": "name-of-worker"
 // Convert this shorthand into a new state machine with full activity ARNs, e.g. "Resource": "arn:aws:states:us-west-2:589690932525:activity:production--name-of-worker"
-func stateMachineWithFullActivityARNs(oldSM models.SLStateMachine, region, accountID, namespace string) *models.SLStateMachine {
+// Resources registered as StateResources use the registered ARN instead of the naming convention.
+func stateMachineWithFullActivityARNs(oldSM models.SLStateMachine, region, accountID, namespace string, registered map[string]models.StateResource) *models.SLStateMachine {
 	sm := deepcopy.Copy(oldSM).(models.SLStateMachine)
 	for stateName, s := range sm.States {
 		state := deepcopy.Copy(s).(models.SLState)
 		if state.Type != models.SLStateTypeTask {
 			continue
 		}
-		if strings.HasPrefix(state.Resource, "lambda:") {
-			state.Resource = sfnconventions.LambdaResource(state.Resource, region, accountID, namespace)
+		if stateResource, ok := registered[state.Resource]; ok {
+			state.Resource = stateResource.URI
 		} else {
-			state.Resource = sfnconventions.SFNCLIResource(state.Resource, region, accountID, namespace)
+			state.Resource = conventionalResourceARN(state.Resource, region, accountID, namespace)
 		}
 		sm.States[stateName] = state
 	}
@@ -110,12 +119,61 @@ func stateMachineWithDefaultRetriers(oldSM models.SLStateMachine) *models.SLStat
 	return &sm
 }
 
+// stateMachineDefinition returns the definition of the state machine for wd in a namespace, with
+// the Resources of its Task states resolved to the StateResources registered in the namespace.
+func (wm *SFNWorkflowManager) stateMachineDefinition(ctx context.Context, wd models.WorkflowDefinition, namespace string) (string, error) {
+	registered, err := registeredStateResources(ctx, wm.store, *wd.StateMachine, namespace)
+	if err != nil {
+		return "", fmt.Errorf("GetStateResource error: %s", err)
+	}
+	awsStateMachine := stateMachineWithFullActivityARNs(*wd.StateMachine, wm.region, wm.accountID, namespace, registered)
+	awsStateMachine = stateMachineWithDefaultRetriers(*awsStateMachine)
+	awsStateMachineDefBytes, err := json.MarshalIndent(awsStateMachine, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	awsStateMachineDefBytes, err = withChildWorkflowParameters(awsStateMachineDefBytes, *wd.StateMachine)
+	if err != nil {
+		return "", err
+	}
+	return string(awsStateMachineDefBytes), nil
+}
+
+// describeOrCreateStateMachine describes the state machine for wd in a namespace, creating it if
+// it doesn't exist. The state machines of numbered versions never change, so that executions
+// always run the state machine they started with. Like in the embedded workflow-manager, only
+// those of version -1 are updated, e.g. once StateResources are registered after they were created.
 func (wm *SFNWorkflowManager) describeOrCreateStateMachine(wd models.WorkflowDefinition, namespace, queue string) (*sfn.DescribeStateMachineOutput, error) {
+	if err := wm.registerCallbackActivities(context.TODO(), wd, namespace); err != nil {
+		return nil, fmt.Errorf("CreateActivity error: %s", err)
+	}
+	stateMachineArn := sfnconventions.StateMachineArn(wm.region, wm.accountID, wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)
 	describeOutput, err := wm.sfnapi.DescribeStateMachine(&sfn.DescribeStateMachineInput{
-		StateMachineArn: aws.String(sfnconventions.StateMachineArn(wm.region, wm.accountID, wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)),
+		StateMachineArn: aws.String(stateMachineArn),
 	})
 	if err == nil {
-		return describeOutput, nil
+		if wd.Version != -1 {
+			return describeOutput, nil
+		}
+		awsStateMachineDef, err := wm.resolved.definition(stateMachineArn, wd, func() (string, error) {
+			return wm.stateMachineDefinition(context.TODO(), wd, namespace)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if aws.StringValue(describeOutput.Definition) == awsStateMachineDef {
+			return describeOutput, nil
+		}
+		log.InfoD("update-state-machine", logger.M{"definition": awsStateMachineDef, "arn": stateMachineArn})
+		if _, err := wm.sfnapi.UpdateStateMachine(&sfn.UpdateStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+			Definition:      aws.String(awsStateMachineDef),
+		}); err != nil {
+			return nil, fmt.Errorf("UpdateStateMachine error: %s", err.Error())
+		}
+		return wm.sfnapi.DescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		})
 	}
 	awserr, ok := err.(awserr.Error)
 	if !ok {
@@ -126,13 +184,10 @@ func (wm *SFNWorkflowManager) describeOrCreateStateMachine(wd models.WorkflowDef
 	}
 
 	// state machine doesn't exist, create it
-	awsStateMachine := stateMachineWithFullActivityARNs(*wd.StateMachine, wm.region, wm.accountID, namespace)
-	awsStateMachine = stateMachineWithDefaultRetriers(*awsStateMachine)
-	awsStateMachineDefBytes, err := json.MarshalIndent(awsStateMachine, "", "  ")
+	awsStateMachineDef, err := wm.stateMachineDefinition(context.TODO(), wd, namespace)
 	if err != nil {
 		return nil, err
 	}
-	awsStateMachineDef := string(awsStateMachineDefBytes)
 	// the name must be unique. Use workflow definition name + version + namespace + queue to uniquely identify a state machine
 	// this effectively creates a new workflow definition in each namespace we deploy into
 	awsStateMachineName := sfnconventions.StateMachineName(wd.Name, wd.Version, namespace, wd.StateMachine.StartAt)
@@ -180,16 +235,36 @@ func (wm *SFNWorkflowManager) startExecution(stateMachineArn *string, workflowID
 	return err
 }
 
+// CreateWorkflow starts a Workflow running wd. rolloutArm, if set, is the arm of wd's rollout the
+// workflow was routed to.
 func (wm *SFNWorkflowManager) CreateWorkflow(ctx context.Context, wd models.WorkflowDefinition,
 	input string,
 	namespace string,
 	queue string,
-	tags map[string]interface{}) (*models.Workflow, error) {
+	tags map[string]interface{},
+	rolloutArm string) (*models.Workflow, error) {
+	return wm.createWorkflow(ctx, wd, input, namespace, queue, tags, func(workflow *models.Workflow) error {
+		workflow.RolloutArm = rolloutArm
+		return nil
+	})
+}
+
+// createWorkflow implements CreateWorkflow. setup, if set, is called with the new Workflow before
+// it is saved, e.g. to link it to the workflow that started it.
+func (wm *SFNWorkflowManager) createWorkflow(ctx context.Context, wd models.WorkflowDefinition,
+	input string,
+	namespace string,
+	queue string,
+	tags map[string]interface{},
+	setup func(workflow *models.Workflow) error) (*models.Workflow, error) {
 
 	describeOutput, err := wm.describeOrCreateStateMachine(wd, namespace, queue)
 	if err != nil {
 		return nil, err
 	}
+	if err := wm.resources.checkStateMachine(ctx, describeOutput, wd, namespace); err != nil {
+		return nil, err
+	}
 
 	mergedTags := map[string]interface{}{}
 	for k, v := range wd.DefaultTags {
@@ -204,6 +279,11 @@ func (wm *SFNWorkflowManager) CreateWorkflow(ctx context.Context, wd models.Work
 	// i.e. execution was started but we failed to save workflow
 	// If we fail starting the execution, we can resolve this out of band (TODO: should support cancelling)
 	workflow := resources.NewWorkflow(&wd, input, namespace, queue, mergedTags)
+	if setup != nil {
+		if err := setup(workflow); err != nil {
+			return nil, err
+		}
+	}
 	if err := wm.store.SaveWorkflow(ctx, *workflow); err != nil {
 		return nil, err
 	}
@@ -233,14 +313,33 @@ func (wm *SFNWorkflowManager) CreateWorkflow(ctx context.Context, wd models.Work
 	return workflow, nil
 }
 
-func (wm *SFNWorkflowManager) RetryWorkflow(ctx context.Context, ogWorkflow models.Workflow, startAt, input string) (*models.Workflow, error) {
+// RetryWorkflow starts a new Workflow running def from startAt, and records it as a retry of ogWorkflow.
+// def is usually ogWorkflow's WorkflowDefinition, but may be another version of the same definition.
+func (wm *SFNWorkflowManager) RetryWorkflow(
+	ctx context.Context,
+	ogWorkflow models.Workflow,
+	def models.WorkflowDefinition,
+	startAt, input string,
+) (*models.Workflow, error) {
+	return wm.retryWorkflow(ctx, ogWorkflow, def, startAt, input, 0)
+}
+
+// retryWorkflow implements RetryWorkflow. autoRetryAttempt is recorded on the new Workflow so that
+// automatic retries can be limited to the AutoRetryPolicy's MaxAttempts.
+func (wm *SFNWorkflowManager) retryWorkflow(
+	ctx context.Context,
+	ogWorkflow models.Workflow,
+	def models.WorkflowDefinition,
+	startAt, input string,
+	autoRetryAttempt int64,
+) (*models.Workflow, error) {
 	// don't allow resume if workflow is still active
 	if !resources.WorkflowIsDone(&ogWorkflow) {
 		return nil, fmt.Errorf("Workflow %s active: %s", ogWorkflow.ID, ogWorkflow.Status)
 	}
 
 	// modify the StateMachine with the custom StartState by making a new WorkflowDefinition (no pointer copy)
-	newDef := resources.CopyWorkflowDefinition(*ogWorkflow.WorkflowDefinition)
+	newDef := resources.CopyWorkflowDefinition(def)
 	newDef.StateMachine.StartAt = startAt
 	if err := resources.RemoveInactiveStates(newDef.StateMachine); err != nil {
 		return nil, err
@@ -249,9 +348,13 @@ func (wm *SFNWorkflowManager) RetryWorkflow(ctx context.Context, ogWorkflow mode
 	if err != nil {
 		return nil, err
 	}
+	if err := wm.resources.checkStateMachine(ctx, describeOutput, newDef, ogWorkflow.Namespace); err != nil {
+		return nil, err
+	}
 
 	workflow := resources.NewWorkflow(&newDef, input, ogWorkflow.Namespace, ogWorkflow.Queue, ogWorkflow.Tags)
 	workflow.RetryFor = ogWorkflow.ID
+	workflow.AutoRetryAttempt = autoRetryAttempt
 	ogWorkflow.Retries = append(ogWorkflow.Retries, workflow.ID)
 
 	// save the workflow before starting execution to ensure we don't have untracked executions
@@ -294,9 +397,15 @@ func (wm *SFNWorkflowManager) CancelWorkflow(ctx context.Context, workflow *mode
 		return err
 	}
 
+	if err := ClearAutoRetry(ctx, wm.store, workflow); err != nil {
+		return err
+	}
 	workflow.StatusReason = reason
 	workflow.ResolvedByUser = true
-	return wm.store.UpdateWorkflow(ctx, *workflow)
+	if err := wm.store.UpdateWorkflow(ctx, *workflow); err != nil {
+		return err
+	}
+	return wm.cancelChildWorkflows(ctx, workflow, reason)
 }
 
 func (wm *SFNWorkflowManager) executionArn(
@@ -316,8 +425,10 @@ func (wm *SFNWorkflowManager) UpdateWorkflowSummary(ctx context.Context, workflo
 	// This also prevents the WM "cancelled" state from getting overwritten for workflows cancelled
 	// by the user after a failure.
 	if resources.WorkflowIsDone(workflow) {
-		return nil
+		return wm.startPendingAutoRetry(ctx, workflow)
 	}
+	previousStatus := workflow.Status
+	wasDone := resources.WorkflowStatusIsDone(workflow)
 
 	// get execution from AWS, pull in all the data into the workflow object
 	wd := workflow.WorkflowDefinition
@@ -366,16 +477,52 @@ func (wm *SFNWorkflowManager) UpdateWorkflowSummary(ctx context.Context, workflo
 	}
 
 	workflow.Output = aws.StringValue(describeOutput.Output) // use for error or success  (TODO: actually this is only sent for success)
-	return wm.store.UpdateWorkflow(ctx, *workflow)
+
+	if workflow.Status == models.WorkflowStatusFailed && previousStatus != models.WorkflowStatusFailed {
+		// failing to schedule a retry shouldn't prevent the failure from being recorded
+		if err := wm.scheduleAutoRetry(ctx, workflow, execARN); err != nil {
+			log.ErrorD("schedule-auto-retry", logger.M{"workflow-id": workflow.ID, "error": err.Error()})
+		}
+	}
+	if !resources.WorkflowStatusIsDone(workflow) {
+		if err := wm.enforceDeadlines(ctx, workflow); err != nil {
+			log.ErrorD("enforce-deadlines", logger.M{"workflow-id": workflow.ID, "error": err.Error()})
+		}
+		// resume polling for the callback states of workflows started before a restart
+		if err := wm.registerCallbackActivities(ctx, *wd, workflow.Namespace); err != nil {
+			log.ErrorD("register-callback-activities", logger.M{"workflow-id": workflow.ID, "error": err.Error()})
+		}
+	}
+	finished := resources.WorkflowStatusIsDone(workflow) && !wasDone
+	if finished {
+		// the update loop, GetWorkflowByID and event streams can all see a workflow finish, so only
+		// the caller that records it first completes the parent's state and starts triggered workflows
+		if err := wm.store.UpdateWorkflowFromStatus(ctx, *workflow, previousStatus); err != nil {
+			if _, ok := err.(store.ConflictError); ok {
+				return nil
+			}
+			return err
+		}
+	} else if err := wm.store.UpdateWorkflow(ctx, *workflow); err != nil {
+		return err
+	}
+	if finished {
+		// failing to complete the parent's state shouldn't prevent this workflow's retry
+		if workflow.ParentWorkflowID != "" {
+			if err := wm.completeParentTask(ctx, workflow); err != nil {
+				log.ErrorD("complete-parent-task", logger.M{"workflow-id": workflow.ID, "error": err.Error()})
+			}
+		}
+		// a pending automatic retry takes over from this workflow, including its triggers
+		if workflow.AutoRetryAt == nil {
+			wm.startTriggeredWorkflows(ctx, workflow)
+		}
+	}
+	return wm.startPendingAutoRetry(ctx, workflow)
 }
 
 func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflow *models.Workflow) error {
 	// Pull in execution history to populate jobs array
-	// Each Job corresponds to a type={Task,Choice,Succeed} state, i.e. States we have currently tested and supported completely
-	// We only create a Job object if the State has been entered.
-	// Execution history events contain a "previous" event ID which is the "parent" event within the execution tree.
-	// E.g., if a state machine has two parallel Task states, the events for these states will overlap in the history, but the event IDs + previous event IDs will link together the parallel execution paths.
-	// In order to correctly associate events with the job they correspond to, maintain a map from event ID to job.
 	wd := workflow.WorkflowSummary.WorkflowDefinition
 	execARN := sfnconventions.ExecutionArn(
 		wm.region,
@@ -383,48 +530,9 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 		sfnconventions.StateMachineName(wd.Name, wd.Version, workflow.Namespace, wd.StateMachine.StartAt),
 		workflow.ID,
 	)
-	jobs := []*models.Job{}
-	eventIDToJob := map[int64]*models.Job{}
-	eventToJob := func(evt *sfn.HistoryEvent) *models.Job {
-		eventID := aws.Int64Value(evt.Id)
-		parentEventID := aws.Int64Value(evt.PreviousEventId)
-		switch *evt.Type {
-		case sfn.HistoryEventTypeExecutionStarted:
-			// very first event for an execution, so there are no jobs yet
-			return nil
-		case sfn.HistoryEventTypePassStateEntered, sfn.HistoryEventTypePassStateExited,
-			sfn.HistoryEventTypeParallelStateEntered, sfn.HistoryEventTypeParallelStateExited,
-			sfn.HistoryEventTypeWaitStateEntered, sfn.HistoryEventTypeWaitStateExited,
-			sfn.HistoryEventTypeFailStateEntered:
-			// only create Jobs for Task, Choice and Succeed states
-			return nil
-		case sfn.HistoryEventTypeTaskStateEntered, sfn.HistoryEventTypeChoiceStateEntered, sfn.HistoryEventTypeSucceedStateEntered:
-			// a job is created when a supported state is entered
-			job := &models.Job{}
-			jobs = append(jobs, job)
-			eventIDToJob[eventID] = job
-			return job
-		case sfn.HistoryEventTypeExecutionAborted:
-			// Execution-level event - update last seen job.
-			return jobs[len(jobs)-1]
-		case sfn.HistoryEventTypeExecutionFailed:
-			// Execution-level event - update last seen job.
-			return jobs[len(jobs)-1]
-		case sfn.HistoryEventTypeExecutionTimedOut:
-			// Execution-level event - update last seen job.
-			return jobs[len(jobs)-1]
-		default:
-			// associate this event with the same job as its parent event
-			job, ok := eventIDToJob[parentEventID]
-			if !ok {
-				// we should investigate these cases, since it means we have a gap in our interpretation of the event history
-				log.ErrorD("event-with-unknown-job", logger.M{"event-id": eventID, "execution-arn": execARN})
-				return nil
-			}
-			eventIDToJob[eventID] = job
-			return job
-		}
-	}
+	parser := sfnhistory.NewParser(workflow, func(arn, resource string) models.StateResourceSource {
+		return stateResourceSource(arn, resource, wm.region, wm.accountID, workflow.Namespace)
+	})
 
 	// Setup a context with a timeout of one minute since
 	// we don't want to pull very large workflow histories
@@ -439,205 +547,28 @@ func (wm *SFNWorkflowManager) UpdateWorkflowHistory(ctx context.Context, workflo
 		// 1) limit the results with `maxResults`
 		// 2) set `reverseOrder` to true to get most recent events first
 		// 3) stop paging once we get to to the smallest job ID (aka event ID) that is still pending
-		for _, evt := range historyOutput.Events {
-			job := eventToJob(evt)
-			if job == nil {
-				continue
-			}
-			switch aws.StringValue(evt.Type) {
-			case sfn.HistoryEventTypeTaskStateEntered, sfn.HistoryEventTypeChoiceStateEntered, sfn.HistoryEventTypeSucceedStateEntered:
-				// event IDs start at 1 and are only unique to the execution, so this might not be ideal
-				job.ID = fmt.Sprintf("%d", aws.Int64Value(evt.Id))
-				job.Attempts = []*models.JobAttempt{}
-				job.CreatedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				if *evt.Type != sfn.HistoryEventTypeTaskStateEntered {
-					// Non-task states technically start immediately, since they don't wait on resources:
-					job.StartedAt = job.CreatedAt
-				}
-				job.Status = models.JobStatusCreated
-				if details := evt.StateEnteredEventDetails; details != nil {
-					stateName := aws.StringValue(details.Name)
-					var stateResourceName string
-					var stateResourceType models.StateResourceType
-					stateDef, ok := workflow.WorkflowDefinition.StateMachine.States[stateName]
-					if ok {
-						if strings.HasPrefix(stateDef.Resource, "lambda:") {
-							stateResourceName = strings.TrimPrefix(stateDef.Resource, "lambda:")
-							stateResourceType = models.StateResourceTypeLambdaFunctionARN
-						} else {
-							stateResourceName = stateDef.Resource
-							stateResourceType = models.StateResourceTypeActivityARN
-						}
-					}
-					job.Input = aws.StringValue(details.Input)
-					job.State = stateName
-
-					job.StateResource = &models.StateResource{
-						Name:        stateResourceName,
-						Type:        stateResourceType,
-						Namespace:   workflow.Namespace,
-						LastUpdated: strfmt.DateTime(aws.TimeValue(evt.Timestamp)),
-					}
-				}
-			case sfn.HistoryEventTypeActivityScheduled, sfn.HistoryEventTypeLambdaFunctionScheduled:
-				if job.Status == models.JobStatusFailed {
-					// this is a retry, copy job data to attempt array, re-initialize job data
-					oldJobData := *job
-					*job = models.Job{}
-					job.ID = fmt.Sprintf("%d", aws.Int64Value(evt.Id))
-					job.Attempts = append(oldJobData.Attempts, &models.JobAttempt{
-						Reason:    oldJobData.StatusReason,
-						CreatedAt: oldJobData.CreatedAt,
-						StartedAt: oldJobData.StartedAt,
-						StoppedAt: oldJobData.StoppedAt,
-						TaskARN:   oldJobData.Container,
-					})
-					job.CreatedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-					job.Input = oldJobData.Input
-					job.State = oldJobData.State
-					job.StateResource = &models.StateResource{
-						Name:        oldJobData.StateResource.Name,
-						Type:        oldJobData.StateResource.Type,
-						Namespace:   oldJobData.StateResource.Namespace,
-						LastUpdated: strfmt.DateTime(aws.TimeValue(evt.Timestamp)),
-					}
-				}
-				job.Status = models.JobStatusQueued
-			case sfn.HistoryEventTypeActivityStarted, sfn.HistoryEventTypeLambdaFunctionStarted:
-				job.Status = models.JobStatusRunning
-				job.StartedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				if details := evt.ActivityStartedEventDetails; details != nil {
-					job.Container = aws.StringValue(details.WorkerName)
-				}
-			case sfn.HistoryEventTypeActivityFailed, sfn.HistoryEventTypeLambdaFunctionFailed, sfn.HistoryEventTypeLambdaFunctionScheduleFailed, sfn.HistoryEventTypeLambdaFunctionStartFailed:
-				job.Status = models.JobStatusFailed
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				cause, errorName := causeAndErrorNameFromFailureEvent(evt)
-				// TODO: need more natural place to put error name...
-				job.StatusReason = strings.TrimSpace(fmt.Sprintf(
-					"%s\n%s",
-					getLastFewLines(cause),
-					errorName,
-				))
-			case sfn.HistoryEventTypeActivityTimedOut, sfn.HistoryEventTypeLambdaFunctionTimedOut:
-				job.Status = models.JobStatusFailed
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				cause, errorName := causeAndErrorNameFromFailureEvent(evt)
-				job.StatusReason = strings.TrimSpace(fmt.Sprintf(
-					"%s\n%s\n%s",
-					resources.StatusReasonJobTimedOut,
-					errorName,
-					getLastFewLines(cause),
-				))
-			case sfn.HistoryEventTypeActivitySucceeded, sfn.HistoryEventTypeLambdaFunctionSucceeded:
-				job.Status = models.JobStatusSucceeded
-			case sfn.HistoryEventTypeExecutionAborted:
-				job.Status = models.JobStatusAbortedByUser
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				if details := evt.ExecutionAbortedEventDetails; details != nil {
-					job.StatusReason = aws.StringValue(details.Cause)
-				}
-			case sfn.HistoryEventTypeExecutionFailed:
-				job.Status = models.JobStatusFailed
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				if details := evt.ExecutionFailedEventDetails; details != nil {
-					if isActivityDoesntExistFailure(evt.ExecutionFailedEventDetails) {
-						job.StatusReason = "State resource does not exist"
-					} else if isActivityTimedOutFailure(evt.ExecutionFailedEventDetails) {
-						// do not update job status reason -- it should already be updated based on the ActivityTimedOut event
-					} else {
-						// set unknown errors to StatusReason
-						job.StatusReason = strings.TrimSpace(fmt.Sprintf(
-							"%s\n%s",
-							getLastFewLines(aws.StringValue(details.Cause)),
-							aws.StringValue(details.Error),
-						))
-					}
-				}
-			case sfn.HistoryEventTypeExecutionTimedOut:
-				job.Status = models.JobStatusFailed
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				if details := evt.ExecutionTimedOutEventDetails; details != nil {
-					job.StatusReason = strings.TrimSpace(fmt.Sprintf(
-						"%s\n%s\n%s",
-						resources.StatusReasonWorkflowTimedOut,
-						aws.StringValue(details.Error),
-						getLastFewLines(aws.StringValue(details.Cause)),
-					))
-				} else {
-					job.StatusReason = resources.StatusReasonWorkflowTimedOut
-				}
-			case sfn.HistoryEventTypeTaskStateExited:
-				stateExited := evt.StateExitedEventDetails
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				if stateExited.Output != nil {
-					job.Output = aws.StringValue(stateExited.Output)
-				}
-			case sfn.HistoryEventTypeChoiceStateExited, sfn.HistoryEventTypeSucceedStateExited:
-				job.Status = models.JobStatusSucceeded
-				job.StoppedAt = strfmt.DateTime(aws.TimeValue(evt.Timestamp))
-				details := evt.StateExitedEventDetails
-				if details.Output != nil {
-					job.Output = aws.StringValue(details.Output)
-				}
-			}
-
-		}
+		parser.AddEvents(historyOutput.Events)
 		return true
 	}); err != nil {
 		return err
 	}
+	jobs := parser.Jobs()
+	if err := wm.setTaskTokens(ctx, jobs); err != nil {
+		return err
+	}
+	// the jobs are rebuilt from the history on every update, so only log newly found violations
+	previousViolations := map[string]bool{}
+	for _, job := range workflow.Jobs {
+		if job.ContractViolation != "" {
+			previousViolations[job.ID] = true
+		}
+	}
+	for _, job := range jobs {
+		if job.ContractViolation != "" && !previousViolations[job.ID] {
+			logJobContractViolation(job, workflow)
+		}
+	}
 	workflow.Jobs = jobs
 
 	return wm.store.UpdateWorkflow(ctx, *workflow)
 }
-
-// isActivityDoesntExistFailure checks if an execution failed because an activity doesn't exist.
-// This currently results in a cryptic AWS error, so the logic is probably over-broad: https://console.aws.amazon.com/support/home?region=us-west-2#/case/?displayId=4514731511&language=en
-// If SFN creates a more descriptive error event we should change this.
-func isActivityDoesntExistFailure(details *sfn.ExecutionFailedEventDetails) bool {
-	return aws.StringValue(details.Error) == "States.Runtime" &&
-		strings.Contains(aws.StringValue(details.Cause), "Internal Error")
-}
-
-// isActivityTimedOutFailure checks if an execution failed because an activity timed out,
-// based on the details of an 'execution failed' history event.
-func isActivityTimedOutFailure(details *sfn.ExecutionFailedEventDetails) bool {
-	return aws.StringValue(details.Error) == "States.Timeout"
-}
-
-func getLastFewLines(rawLines string) string {
-	lastFewLines := strings.Split(strings.TrimSpace(rawLines), "\n")
-	if len(lastFewLines) > maxFailureReasonLines {
-		lastFewLines = lastFewLines[len(lastFewLines)-maxFailureReasonLines:]
-	}
-
-	return strings.Join(lastFewLines, "\n")
-}
-
-func causeAndErrorNameFromFailureEvent(evt *sfn.HistoryEvent) (string, string) {
-	switch aws.StringValue(evt.Type) {
-	case sfn.HistoryEventTypeActivityFailed:
-		return aws.StringValue(evt.ActivityFailedEventDetails.Cause), aws.StringValue(evt.ActivityFailedEventDetails.Error)
-	case sfn.HistoryEventTypeActivityScheduleFailed:
-		return aws.StringValue(evt.ActivityScheduleFailedEventDetails.Cause), aws.StringValue(evt.ActivityScheduleFailedEventDetails.Error)
-	case sfn.HistoryEventTypeActivityTimedOut:
-		return aws.StringValue(evt.ActivityTimedOutEventDetails.Cause), aws.StringValue(evt.ActivityTimedOutEventDetails.Error)
-	case sfn.HistoryEventTypeExecutionAborted:
-		return aws.StringValue(evt.ExecutionAbortedEventDetails.Cause), aws.StringValue(evt.ExecutionAbortedEventDetails.Error)
-	case sfn.HistoryEventTypeExecutionFailed:
-		return aws.StringValue(evt.ExecutionFailedEventDetails.Cause), aws.StringValue(evt.ExecutionFailedEventDetails.Error)
-	case sfn.HistoryEventTypeExecutionTimedOut:
-		return aws.StringValue(evt.ExecutionTimedOutEventDetails.Cause), aws.StringValue(evt.ExecutionTimedOutEventDetails.Error)
-	case sfn.HistoryEventTypeLambdaFunctionFailed:
-		return aws.StringValue(evt.LambdaFunctionFailedEventDetails.Cause), aws.StringValue(evt.LambdaFunctionFailedEventDetails.Error)
-	case sfn.HistoryEventTypeLambdaFunctionScheduleFailed:
-		return aws.StringValue(evt.LambdaFunctionScheduleFailedEventDetails.Cause), aws.StringValue(evt.LambdaFunctionScheduleFailedEventDetails.Error)
-	case sfn.HistoryEventTypeLambdaFunctionStartFailed:
-		return aws.StringValue(evt.LambdaFunctionStartFailedEventDetails.Cause), aws.StringValue(evt.LambdaFunctionStartFailedEventDetails.Error)
-	case sfn.HistoryEventTypeLambdaFunctionTimedOut:
-		return aws.StringValue(evt.LambdaFunctionTimedOutEventDetails.Cause), aws.StringValue(evt.LambdaFunctionTimedOutEventDetails.Error)
-	default:
-		return "", ""
-	}
-}
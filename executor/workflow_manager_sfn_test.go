@@ -3,6 +3,8 @@ package executor
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +24,7 @@ import (
 	"github.com/Clever/workflow-manager/resources"
 	"github.com/Clever/workflow-manager/store"
 	"github.com/Clever/workflow-manager/store/memory"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
 )
 
 type sfnManagerTestController struct {
@@ -79,9 +83,13 @@ func TestStateMachineWithFullActivityARNs(t *testing.T) {
 				Type:     models.SLStateTypeTask,
 				Resource: "lambda:resource-name",
 			},
+			"foostatecallback": models.SLState{
+				Type:     models.SLStateTypeTask,
+				Resource: "callback:resource-name",
+			},
 		},
 	}
-	smWithFullActivityARNs := stateMachineWithFullActivityARNs(sm, "region", "accountID", "namespace")
+	smWithFullActivityARNs := stateMachineWithFullActivityARNs(sm, "region", "accountID", "namespace", nil)
 	require.Equal(t, map[string]models.SLState{
 		"foostate": models.SLState{
 			Type:     models.SLStateTypeTask,
@@ -91,7 +99,55 @@ func TestStateMachineWithFullActivityARNs(t *testing.T) {
 			Type:     models.SLStateTypeTask,
 			Resource: "arn:aws:lambda:region:accountID:function:namespace--resource-name",
 		},
+		"foostatecallback": models.SLState{
+			Type:     models.SLStateTypeTask,
+			Resource: "arn:aws:states:region:accountID:activity:namespace--workflow-manager-callback-resource-name",
+		},
 	}, smWithFullActivityARNs.States)
+
+	t.Log("Registered StateResources are used instead of the naming convention")
+	smWithFullActivityARNs = stateMachineWithFullActivityARNs(sm, "region", "accountID", "namespace", map[string]models.StateResource{
+		"lambda:resource-name": {
+			Name: "resource-name", Namespace: "namespace",
+			URI:  "arn:aws:lambda:region:accountID:function:other-name",
+			Type: models.StateResourceTypeLambdaFunctionARN,
+		},
+	})
+	assert.Equal(t, "arn:aws:states:region:accountID:activity:namespace--resource-name", smWithFullActivityARNs.States["foostate"].Resource)
+	assert.Equal(t, "arn:aws:lambda:region:accountID:function:other-name", smWithFullActivityARNs.States["foostatelambda"].Resource)
+}
+
+func TestRegisteredStateResources(t *testing.T) {
+	ctx := context.Background()
+	s := memory.New()
+	sm := models.SLStateMachine{
+		States: map[string]models.SLState{
+			"foostate": models.SLState{
+				Type:     models.SLStateTypeTask,
+				Resource: "resource-name",
+			},
+			"foostatelambda": models.SLState{
+				Type:     models.SLStateTypeTask,
+				Resource: "lambda:resource-name",
+			},
+		},
+	}
+
+	t.Log("LambdaFunctionARNs are only used for lambda resources")
+	lambdaARN := "arn:aws:lambda:region:accountID:function:other-name"
+	require.NoError(t, s.SaveStateResource(ctx, *resources.NewStateResource("resource-name", "namespace", lambdaARN, "")))
+	registered, err := registeredStateResources(ctx, s, sm, "namespace")
+	require.NoError(t, err)
+	require.Len(t, registered, 1)
+	assert.Equal(t, lambdaARN, registered["lambda:resource-name"].URI)
+
+	t.Log("ActivityARNs are only used for activity resources")
+	activityARN := "arn:aws:states:region:accountID:activity:other-name"
+	require.NoError(t, s.SaveStateResource(ctx, *resources.NewStateResource("resource-name", "namespace", activityARN, "")))
+	registered, err = registeredStateResources(ctx, s, sm, "namespace")
+	require.NoError(t, err)
+	require.Len(t, registered, 1)
+	assert.Equal(t, activityARN, registered["resource-name"].URI)
 }
 
 func TestStateMachineWithDefaultRetriers(t *testing.T) {
@@ -152,13 +208,9 @@ func TestCreateWorkflow(t *testing.T) {
 			"namespace",
 			c.workflowDefinition.StateMachine.StartAt,
 		)
-		c.mockSFNAPI.EXPECT().
-			DescribeStateMachine(&sfn.DescribeStateMachineInput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}).
-			Return(&sfn.DescribeStateMachineOutput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}, nil)
+		c.expectDescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}, *c.workflowDefinition, "namespace", stateMachineArn)
 		c.mockSFNAPI.EXPECT().
 			StartExecution(gomock.Any()).
 			Return(&sfn.StartExecutionOutput{}, nil)
@@ -177,6 +229,7 @@ func TestCreateWorkflow(t *testing.T) {
 			"namespace",
 			"queue",
 			map[string]interface{}{},
+			resources.RolloutArmCanary,
 		)
 		assert.Nil(t, err)
 		assert.NotNil(t, workflow)
@@ -192,6 +245,7 @@ func TestCreateWorkflow(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, workflow.CreatedAt.String(), savedWorkflow.CreatedAt.String())
 		assert.Equal(t, workflow.ID, savedWorkflow.ID)
+		assert.Equal(t, resources.RolloutArmCanary, savedWorkflow.RolloutArm)
 
 		t.Log("Verify updatePendingWorkflow causes in-progress workflow to be put back into the update queue")
 		sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
@@ -223,29 +277,187 @@ func TestCreateWorkflow(t *testing.T) {
 			}).
 			Return(&sqs.DeleteMessageOutput{}, nil)
 
-		wfID, err := updatePendingWorkflow(context.TODO(), msg, c.manager, c.store, c.mockSQSAPI, "")
+		wfID, err := updatePendingWorkflow(context.TODO(), msg, c.manager, c.store, c.mockSQSAPI, "", nil)
 		assert.Nil(t, err)
 		assert.Equal(t, workflow.ID, wfID)
 	})
 
-	t.Run("CreateWorkflow with added tags", func(t *testing.T) {
+	t.Run("CreateWorkflow creates StateMachines with registered StateResources", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		registeredARN := "arn:aws:states:us-west-2:000000000000:activity:shared--fake-resource-1"
+		require.NoError(t, c.store.SaveStateResource(ctx,
+			*resources.NewStateResource("fake-resource-1", "namespace", registeredARN, "")))
+		// Batch job definitions can't be Task resources, so they are ignored
+		require.NoError(t, c.store.SaveStateResource(ctx,
+			*resources.NewStateResource("fake-resource-2", "namespace", "arn:aws:batch:us-west-2:000000000000:job-definition/fake:1", "")))
+
+		stateMachineArn := sfnconventions.StateMachineArn(c.manager.region, c.manager.accountID,
+			c.workflowDefinition.Name,
+			c.workflowDefinition.Version,
+			"namespace",
+			c.workflowDefinition.StateMachine.StartAt,
+		)
+		gomock.InOrder(
+			c.mockSFNAPI.EXPECT().
+				DescribeStateMachine(gomock.Any()).
+				Return(nil, awserr.New(sfn.ErrCodeStateMachineDoesNotExist, "", nil)),
+			c.mockSFNAPI.EXPECT().
+				CreateStateMachine(gomock.Any()).
+				Do(func(input *sfn.CreateStateMachineInput) {
+					assert.Contains(t, *input.Definition, registeredARN)
+					assert.Contains(t, *input.Definition,
+						sfnconventions.SFNCLIResource("fake-resource-2", c.manager.region, c.manager.accountID, "namespace"))
+				}).
+				Return(&sfn.CreateStateMachineOutput{}, nil),
+		)
+		c.expectDescribeStateMachine(gomock.Any(), *c.workflowDefinition, "namespace", stateMachineArn)
+		c.mockSFNAPI.EXPECT().
+			StartExecution(gomock.Any()).
+			Return(&sfn.StartExecutionOutput{}, nil)
+		c.mockSQSAPI.EXPECT().
+			SendMessageWithContext(gomock.Any(), gomock.Any()).
+			Return(&sqs.SendMessageOutput{}, nil)
+
+		_, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition, input, "namespace", "queue", map[string]interface{}{}, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("CreateWorkflow doesn't update the StateMachines of numbered versions", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		stateMachineArn := sfnconventions.StateMachineArn(c.manager.region, c.manager.accountID,
+			c.workflowDefinition.Name,
+			c.workflowDefinition.Version,
+			"namespace",
+			c.workflowDefinition.StateMachine.StartAt,
+		)
+		c.expectDescribeStateMachine(gomock.Any(), *c.workflowDefinition, "namespace", stateMachineArn)
+		require.NoError(t, c.store.SaveStateResource(ctx, *resources.NewStateResource(
+			"fake-resource-1", "namespace", "arn:aws:states:us-west-2:000000000000:activity:shared--fake-resource-1", "",
+		)))
+		c.mockSFNAPI.EXPECT().
+			StartExecution(gomock.Any()).
+			Return(&sfn.StartExecutionOutput{}, nil)
+		c.mockSQSAPI.EXPECT().
+			SendMessageWithContext(gomock.Any(), gomock.Any()).
+			Return(&sqs.SendMessageOutput{}, nil)
+
+		_, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition, input, "namespace", "queue", map[string]interface{}{}, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("CreateWorkflow updates the StateMachines of version -1 when StateResources are registered", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 		c := newSFNManagerTestController(t)
 		defer c.tearDown()
+		c.workflowDefinition.Version = -1
 		stateMachineArn := sfnconventions.StateMachineArn(c.manager.region, c.manager.accountID,
 			c.workflowDefinition.Name,
 			c.workflowDefinition.Version,
 			"namespace",
 			c.workflowDefinition.StateMachine.StartAt,
 		)
+		createdDefinition, err := c.manager.stateMachineDefinition(ctx, *c.workflowDefinition, "namespace")
+		require.NoError(t, err)
+		registeredARN := "arn:aws:states:us-west-2:000000000000:activity:shared--fake-resource-1"
+		require.NoError(t, c.store.SaveStateResource(ctx,
+			*resources.NewStateResource("fake-resource-1", "namespace", registeredARN, "")))
+
 		c.mockSFNAPI.EXPECT().
-			DescribeStateMachine(&sfn.DescribeStateMachineInput{
+			DescribeStateMachine(gomock.Any()).
+			Return(&sfn.DescribeStateMachineOutput{
 				StateMachineArn: aws.String(stateMachineArn),
+				Definition:      aws.String(createdDefinition),
+			}, nil)
+		c.mockSFNAPI.EXPECT().
+			UpdateStateMachine(gomock.Any()).
+			Do(func(input *sfn.UpdateStateMachineInput) {
+				assert.Equal(t, stateMachineArn, aws.StringValue(input.StateMachineArn))
+				assert.Contains(t, *input.Definition, registeredARN)
 			}).
+			Return(&sfn.UpdateStateMachineOutput{}, nil)
+		c.expectDescribeStateMachine(gomock.Any(), *c.workflowDefinition, "namespace", stateMachineArn)
+		c.mockSFNAPI.EXPECT().
+			StartExecution(gomock.Any()).
+			Return(&sfn.StartExecutionOutput{}, nil).
+			Times(2)
+		c.mockSQSAPI.EXPECT().
+			SendMessageWithContext(gomock.Any(), gomock.Any()).
+			Return(&sqs.SendMessageOutput{}, nil).
+			Times(2)
+
+		_, err = c.manager.CreateWorkflow(ctx, *c.workflowDefinition, input, "namespace", "queue", map[string]interface{}{}, "")
+		require.NoError(t, err)
+
+		t.Log("the resolved definition is reused by the next start")
+		c.expectDescribeStateMachine(gomock.Any(), *c.workflowDefinition, "namespace", stateMachineArn)
+		require.NoError(t, c.store.DeleteStateResource(ctx, "fake-resource-1", "namespace"))
+		_, err = c.manager.CreateWorkflow(ctx, *c.workflowDefinition, input, "namespace", "queue", map[string]interface{}{}, "")
+		require.NoError(t, err)
+	})
+
+	t.Run("CreateWorkflow fails for missing resources", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		stateMachineArn := sfnconventions.StateMachineArn(c.manager.region, c.manager.accountID,
+			c.workflowDefinition.Name,
+			c.workflowDefinition.Version,
+			"namespace",
+			c.workflowDefinition.StateMachine.StartAt,
+		)
+		definition, err := c.manager.stateMachineDefinition(ctx, *c.workflowDefinition, "namespace")
+		require.NoError(t, err)
+		c.mockSFNAPI.EXPECT().
+			DescribeStateMachine(gomock.Any()).
 			Return(&sfn.DescribeStateMachineOutput{
 				StateMachineArn: aws.String(stateMachineArn),
+				Definition:      aws.String(definition),
 			}, nil)
+		missingARN := sfnconventions.SFNCLIResource("fake-resource-2", c.manager.region, c.manager.accountID, "namespace")
+		c.mockSFNAPI.EXPECT().
+			DescribeActivityWithContext(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx aws.Context, input *sfn.DescribeActivityInput, opts ...interface{}) (*sfn.DescribeActivityOutput, error) {
+				if *input.ActivityArn == missingARN {
+					return nil, awserr.New(sfn.ErrCodeActivityDoesNotExist, "", nil)
+				}
+				return &sfn.DescribeActivityOutput{}, nil
+			}).
+			Times(3)
+
+		workflow, err := c.manager.CreateWorkflow(ctx, *c.workflowDefinition, input, "namespace", "queue", map[string]interface{}{}, "")
+		assert.Nil(t, workflow)
+		require.IsType(t, models.BadRequest{}, err)
+		assert.Contains(t, err.Error(), missingARN)
+		workflows, _, err := c.store.GetWorkflows(ctx, &models.WorkflowQuery{
+			WorkflowDefinitionName: aws.String(c.workflowDefinition.Name),
+			Limit:                  10,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, workflows)
+	})
+
+	t.Run("CreateWorkflow with added tags", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		stateMachineArn := sfnconventions.StateMachineArn(c.manager.region, c.manager.accountID,
+			c.workflowDefinition.Name,
+			c.workflowDefinition.Version,
+			"namespace",
+			c.workflowDefinition.StateMachine.StartAt,
+		)
+		c.expectDescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}, *c.workflowDefinition, "namespace", stateMachineArn)
 		c.mockSFNAPI.EXPECT().
 			StartExecution(gomock.Any()).
 			Return(&sfn.StartExecutionOutput{}, nil)
@@ -263,6 +475,7 @@ func TestCreateWorkflow(t *testing.T) {
 			"namespace",
 			"queue",
 			map[string]interface{}{"newTag1": "newVal1", "newTag2": "newVal2"},
+			"",
 		)
 		assert.Nil(t, err)
 		// Create called with tags, so they should be added to c.workflowDefinition.DefaultTags
@@ -290,13 +503,9 @@ func TestCreateWorkflow(t *testing.T) {
 			c.workflowDefinition.StateMachine.StartAt,
 		)
 		awsError := awserr.New("test", "test", errors.New(""))
-		c.mockSFNAPI.EXPECT().
-			DescribeStateMachine(&sfn.DescribeStateMachineInput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}).
-			Return(&sfn.DescribeStateMachineOutput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}, nil)
+		c.expectDescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}, *c.workflowDefinition, "namespace", stateMachineArn)
 		c.mockSFNAPI.EXPECT().
 			StartExecution(gomock.Any()).
 			Return(nil, awsError)
@@ -306,6 +515,7 @@ func TestCreateWorkflow(t *testing.T) {
 			"namespace",
 			"queue",
 			map[string]interface{}{},
+			"",
 		)
 		assert.NotNil(t, err)
 		assert.Nil(t, workflow)
@@ -330,13 +540,9 @@ func TestRetryWorkflow(t *testing.T) {
 			"namespace",
 			c.workflowDefinition.StateMachine.StartAt,
 		)
-		c.mockSFNAPI.EXPECT().
-			DescribeStateMachine(&sfn.DescribeStateMachineInput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}).
-			Return(&sfn.DescribeStateMachineOutput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}, nil)
+		c.expectDescribeStateMachine(&sfn.DescribeStateMachineInput{
+			StateMachineArn: aws.String(stateMachineArn),
+		}, *c.workflowDefinition, "namespace", stateMachineArn)
 		c.mockSFNAPI.EXPECT().
 			StartExecution(gomock.Any()).
 			Return(&sfn.StartExecutionOutput{}, nil)
@@ -349,6 +555,7 @@ func TestRetryWorkflow(t *testing.T) {
 			"namespace",
 			"queue",
 			map[string]interface{}{},
+			"",
 		)
 		assert.Nil(t, err)
 		assert.NotNil(t, workflow)
@@ -363,17 +570,13 @@ func TestRetryWorkflow(t *testing.T) {
 		sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
 
 		t.Log("RetryWorkflow should fail if workflow is not yet done")
-		_, err = c.manager.RetryWorkflow(ctx, *workflow, workflow.WorkflowDefinition.StateMachine.StartAt, input)
+		_, err = c.manager.RetryWorkflow(ctx, *workflow, *workflow.WorkflowDefinition, workflow.WorkflowDefinition.StateMachine.StartAt, input)
 		assert.Error(t, err)
 
 		t.Log("Set workflow to failed, then retry it")
 		workflow.Status = models.WorkflowStatusFailed
 
-		c.mockSFNAPI.EXPECT().
-			DescribeStateMachine(gomock.Any()).
-			Return(&sfn.DescribeStateMachineOutput{
-				StateMachineArn: aws.String(stateMachineArn),
-			}, nil)
+		c.expectDescribeStateMachine(gomock.Any(), *c.workflowDefinition, "namespace", stateMachineArn)
 		c.mockSFNAPI.EXPECT().
 			StartExecution(gomock.Any()).
 			Return(&sfn.StartExecutionOutput{}, nil)
@@ -381,7 +584,7 @@ func TestRetryWorkflow(t *testing.T) {
 			SendMessageWithContext(gomock.Any(), gomock.Any()).
 			Return(&sqs.SendMessageOutput{}, nil)
 
-		workflow2, err := c.manager.RetryWorkflow(ctx, *workflow, workflow.WorkflowDefinition.StateMachine.StartAt, input)
+		workflow2, err := c.manager.RetryWorkflow(ctx, *workflow, *workflow.WorkflowDefinition, workflow.WorkflowDefinition.StateMachine.StartAt, input)
 		assert.Nil(t, err)
 		assert.NotNil(t, workflow2)
 		assert.Equal(t, workflow2.Namespace, "namespace")
@@ -423,6 +626,21 @@ func TestCancelWorkflow(t *testing.T) {
 	assert.Equal(t, true, workflow.ResolvedByUser)
 	assert.Equal(t, reason, workflow.StatusReason)
 
+	t.Log("Cancelling clears a scheduled automatic retry.")
+	retryAt := strfmt.DateTime(time.Now().Add(time.Minute))
+	workflow.AutoRetryAt = &retryAt
+	workflow.ResolvedByUser = false
+	c.updateWorkflow(ctx, t, workflow)
+	c.mockSFNAPI.EXPECT().
+		StopExecution(gomock.Any()).
+		Return(&sfn.StopExecutionOutput{}, nil)
+	require.NoError(t, c.manager.CancelWorkflow(ctx, workflow, reason))
+	assert.Nil(t, workflow.AutoRetryAt)
+	saved, err := c.store.GetWorkflowByID(ctx, workflow.ID)
+	require.NoError(t, err)
+	assert.Nil(t, saved.AutoRetryAt)
+	assert.True(t, saved.ResolvedByUser)
+
 	t.Log("Failed Workflows cannot be cancelled.")
 	workflow.Status = models.WorkflowStatusFailed
 	c.updateWorkflow(ctx, t, workflow)
@@ -448,6 +666,78 @@ func TestUpdateWorkflowStatusNoop(t *testing.T) {
 	assert.Equal(t, models.WorkflowStatusCancelled, workflow.Status)
 }
 
+// TestUpdateWorkflowStatusFromExecution checks the summary of workflows against the status of
+// their executions. Their jobs are parsed from the execution history in the sfnhistory package.
+func TestUpdateWorkflowStatusFromExecution(t *testing.T) {
+	tests := []struct {
+		name               string
+		executionStatus    string
+		executionOutput    string
+		statusReason       string
+		wantStatus         models.WorkflowStatus
+		wantStatusReason   string
+		wantWorkflowOutput string
+	}{
+		{
+			name:            "running",
+			executionStatus: sfn.ExecutionStatusRunning,
+			wantStatus:      models.WorkflowStatusRunning,
+		},
+		{
+			name:            "failed",
+			executionStatus: sfn.ExecutionStatusFailed,
+			wantStatus:      models.WorkflowStatusFailed,
+		},
+		{
+			name:               "succeeded",
+			executionStatus:    sfn.ExecutionStatusSucceeded,
+			executionOutput:    `{"output": true}`,
+			wantStatus:         models.WorkflowStatusSucceeded,
+			wantWorkflowOutput: `{"output": true}`,
+		},
+		{
+			name:             "cancelled by the user",
+			executionStatus:  sfn.ExecutionStatusAborted,
+			statusReason:     "cancelled by user",
+			wantStatus:       models.WorkflowStatusCancelled,
+			wantStatusReason: "cancelled by user",
+		},
+		{
+			name:             "timed out",
+			executionStatus:  sfn.ExecutionStatusTimedOut,
+			wantStatus:       models.WorkflowStatusFailed,
+			wantStatusReason: resources.StatusReasonWorkflowTimedOut,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			c := newSFNManagerTestController(t)
+			defer c.tearDown()
+
+			workflow := c.newWorkflow()
+			workflow.Status = models.WorkflowStatusQueued
+			workflow.StatusReason = test.statusReason
+			c.saveWorkflow(ctx, t, workflow)
+
+			describeOutput := &sfn.DescribeExecutionOutput{Status: aws.String(test.executionStatus)}
+			if test.executionOutput != "" {
+				describeOutput.Output = aws.String(test.executionOutput)
+			}
+			c.mockSFNAPI.EXPECT().
+				DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String(c.manager.executionArn(workflow, c.workflowDefinition)),
+				}).
+				Return(describeOutput, nil)
+
+			require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+			assert.Equal(t, test.wantStatus, workflow.Status)
+			assert.Equal(t, test.wantStatusReason, workflow.StatusReason)
+			assert.Equal(t, test.wantWorkflowOutput, workflow.Output)
+		})
+	}
+}
+
 var jobCreatedEventTimestamp = time.Now()
 var jobCreatedEvent = &sfn.HistoryEvent{
 	Id:        aws.Int64(1),
@@ -466,56 +756,134 @@ func assertBasicJobData(t *testing.T, job *models.Job) {
 	assert.WithinDuration(t, jobCreatedEventTimestamp, time.Time(job.CreatedAt), 1*time.Second)
 }
 
-func TestUpdateWorkflowStatusJobCreated(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+var jobSucceededEventTimestamp = jobCreatedEventTimestamp.Add(5 * time.Minute)
+var jobSucceededEvent = &sfn.HistoryEvent{
+	Id:              aws.Int64(2),
+	PreviousEventId: aws.Int64(1),
+	Timestamp:       aws.Time(jobSucceededEventTimestamp),
+	Type:            aws.String(sfn.HistoryEventTypeActivitySucceeded),
+}
+var jobExitedEventTimestamp = jobSucceededEventTimestamp.Add(5 * time.Second)
+var jobExitedEvent = &sfn.HistoryEvent{
+	Id:              aws.Int64(3),
+	PreviousEventId: aws.Int64(2),
+	Timestamp:       aws.Time(jobExitedEventTimestamp),
+	Type:            aws.String(sfn.HistoryEventTypeTaskStateExited),
+	StateExitedEventDetails: &sfn.StateExitedEventDetails{
+		Name:   jobCreatedEvent.StateEnteredEventDetails.Name,
+		Output: aws.String(`{out: "put"}`),
+	},
+}
+
+func assertSucceededJobData(t *testing.T, job *models.Job) {
+	assert.Equal(t, models.JobStatusSucceeded, job.Status)
+	assert.Equal(t, `{out: "put"}`, job.Output)
+	assert.WithinDuration(t, jobExitedEventTimestamp, time.Time(job.StoppedAt), 1*time.Second)
+}
+
+func TestUpdateWorkflowHistoryContractViolation(t *testing.T) {
+	ctx := context.Background()
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
-
+	mocklog := logger.NewMockCountLogger("workflow-manager")
+	defer func(previous logger.KayveeLogger) { log = previous }(log)
+	log = mocklog
+
+	c.workflowDefinition.OutputSchemas = map[string]interface{}{
+		"my-first-state": map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"id"},
+		},
+	}
 	workflow := c.newWorkflow()
-	workflow.Status = models.WorkflowStatusQueued
+	workflow.Status = models.WorkflowStatusRunning
 	c.saveWorkflow(ctx, t, workflow)
 
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
+	output := `{"other": true}`
 	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
+		GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(
+			ctx aws.Context,
+			input *sfn.GetExecutionHistoryInput,
+			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
+		) {
+			exitedEvent := *jobExitedEvent
+			exitedEvent.StateExitedEventDetails = &sfn.StateExitedEventDetails{
+				Name:   jobCreatedEvent.StateEnteredEventDetails.Name,
+				Output: aws.String(output),
+			}
+			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
+				jobCreatedEvent,
+				jobSucceededEvent,
+				&exitedEvent,
+			}}, true)
 		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusRunning),
-		}, nil)
+		Times(3)
+
+	t.Log("Output that violates the state's output schema is flagged on the job")
+	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
+	require.Len(t, workflow.Jobs, 1)
+	assert.Equal(t, models.JobStatusSucceeded, workflow.Jobs[0].Status)
+	assert.Contains(t, workflow.Jobs[0].ContractViolation, "id is required")
+
+	t.Log("The violation is only logged when it is first found")
+	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
+	assert.Equal(t, 1, mocklog.RuleCounts()["job-contract-violation"])
+
+	t.Log("Output that matches the schema isn't flagged")
+	output = `{"id": "a"}`
+	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
+	require.Len(t, workflow.Jobs, 1)
+	assert.Empty(t, workflow.Jobs[0].ContractViolation)
+}
+
+func TestUpdateWorkflowHistoryStateResources(t *testing.T) {
+	ctx := context.Background()
+	c := newSFNManagerTestController(t)
+	defer c.tearDown()
+	workflow := c.newWorkflow()
+	workflow.Status = models.WorkflowStatusRunning
+	c.saveWorkflow(ctx, t, workflow)
+
+	scheduledARN := ""
 	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
+		GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
 		Do(func(
 			ctx aws.Context,
 			input *sfn.GetExecutionHistoryInput,
 			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
 		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{jobCreatedEvent}}, true)
-		})
+			createdEvent := *jobCreatedEvent
+			createdEvent.StateEnteredEventDetails = &sfn.StateEnteredEventDetails{
+				Name:  aws.String("start-state"),
+				Input: aws.String(`{}`),
+			}
+			scheduledEvent := *jobScheduledEvent
+			scheduledEvent.ActivityScheduledEventDetails = &sfn.ActivityScheduledEventDetails{
+				Resource: aws.String(scheduledARN),
+			}
+			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
+				&createdEvent,
+				&scheduledEvent,
+			}}, true)
+		}).
+		Times(2)
 
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	t.Log("Jobs report the ARN they were scheduled on and where it came from")
+	scheduledARN = sfnconventions.SFNCLIResource("fake-resource-1", c.manager.region, c.manager.accountID, "namespace")
 	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusRunning, workflow.Status)
 	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assert.Equal(t, models.JobStatusCreated, workflow.Jobs[0].Status)
-}
+	assert.Equal(t, scheduledARN, workflow.Jobs[0].StateResource.URI)
+	assert.Equal(t, models.StateResourceSourceConvention, workflow.Jobs[0].StateResource.Source)
 
-var jobFailedEventTimestamp = jobCreatedEventTimestamp.Add(5 * time.Minute)
-var jobFailedEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(2),
-	PreviousEventId: aws.Int64(1),
-	Timestamp:       aws.Time(jobFailedEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeActivityFailed),
-	ActivityFailedEventDetails: &sfn.ActivityFailedEventDetails{
-		Cause: aws.String("line1\nline2\nline3\nline4\nline5\nline6\n\n"),
-	},
+	scheduledARN = "arn:aws:states:us-west-2:000000000000:activity:shared--fake-resource-1"
+	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
+	require.Len(t, workflow.Jobs, 1)
+	assert.Equal(t, scheduledARN, workflow.Jobs[0].StateResource.URI)
+	assert.Equal(t, models.StateResourceSourceRegistered, workflow.Jobs[0].StateResource.Source)
 }
 
-func TestUpdateWorkflowStatusJobFailed(t *testing.T) {
+func TestUpdateWorkflowStatusExecutionNotFoundRetry(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	c := newSFNManagerTestController(t)
@@ -525,13 +893,22 @@ func TestUpdateWorkflowStatusJobFailed(t *testing.T) {
 	workflow.Status = models.WorkflowStatusQueued
 	c.saveWorkflow(ctx, t, workflow)
 
+	executionOutput := `{"output": true}`
 	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
+	// fail the first time
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+			ExecutionArn: aws.String(sfnExecutionARN),
+		}).
+		Return(nil, awserr.New(sfn.ErrCodeExecutionDoesNotExist, "test", errors.New("")))
+	// then success
 	c.mockSFNAPI.EXPECT().
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
 		}).
 		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusFailed),
+			Status: aws.String(sfn.ExecutionStatusSucceeded),
+			Output: aws.String(executionOutput),
 		}, nil)
 
 	c.mockSFNAPI.EXPECT().
@@ -545,25 +922,27 @@ func TestUpdateWorkflowStatusJobFailed(t *testing.T) {
 		) {
 			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
 				jobCreatedEvent,
-				jobFailedEvent,
+				jobSucceededEvent,
+				jobExitedEvent,
 			}}, true)
 		})
 
 	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Equal(t, models.WorkflowStatusQueued, workflow.Status)
+	require.Len(t, workflow.Jobs, 0)
+
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Equal(t, models.WorkflowStatusSucceeded, workflow.Status)
 	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
 	require.Len(t, workflow.Jobs, 1)
 	assertBasicJobData(t, workflow.Jobs[0])
-	assert.Equal(t, models.JobStatusFailed, workflow.Jobs[0].Status)
-	assert.Equal(t, "line4\nline5\nline6", workflow.Jobs[0].StatusReason)
-	assert.WithinDuration(
-		t, jobFailedEventTimestamp, time.Time(workflow.Jobs[0].StoppedAt), 1*time.Second,
-	)
+	assertSucceededJobData(t, workflow.Jobs[0])
 }
 
-func TestUpdateWorkflowStatusJobFailedNotDeployed(t *testing.T) {
+func TestUpdateWorkflowStatusExecutionNotFoundStopRetry(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	durationToRetryDescribeExecutions = 1 * time.Second
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
 
@@ -576,455 +955,609 @@ func TestUpdateWorkflowStatusJobFailedNotDeployed(t *testing.T) {
 		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
 			ExecutionArn: aws.String(sfnExecutionARN),
 		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusFailed),
-		}, nil)
-
-	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
-		Do(func(
-			ctx aws.Context,
-			input *sfn.GetExecutionHistoryInput,
-			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
-		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				&sfn.HistoryEvent{
-					Id:        aws.Int64(2),
-					Timestamp: aws.Time(jobFailedEventTimestamp),
-					Type:      aws.String(sfn.HistoryEventTypeExecutionFailed),
-					ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{
-						Cause: aws.String("Internal Error (49b863bd-3367-4035-a76d-bfb2e777ece3)"),
-						Error: aws.String("States.Runtime"),
-					},
-				},
-			}}, true)
-		})
+		Return(nil, awserr.New(sfn.ErrCodeExecutionDoesNotExist,
+			"test",
+			errors.New("")),
+		).
+		Times(2)
+
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Equal(t, models.WorkflowStatusQueued, workflow.Status)
+	require.Len(t, workflow.Jobs, 0)
+	time.Sleep(durationToRetryDescribeExecutions)
 
 	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
 	assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
-	require.Len(t, workflow.Jobs, 1)
-	assert.Equal(t, models.JobStatusFailed, workflow.Jobs[0].Status)
-	assert.Equal(t, "State resource does not exist", workflow.Jobs[0].StatusReason)
-	assert.WithinDuration(
-		t, jobFailedEventTimestamp, time.Time(workflow.Jobs[0].StoppedAt), 1*time.Second,
-	)
+	require.Len(t, workflow.Jobs, 0)
 }
 
-var jobSucceededEventTimestamp = jobCreatedEventTimestamp.Add(5 * time.Minute)
-var jobSucceededEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(2),
-	PreviousEventId: aws.Int64(1),
-	Timestamp:       aws.Time(jobSucceededEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeActivitySucceeded),
+var jobScheduledEventTimestamp = jobCreatedEventTimestamp.Add(1 * time.Minute)
+var jobScheduledEvent = &sfn.HistoryEvent{
+	Id:              aws.Int64(6),
+	PreviousEventId: aws.Int64(1), // job created
+	Timestamp:       aws.Time(jobScheduledEventTimestamp),
+	Type:            aws.String(sfn.HistoryEventTypeActivityScheduled),
 }
-var jobExitedEventTimestamp = jobSucceededEventTimestamp.Add(5 * time.Second)
-var jobExitedEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(3),
-	PreviousEventId: aws.Int64(2),
-	Timestamp:       aws.Time(jobExitedEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeTaskStateExited),
-	StateExitedEventDetails: &sfn.StateExitedEventDetails{
-		Name:   jobCreatedEvent.StateEnteredEventDetails.Name,
-		Output: aws.String(`{out: "put"}`),
-	},
+var jobStartedEventTimestamp = jobScheduledEventTimestamp.Add(1 * time.Minute)
+var jobStartedEvent = &sfn.HistoryEvent{
+	Id:              aws.Int64(7),
+	PreviousEventId: aws.Int64(6),
+	Timestamp:       aws.Time(jobStartedEventTimestamp),
+	Type:            aws.String(sfn.HistoryEventTypeActivityStarted),
 }
 
-func assertSucceededJobData(t *testing.T, job *models.Job) {
-	assert.Equal(t, models.JobStatusSucceeded, job.Status)
-	assert.Equal(t, `{out: "put"}`, job.Output)
-	assert.WithinDuration(t, jobExitedEventTimestamp, time.Time(job.StoppedAt), 1*time.Second)
-}
+func TestUpdateWorkflowSummaryAutoRetry(t *testing.T) {
+	executionFailedEvent := &sfn.HistoryEvent{
+		Id:   aws.Int64(3),
+		Type: aws.String(sfn.HistoryEventTypeExecutionFailed),
+		ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{
+			Error: aws.String("States.TaskFailed"),
+			Cause: aws.String("connection reset"),
+		},
+	}
+	expectFailedExecution := func(c *sfnManagerTestController, workflow *models.Workflow) {
+		sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
+		c.mockSFNAPI.EXPECT().
+			DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
+				ExecutionArn: aws.String(sfnExecutionARN),
+			}).
+			Return(&sfn.DescribeExecutionOutput{
+				Status: aws.String(sfn.ExecutionStatusFailed),
+			}, nil)
+		c.mockSFNAPI.EXPECT().
+			GetExecutionHistoryWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
+				ExecutionArn: aws.String(sfnExecutionARN),
+				MaxResults:   aws.Int64(1),
+				ReverseOrder: aws.Bool(true),
+			}).
+			Return(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{executionFailedEvent}}, nil)
+	}
+	expectRetry := func(c *sfnManagerTestController, startAt string) {
+		retryDefinition := resources.CopyWorkflowDefinition(*c.workflowDefinition)
+		retryDefinition.StateMachine.StartAt = startAt
+		require.NoError(c.t, resources.RemoveInactiveStates(retryDefinition.StateMachine))
+		c.expectDescribeStateMachine(gomock.Any(), retryDefinition, "namespace", "arn")
+		c.mockSFNAPI.EXPECT().
+			StartExecution(gomock.Any()).
+			Return(&sfn.StartExecutionOutput{}, nil)
+		c.mockSQSAPI.EXPECT().
+			SendMessageWithContext(gomock.Any(), gomock.Any()).
+			Return(&sqs.SendMessageOutput{}, nil)
+	}
 
-func TestUpdateWorkflowStatusWorkflowJobSucceeded(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	c := newSFNManagerTestController(t)
-	defer c.tearDown()
+	t.Run("restarts a workflow whose failure matches the policy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		c.workflowDefinition.AutoRetry = &models.AutoRetryPolicy{
+			MaxAttempts: 1,
+			ErrorEquals: []string{"States.TaskFailed"},
+			Mode:        models.AutoRetryModeRestart,
+		}
 
-	workflow := c.newWorkflow()
-	workflow.Status = models.WorkflowStatusQueued
-	c.saveWorkflow(ctx, t, workflow)
+		workflow := c.newWorkflow()
+		workflow.Input = `{"input": true}`
+		workflow.Status = models.WorkflowStatusRunning
+		c.saveWorkflow(ctx, t, workflow)
+
+		expectFailedExecution(c, workflow)
+		expectRetry(c, "start-state")
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+		assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
+		assert.Nil(t, workflow.AutoRetryAt)
+		require.Len(t, workflow.Retries, 1)
+
+		retry, err := c.store.GetWorkflowByID(ctx, workflow.Retries[0])
+		require.NoError(t, err)
+		assert.Equal(t, workflow.ID, retry.RetryFor)
+		assert.Equal(t, int64(1), retry.AutoRetryAttempt)
+		assert.Equal(t, workflow.Input, retry.Input)
+		assert.Equal(t, "start-state", retry.WorkflowDefinition.StateMachine.StartAt)
+
+		saved, err := c.store.GetWorkflowByID(ctx, workflow.ID)
+		require.NoError(t, err)
+		assert.Nil(t, saved.AutoRetryAt)
+		assert.Equal(t, []string{retry.ID}, saved.Retries)
+
+		t.Log("the retry is not retried again once MaxAttempts is reached")
+		retry.Status = models.WorkflowStatusRunning
+		c.updateWorkflow(ctx, t, &retry)
+		c.mockSFNAPI.EXPECT().
+			DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+			Return(&sfn.DescribeExecutionOutput{
+				Status: aws.String(sfn.ExecutionStatusFailed),
+			}, nil)
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, &retry))
+		assert.Nil(t, retry.AutoRetryAt)
+		assert.Empty(t, retry.Retries)
+	})
 
-	executionOutput := `{"output": true}`
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
-	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusSucceeded),
-			Output: aws.String(executionOutput),
-		}, nil)
+	t.Run("starts a retry once for callers with copies of the workflow", func(t *testing.T) {
+		ctx := context.Background()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		c.workflowDefinition.AutoRetry = &models.AutoRetryPolicy{
+			MaxAttempts: 1,
+			Mode:        models.AutoRetryModeRestart,
+		}
 
-	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
-		Do(func(
-			ctx aws.Context,
-			input *sfn.GetExecutionHistoryInput,
-			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
-		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				jobSucceededEvent,
-				jobExitedEvent,
-			}}, true)
-		})
+		workflow := c.newWorkflow()
+		workflow.Input = `{"input": true}`
+		workflow.Status = models.WorkflowStatusFailed
+		retryAt := strfmt.DateTime(time.Now().Add(-time.Second))
+		workflow.AutoRetryAt = &retryAt
+		c.saveWorkflow(ctx, t, workflow)
+		stale := *workflow
+
+		expectRetry(c, "start-state")
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+		require.Len(t, workflow.Retries, 1)
+
+		t.Log("the other copy finds the retry claimed")
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, &stale))
+		assert.Nil(t, stale.AutoRetryAt)
+		assert.Empty(t, stale.Retries)
+
+		saved, err := c.store.GetWorkflowByID(ctx, workflow.ID)
+		require.NoError(t, err)
+		assert.Nil(t, saved.AutoRetryAt)
+		assert.Equal(t, workflow.Retries, saved.Retries)
+	})
 
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusSucceeded, workflow.Status)
-	assert.Equal(t, executionOutput, workflow.Output)
-	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assertSucceededJobData(t, workflow.Jobs[0])
-}
+	t.Run("doesn't retry workflows resolved by the user", func(t *testing.T) {
+		ctx := context.Background()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		c.workflowDefinition.AutoRetry = &models.AutoRetryPolicy{
+			MaxAttempts: 1,
+			Mode:        models.AutoRetryModeRestart,
+		}
 
-var jobAbortedEventTimestamp = jobSucceededEventTimestamp.Add(5 * time.Minute)
-var jobAbortedEvent = &sfn.HistoryEvent{
-	Id:        aws.Int64(5),
-	Timestamp: aws.Time(jobAbortedEventTimestamp),
-	Type:      aws.String(sfn.HistoryEventTypeExecutionAborted),
-	ExecutionAbortedEventDetails: &sfn.ExecutionAbortedEventDetails{
-		Cause: aws.String("sfn abort reason"),
-	},
-}
+		workflow := c.newWorkflow()
+		workflow.Status = models.WorkflowStatusFailed
+		workflow.ResolvedByUser = true
+		retryAt := strfmt.DateTime(time.Now().Add(-time.Second))
+		workflow.AutoRetryAt = &retryAt
+		c.saveWorkflow(ctx, t, workflow)
+
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+		assert.Empty(t, workflow.Retries)
+	})
+
+	t.Run("ignores failures that don't match the policy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		c.workflowDefinition.AutoRetry = &models.AutoRetryPolicy{
+			MaxAttempts:         1,
+			StatusReasonPattern: "throttled",
+		}
+
+		workflow := c.newWorkflow()
+		workflow.Input = `{"input": true}`
+		workflow.Status = models.WorkflowStatusRunning
+		c.saveWorkflow(ctx, t, workflow)
 
-func assertCancelledJobData(t *testing.T, job *models.Job) {
-	assert.Equal(t, models.JobStatusAbortedByUser, job.Status)
-	assert.Equal(t, "sfn abort reason", job.StatusReason)
-	assert.WithinDuration(t, jobAbortedEventTimestamp, time.Time(job.StoppedAt), 1*time.Second)
+		expectFailedExecution(c, workflow)
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+		assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
+		assert.Nil(t, workflow.AutoRetryAt)
+		assert.Empty(t, workflow.Retries)
+	})
+
+	t.Run("resumes from the failed state after the backoff", func(t *testing.T) {
+		ctx := context.Background()
+		c := newSFNManagerTestController(t)
+		defer c.tearDown()
+		c.workflowDefinition.AutoRetry = &models.AutoRetryPolicy{
+			MaxAttempts:     2,
+			IntervalSeconds: 60,
+		}
+
+		workflow := c.newWorkflow()
+		workflow.Input = `{"input": true}`
+		workflow.Status = models.WorkflowStatusRunning
+		c.saveWorkflow(ctx, t, workflow)
+
+		expectFailedExecution(c, workflow)
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+		require.NotNil(t, workflow.AutoRetryAt)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), time.Time(*workflow.AutoRetryAt), 5*time.Second)
+		assert.Empty(t, workflow.Retries)
+
+		t.Log("once the backoff has passed the failed state is resumed with its original input")
+		retryAt := strfmt.DateTime(time.Now().Add(-time.Second))
+		workflow.AutoRetryAt = &retryAt
+		c.updateWorkflow(ctx, t, workflow)
+		c.mockSFNAPI.EXPECT().
+			GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(
+				ctx aws.Context,
+				input *sfn.GetExecutionHistoryInput,
+				cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
+			) {
+				cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
+					{
+						Id:   aws.Int64(1),
+						Type: aws.String(sfn.HistoryEventTypeTaskStateEntered),
+						StateEnteredEventDetails: &sfn.StateEnteredEventDetails{
+							Name:  aws.String("second-state"),
+							Input: aws.String(`{"second": true}`),
+						},
+					},
+					{
+						Id:                         aws.Int64(2),
+						PreviousEventId:            aws.Int64(1),
+						Type:                       aws.String(sfn.HistoryEventTypeActivityFailed),
+						ActivityFailedEventDetails: &sfn.ActivityFailedEventDetails{},
+					},
+					executionFailedEvent,
+				}}, true)
+			})
+		expectRetry(c, "second-state")
+		require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+		assert.Nil(t, workflow.AutoRetryAt)
+		require.Len(t, workflow.Retries, 1)
+
+		retry, err := c.store.GetWorkflowByID(ctx, workflow.Retries[0])
+		require.NoError(t, err)
+		assert.Equal(t, "second-state", retry.WorkflowDefinition.StateMachine.StartAt)
+		assert.Equal(t, `{"second": true}`, retry.Input)
+		assert.Equal(t, int64(1), retry.AutoRetryAttempt)
+	})
 }
 
-func TestUpdateWorkflowStatusJobCancelled(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func TestUpdateWorkflowSummaryDeadlines(t *testing.T) {
+	ctx := context.Background()
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
+	c.workflowDefinition.Deadlines = &models.WorkflowDeadlines{
+		SoftDeadlineSeconds: 60,
+		MaxAgeSeconds:       3600,
+	}
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{
+			Status: aws.String(sfn.ExecutionStatusRunning),
+		}, nil).
+		AnyTimes()
 
+	t.Log("Workflows within their deadlines are left alone")
 	workflow := c.newWorkflow()
-	workflow.Status = models.WorkflowStatusQueued
-	workflow.StatusReason = "cancelled by user"
+	workflow.Status = models.WorkflowStatusRunning
 	c.saveWorkflow(ctx, t, workflow)
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.False(t, workflow.SoftDeadlineBreached)
 
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
-	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusAborted),
-		}, nil)
+	t.Log("Workflows past their soft deadline are flagged")
+	workflow.CreatedAt = strfmt.DateTime(time.Now().Add(-2 * time.Minute))
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.True(t, workflow.SoftDeadlineBreached)
+	assert.Equal(t, models.WorkflowStatusRunning, workflow.Status)
+	saved, err := c.store.GetWorkflowByID(ctx, workflow.ID)
+	require.NoError(t, err)
+	assert.True(t, saved.SoftDeadlineBreached)
 
+	t.Log("Workflows past their max age are cancelled")
+	workflow.CreatedAt = strfmt.DateTime(time.Now().Add(-2 * time.Hour))
 	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
-		Do(func(
-			ctx aws.Context,
-			input *sfn.GetExecutionHistoryInput,
-			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
-		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				jobAbortedEvent,
-			}}, true)
-		})
+		StopExecution(&sfn.StopExecutionInput{
+			ExecutionArn: aws.String(c.manager.executionArn(workflow, c.workflowDefinition)),
+			Cause:        aws.String(resources.StatusReasonWorkflowMaxAgeExceeded),
+		}).
+		Return(&sfn.StopExecutionOutput{}, nil)
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Equal(t, resources.StatusReasonWorkflowMaxAgeExceeded, workflow.StatusReason)
+	assert.True(t, workflow.ResolvedByUser)
 
+	t.Log("Workflows aren't cancelled again while their execution stops")
 	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusCancelled, workflow.Status)
-	assert.Equal(t, "cancelled by user", workflow.StatusReason)
-	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assertCancelledJobData(t, workflow.Jobs[0])
+	assert.Equal(t, models.WorkflowStatusRunning, workflow.Status)
 }
 
-func TestUpdateWorkflowStatusWorkflowCancelledAfterJobSucceeded(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func TestSignalWorkflow(t *testing.T) {
+	ctx := context.Background()
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
+	state := c.workflowDefinition.StateMachine.States["start-state"]
+	state.Resource = "callback:approval"
+	c.workflowDefinition.StateMachine.States["start-state"] = state
+	activityArn := sfnconventions.CallbackResource(state.Resource, "", "", "namespace")
 
-	workflow := c.newWorkflow()
-	workflow.Status = models.WorkflowStatusQueued
-	workflow.StatusReason = "cancelled by user"
-	c.saveWorkflow(ctx, t, workflow)
+	t.Log("Callback activities are created and polled once per namespace")
+	c.mockSFNAPI.EXPECT().
+		CreateActivityWithContext(gomock.Any(), &sfn.CreateActivityInput{
+			Name: aws.String("namespace--workflow-manager-callback-approval"),
+		}).
+		Return(&sfn.CreateActivityOutput{ActivityArn: aws.String(activityArn)}, nil)
+	require.NoError(t, c.manager.registerCallbackActivities(ctx, *c.workflowDefinition, "namespace"))
+	require.NoError(t, c.manager.registerCallbackActivities(ctx, *c.workflowDefinition, "namespace"))
+	assert.Contains(t, c.manager.callbacks.arns, activityArn)
 
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
+	t.Log("Task tokens of callback states are saved by worker name")
+	var workerName string
 	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
+		GetActivityTaskWithContext(gomock.Any(), gomock.Any()).
+		Do(func(ctx aws.Context, input *sfn.GetActivityTaskInput, opts ...interface{}) {
+			assert.Equal(t, activityArn, aws.StringValue(input.ActivityArn))
+			workerName = aws.StringValue(input.WorkerName)
 		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusAborted),
-		}, nil)
+		Return(&sfn.GetActivityTaskOutput{TaskToken: aws.String("token"), Input: aws.String("{}")}, nil)
+	require.NoError(t, c.manager.takeCallbackTask(ctx, activityArn))
+	token, err := c.store.GetTaskToken(ctx, workerName)
+	require.NoError(t, err)
+	assert.Equal(t, "token", token)
 
+	workflow := c.newWorkflow()
+	workflow.Status = models.WorkflowStatusRunning
+	c.saveWorkflow(ctx, t, workflow)
+	callbackStartedEvent := *jobStartedEvent
+	callbackStartedEvent.PreviousEventId = jobScheduledEvent.Id
+	callbackStartedEvent.ActivityStartedEventDetails = &sfn.ActivityStartedEventDetails{
+		WorkerName: aws.String(workerName),
+	}
+	callbackEnteredEvent := *jobCreatedEvent
+	callbackEnteredEvent.StateEnteredEventDetails = &sfn.StateEnteredEventDetails{
+		Name:  aws.String("start-state"),
+		Input: aws.String("{}"),
+	}
 	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
+		GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
 		Do(func(
 			ctx aws.Context,
 			input *sfn.GetExecutionHistoryInput,
 			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
 		) {
 			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				jobSucceededEvent,
-				jobAbortedEvent,
+				&callbackEnteredEvent,
+				jobScheduledEvent,
+				&callbackStartedEvent,
 			}}, true)
-		})
+		}).
+		Times(4)
 
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	t.Log("Running callback states expose their task token")
 	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusCancelled, workflow.Status)
-	assert.Equal(t, "cancelled by user", workflow.StatusReason)
 	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assertCancelledJobData(t, workflow.Jobs[0])
+	assert.Equal(t, models.JobStatusRunning, workflow.Jobs[0].Status)
+	assert.Equal(t, "token", workflow.Jobs[0].TaskToken)
+
+	t.Log("Signals complete the state with their output")
+	c.mockSFNAPI.EXPECT().
+		SendTaskSuccessWithContext(gomock.Any(), &sfn.SendTaskSuccessInput{
+			TaskToken: aws.String("token"),
+			Output:    aws.String(`{"approved": true}`),
+		}).
+		Return(&sfn.SendTaskSuccessOutput{}, nil)
+	require.NoError(t, c.manager.SignalWorkflow(ctx, workflow, "start-state", models.SignalRequest{
+		Output: `{"approved": true}`,
+	}))
+
+	t.Log("Signals with an error fail the state")
+	c.mockSFNAPI.EXPECT().
+		SendTaskFailureWithContext(gomock.Any(), &sfn.SendTaskFailureInput{
+			TaskToken: aws.String("token"),
+			Error:     aws.String("Rejected"),
+			Cause:     aws.String("not approved"),
+		}).
+		Return(nil, awserr.New(sfn.ErrCodeTaskTimedOut, "task timed out", nil))
+	err = c.manager.SignalWorkflow(ctx, workflow, "start-state", models.SignalRequest{
+		Error: "Rejected",
+		Cause: "not approved",
+	})
+	assert.IsType(t, models.Conflict{}, err)
+
+	t.Log("States that are not waiting for a signal cannot be signaled")
+	err = c.manager.SignalWorkflow(ctx, workflow, "second-state", models.SignalRequest{})
+	assert.IsType(t, models.Conflict{}, err)
 }
 
-func TestUpdateWorkflowStatusExecutionNotFoundRetry(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func TestChildWorkflows(t *testing.T) {
+	ctx := context.Background()
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
+	childDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	childDefinition.Name = "child"
+	require.NoError(t, c.store.SaveWorkflowDefinition(ctx, *childDefinition))
+	state := c.workflowDefinition.StateMachine.States["start-state"]
+	state.Resource = "workflow:child"
+	c.workflowDefinition.StateMachine.States["start-state"] = state
+	activityArn := sfnconventions.ChildWorkflowResource(state.Resource, "", "", "namespace")
 
-	workflow := c.newWorkflow()
-	workflow.Status = models.WorkflowStatusQueued
-	c.saveWorkflow(ctx, t, workflow)
-
-	executionOutput := `{"output": true}`
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
-	// fail the first time
 	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
+		CreateActivityWithContext(gomock.Any(), &sfn.CreateActivityInput{
+			Name: aws.String("namespace--workflow-manager-workflow-child"),
 		}).
-		Return(nil, awserr.New(sfn.ErrCodeExecutionDoesNotExist, "test", errors.New("")))
-	// then success
+		Return(&sfn.CreateActivityOutput{ActivityArn: aws.String(activityArn)}, nil)
+	require.NoError(t, c.manager.registerCallbackActivities(ctx, *c.workflowDefinition, "namespace"))
+
+	parent := c.newWorkflow()
+	parent.Status = models.WorkflowStatusRunning
+	c.saveWorkflow(ctx, t, parent)
+
+	t.Log("Child workflow states pass the parent's workflow ID from the context object")
+	definition, err := withChildWorkflowParameters([]byte(`{"States": {"start-state": {"Type": "Task"}}}`), *c.workflowDefinition.StateMachine)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"States": {"start-state": {"Type": "Task", "Parameters": {
+		"_PARENT_WORKFLOW_ID.$": "$$.Execution.Name",
+		"_INPUT.$": "$"
+	}}}}`, string(definition))
+
+	t.Log("Tasks of child workflow states start the child workflow with the state's input")
 	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
+		GetActivityTaskWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.GetActivityTaskOutput{
+			TaskToken: aws.String("token"),
+			Input:     aws.String(fmt.Sprintf(`{"_PARENT_WORKFLOW_ID": "%s", "_INPUT": {"a": 1}}`, parent.ID)),
+		}, nil)
+	c.expectDescribeStateMachine(gomock.Any(), *childDefinition, "namespace", "child-state-machine")
+	c.mockSFNAPI.EXPECT().
+		StartExecution(gomock.Any()).
+		Do(func(input *sfn.StartExecutionInput) {
+			assert.Equal(t, "child-state-machine", aws.StringValue(input.StateMachineArn))
+			assert.JSONEq(t, fmt.Sprintf(`{"_EXECUTION_NAME": "%s", "a": 1}`, aws.StringValue(input.Name)), aws.StringValue(input.Input))
 		}).
+		Return(&sfn.StartExecutionOutput{}, nil)
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.SendMessageOutput{}, nil)
+	require.NoError(t, c.manager.takeCallbackTask(ctx, activityArn))
+
+	savedParent, err := c.store.GetWorkflowByID(ctx, parent.ID)
+	require.NoError(t, err)
+	require.Len(t, savedParent.ChildWorkflowIDs, 1)
+	child, err := c.store.GetWorkflowByID(ctx, savedParent.ChildWorkflowIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, parent.ID, child.ParentWorkflowID)
+	assert.Equal(t, "child", child.WorkflowDefinition.Name)
+	assert.Equal(t, "queue", child.Queue)
+
+	t.Log("The parent's state completes with the output of the child workflow")
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
 		Return(&sfn.DescribeExecutionOutput{
 			Status: aws.String(sfn.ExecutionStatusSucceeded),
-			Output: aws.String(executionOutput),
+			Output: aws.String(`{"b": 2}`),
 		}, nil)
-
 	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
-		Do(func(
-			ctx aws.Context,
-			input *sfn.GetExecutionHistoryInput,
-			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
-		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				jobSucceededEvent,
-				jobExitedEvent,
-			}}, true)
-		})
-
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusQueued, workflow.Status)
-	require.Len(t, workflow.Jobs, 0)
-
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusSucceeded, workflow.Status)
-	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assertSucceededJobData(t, workflow.Jobs[0])
-}
-
-func TestUpdateWorkflowStatusExecutionNotFoundStopRetry(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-	durationToRetryDescribeExecutions = 1 * time.Second
-	c := newSFNManagerTestController(t)
-	defer c.tearDown()
-
-	workflow := c.newWorkflow()
-	workflow.Status = models.WorkflowStatusQueued
-	c.saveWorkflow(ctx, t, workflow)
-
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
+		SendTaskSuccessWithContext(gomock.Any(), &sfn.SendTaskSuccessInput{
+			TaskToken: aws.String("token"),
+			Output:    aws.String(`{"b": 2}`),
+		}).
+		Return(&sfn.SendTaskSuccessOutput{}, nil)
+	child.Status = models.WorkflowStatusRunning
+	c.updateWorkflow(ctx, t, &child)
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, &child))
+
+	t.Log("Cancelling the parent cancels its active children")
+	runningChild := resources.NewWorkflow(childDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	runningChild.Status = models.WorkflowStatusRunning
+	runningChild.ParentWorkflowID = parent.ID
+	c.saveWorkflow(ctx, t, runningChild)
+	savedParent.ChildWorkflowIDs = append(savedParent.ChildWorkflowIDs, runningChild.ID)
 	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
+		StopExecution(&sfn.StopExecutionInput{
+			ExecutionArn: aws.String(c.manager.executionArn(&savedParent, c.workflowDefinition)),
+			Cause:        aws.String("stop"),
 		}).
-		Return(nil, awserr.New(sfn.ErrCodeExecutionDoesNotExist,
-			"test",
-			errors.New("")),
-		).
-		Times(2)
-
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusQueued, workflow.Status)
-	require.Len(t, workflow.Jobs, 0)
-	time.Sleep(durationToRetryDescribeExecutions)
-
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
-	require.Len(t, workflow.Jobs, 0)
-}
-
-var jobScheduledEventTimestamp = jobCreatedEventTimestamp.Add(1 * time.Minute)
-var jobScheduledEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(6),
-	PreviousEventId: aws.Int64(1), // job created
-	Timestamp:       aws.Time(jobScheduledEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeActivityScheduled),
-}
-var jobStartedEventTimestamp = jobScheduledEventTimestamp.Add(1 * time.Minute)
-var jobStartedEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(7),
-	PreviousEventId: aws.Int64(6),
-	Timestamp:       aws.Time(jobStartedEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeActivityStarted),
-}
-var jobTimedOutEventTimestamp = jobStartedEventTimestamp.Add(5 * time.Minute)
-var jobTimedOutEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(8),
-	PreviousEventId: aws.Int64(7),
-	Timestamp:       aws.Time(jobTimedOutEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeActivityTimedOut),
-	ActivityTimedOutEventDetails: &sfn.ActivityTimedOutEventDetails{
-		Error: aws.String("States.Timeout"), // this string will actually be States.Timeout
-		Cause: aws.String("(sfn cause for activity that timed out)"),
-	},
-}
-var jobTimedOutWorkflowFailedEventTimestamp = jobTimedOutEventTimestamp
-var jobTimedOutWorkflowFailedEvent = &sfn.HistoryEvent{
-	Id:              aws.Int64(9),
-	PreviousEventId: aws.Int64(8),
-	Timestamp:       aws.Time(jobTimedOutWorkflowFailedEventTimestamp),
-	Type:            aws.String(sfn.HistoryEventTypeExecutionFailed),
-	ExecutionFailedEventDetails: &sfn.ExecutionFailedEventDetails{
-		Error: aws.String("States.Timeout"),
-	},
-}
-
-func assertTimedOutJobData(t *testing.T, job *models.Job) {
-	assert.Equal(t, models.JobStatusFailed, job.Status)
-	// not currently using the details in TestUpdateWorkflowStatusJobTimedOut test
-	// also this format does not match the output in workflow_manager_sfn
-	assert.Equal(t, "Job timed out", resources.StatusReasonJobTimedOut)
-	assert.Contains(t, job.StatusReason, resources.StatusReasonJobTimedOut)
-	assert.WithinDuration(t, jobTimedOutEventTimestamp, time.Time(job.StoppedAt), 1*time.Second)
+		Return(&sfn.StopExecutionOutput{}, nil)
+	c.mockSFNAPI.EXPECT().
+		StopExecution(&sfn.StopExecutionInput{
+			ExecutionArn: aws.String(c.manager.executionArn(runningChild, childDefinition)),
+			Cause:        aws.String(fmt.Sprintf("parent workflow %s cancelled: stop", parent.ID)),
+		}).
+		Return(&sfn.StopExecutionOutput{}, nil)
+	require.NoError(t, c.manager.CancelWorkflow(ctx, &savedParent, "stop"))
 }
 
-func TestUpdateWorkflowStatusJobTimedOut(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func TestUpdateWorkflowSummaryTriggers(t *testing.T) {
+	ctx := context.Background()
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
+	nextDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	nextDefinition.Name = "next"
+	require.NoError(t, c.store.SaveWorkflowDefinition(ctx, *nextDefinition))
+	c.workflowDefinition.Triggers = []*models.WorkflowTrigger{
+		{
+			On:                     models.WorkflowStatusSucceeded,
+			WorkflowDefinitionName: "next",
+			InputMapping:           map[string]string{"source": "$.id"},
+		},
+		{
+			On:                     models.WorkflowStatusFailed,
+			WorkflowDefinitionName: "next",
+		},
+	}
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{
+			Status: aws.String(sfn.ExecutionStatusSucceeded),
+			Output: aws.String(`{"result": true}`),
+		}, nil).
+		Times(2)
 
+	t.Log("Workflows start the workflows triggered by their terminal status")
 	workflow := c.newWorkflow()
 	workflow.Status = models.WorkflowStatusRunning
 	c.saveWorkflow(ctx, t, workflow)
-
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
+	var triggeredID string
+	c.expectDescribeStateMachine(gomock.Any(), *nextDefinition, "namespace", "next-state-machine")
 	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
+		StartExecution(gomock.Any()).
+		Do(func(input *sfn.StartExecutionInput) {
+			triggeredID = aws.StringValue(input.Name)
+			assert.JSONEq(t, fmt.Sprintf(`{"_EXECUTION_NAME": "%s", "source": "%s"}`, triggeredID, workflow.ID), aws.StringValue(input.Input))
 		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusFailed), // when activity times out, execution immediately fails
-		}, nil)
-
-	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
-		Do(func(
-			ctx aws.Context,
-			input *sfn.GetExecutionHistoryInput,
-			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
-		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				jobScheduledEvent,
-				jobStartedEvent, // ActivityStarted - starts timer for timeouts & heartbeat checks
-				jobTimedOutEvent,
-				jobTimedOutWorkflowFailedEvent,
-			}}, true)
-		})
-
+		Return(&sfn.StartExecutionOutput{}, nil)
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.SendMessageOutput{}, nil)
 	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
-	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assertTimedOutJobData(t, workflow.Jobs[0])
-}
-
-var workflowTimedOutEventTimestamp = jobCreatedEventTimestamp.Add(10 * time.Minute)
-var workflowTimedOutEvent = &sfn.HistoryEvent{
-	Id:        aws.Int64(8),
-	Timestamp: aws.Time(workflowTimedOutEventTimestamp),
-	Type:      aws.String(sfn.HistoryEventTypeExecutionTimedOut),
-}
-
-func assertWorkflowTimedOutJobData(t *testing.T, job *models.Job) {
-	assert.Equal(t, models.JobStatusFailed, job.Status)
-	assert.Equal(t, "Workflow timed out", resources.StatusReasonWorkflowTimedOut)
-	assert.Contains(t, job.StatusReason, resources.StatusReasonWorkflowTimedOut)
-	assert.WithinDuration(t, workflowTimedOutEventTimestamp, time.Time(job.StoppedAt), 1*time.Second)
+	triggered, err := c.store.GetWorkflowByID(ctx, triggeredID)
+	require.NoError(t, err)
+	assert.Equal(t, "next", triggered.WorkflowDefinition.Name)
+	assert.Equal(t, workflow.ID, triggered.TriggeredBy)
+	assert.Equal(t, int64(1), triggered.TriggerDepth)
+	assert.Equal(t, workflow.Queue, triggered.Queue)
+
+	t.Log("Chains of triggered workflows are limited")
+	workflow = c.newWorkflow()
+	workflow.Status = models.WorkflowStatusRunning
+	workflow.TriggerDepth = maxTriggerDepth
+	c.saveWorkflow(ctx, t, workflow)
+	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
+	assert.Equal(t, models.WorkflowStatusSucceeded, workflow.Status)
 }
 
-func TestUpdateWorkflowStatusWorkflowTimedOut(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func TestUpdateWorkflowSummaryTriggersOnce(t *testing.T) {
+	ctx := context.Background()
 	c := newSFNManagerTestController(t)
 	defer c.tearDown()
+	nextDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	nextDefinition.Name = "next"
+	require.NoError(t, c.store.SaveWorkflowDefinition(ctx, *nextDefinition))
+	c.workflowDefinition.Triggers = []*models.WorkflowTrigger{
+		{
+			On:                     models.WorkflowStatusSucceeded,
+			WorkflowDefinitionName: "next",
+		},
+	}
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{
+			Status: aws.String(sfn.ExecutionStatusSucceeded),
+		}, nil).
+		Times(2)
 
+	t.Log("Concurrent callers with copies of a workflow that finished start its triggers once")
 	workflow := c.newWorkflow()
 	workflow.Status = models.WorkflowStatusRunning
 	c.saveWorkflow(ctx, t, workflow)
-
-	sfnExecutionARN := c.manager.executionArn(workflow, c.workflowDefinition)
-	c.mockSFNAPI.EXPECT().
-		DescribeExecutionWithContext(gomock.Any(), &sfn.DescribeExecutionInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}).
-		Return(&sfn.DescribeExecutionOutput{
-			Status: aws.String(sfn.ExecutionStatusTimedOut),
-		}, nil)
-
+	c.expectDescribeStateMachine(gomock.Any(), *nextDefinition, "namespace", "next-state-machine")
 	c.mockSFNAPI.EXPECT().
-		GetExecutionHistoryPagesWithContext(gomock.Any(), &sfn.GetExecutionHistoryInput{
-			ExecutionArn: aws.String(sfnExecutionARN),
-		}, gomock.Any()).
-		Do(func(
-			ctx aws.Context,
-			input *sfn.GetExecutionHistoryInput,
-			cb func(historyOutput *sfn.GetExecutionHistoryOutput, lastPage bool) bool,
-		) {
-			cb(&sfn.GetExecutionHistoryOutput{Events: []*sfn.HistoryEvent{
-				jobCreatedEvent,
-				workflowTimedOutEvent,
-			}}, true)
-		})
+		StartExecution(gomock.Any()).
+		Return(&sfn.StartExecutionOutput{}, nil)
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(&sqs.SendMessageOutput{}, nil)
+
+	copies := []models.Workflow{*workflow, *workflow}
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := range copies {
+		wg.Add(1)
+		go func(copy *models.Workflow) {
+			defer wg.Done()
+			<-start
+			assert.NoError(t, c.manager.UpdateWorkflowSummary(ctx, copy))
+		}(&copies[i])
+	}
+	close(start)
+	wg.Wait()
 
-	require.NoError(t, c.manager.UpdateWorkflowSummary(ctx, workflow))
-	require.NoError(t, c.manager.UpdateWorkflowHistory(ctx, workflow))
-	assert.Equal(t, models.WorkflowStatusFailed, workflow.Status)
-	// TODO: this line below is not testing anything. Verify what needs to go in this assertion
-	assert.Equal(t, "Workflow timed out", resources.StatusReasonWorkflowTimedOut)
-	assert.Equal(t, resources.StatusReasonWorkflowTimedOut, workflow.StatusReason)
-	require.Len(t, workflow.Jobs, 1)
-	assertBasicJobData(t, workflow.Jobs[0])
-	assertWorkflowTimedOutJobData(t, workflow.Jobs[0])
+	saved, err := c.store.GetWorkflowByID(ctx, workflow.ID)
+	require.NoError(t, err)
+	assert.Equal(t, models.WorkflowStatusSucceeded, saved.Status)
 }
 
 func newSFNManagerTestController(t *testing.T) *sfnManagerTestController {
@@ -1037,7 +1570,7 @@ func newSFNManagerTestController(t *testing.T) *sfnManagerTestController {
 	require.NoError(t, store.SaveWorkflowDefinition(context.Background(), *workflowDefinition))
 
 	return &sfnManagerTestController{
-		manager:            NewSFNWorkflowManager(mockSFNAPI, mockSQSAPI, store, "", "", "", ""),
+		manager:            NewSFNWorkflowManager(mockSFNAPI, mockSQSAPI, nil, store, "", "", "", ""),
 		mockController:     mockController,
 		mockSFNAPI:         mockSFNAPI,
 		mockSQSAPI:         mockSQSAPI,
@@ -1047,6 +1580,23 @@ func newSFNManagerTestController(t *testing.T) *sfnManagerTestController {
 	}
 }
 
+// expectDescribeStateMachine expects the state machine of wd in a namespace to be described, and
+// returns it with the definition its StateResources resolve to so that it isn't updated.
+func (c *sfnManagerTestController) expectDescribeStateMachine(input interface{}, wd models.WorkflowDefinition, namespace, arn string) {
+	definition, err := c.manager.stateMachineDefinition(context.Background(), wd, namespace)
+	require.NoError(c.t, err)
+	c.mockSFNAPI.EXPECT().
+		DescribeStateMachine(input).
+		Return(&sfn.DescribeStateMachineOutput{
+			StateMachineArn: aws.String(arn),
+			Definition:      aws.String(definition),
+		}, nil)
+	c.mockSFNAPI.EXPECT().
+		DescribeActivityWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeActivityOutput{}, nil).
+		AnyTimes()
+}
+
 func (c *sfnManagerTestController) newWorkflow() *models.Workflow {
 	return resources.NewWorkflow(
 		c.workflowDefinition, `["input"]`, "namespace", "queue", map[string]interface{}{},
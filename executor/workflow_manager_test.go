@@ -61,7 +61,7 @@ func TestUpdatePendingWorkflowStoreWorkflowSucceeds(t *testing.T) {
 		Return(&descExecOutput, nil)
 
 	c.store.EXPECT().
-		UpdateWorkflow(gomock.Any(), gomock.Any()).
+		UpdateWorkflowFromStatus(gomock.Any(), gomock.Any(), models.WorkflowStatusRunning).
 		Return(nil).
 		Times(1)
 
@@ -76,7 +76,7 @@ func TestUpdatePendingWorkflowStoreWorkflowSucceeds(t *testing.T) {
 		Return(nil, nil).
 		Times(0)
 
-	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue")
+	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue", nil)
 	require.NoError(t, err)
 	require.Equal(t, id, wfID)
 }
@@ -131,7 +131,139 @@ func TestUpdatePendingWorkflowStoreWorkflowStillRunning(t *testing.T) {
 		Return(nil, nil).
 		Times(1)
 
-	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue")
+	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue", nil)
+	require.NoError(t, err)
+	require.Equal(t, id, wfID)
+}
+
+// testWatcher records the workflows it's told about.
+type testWatcher struct {
+	watched map[string]bool
+	updated []models.Workflow
+}
+
+func (w *testWatcher) IsWatched(workflowID string) bool {
+	return w.watched[workflowID]
+}
+
+func (w *testWatcher) WorkflowUpdated(workflow models.Workflow) {
+	w.updated = append(w.updated, workflow)
+}
+
+func TestUpdatePendingWorkflowWatched(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newWfmTestController(t)
+	defer c.mockController.Finish()
+
+	id := uuid.NewV4().String()
+	wf := models.Workflow{
+		WorkflowSummary: models.WorkflowSummary{
+			ID:          id,
+			LastUpdated: strfmt.DateTime(time.Now()),
+			Status:      models.WorkflowStatusRunning,
+			WorkflowDefinition: &models.WorkflowDefinition{
+				StateMachine: &models.SLStateMachine{
+					StartAt: "foo",
+				},
+			},
+		},
+		Jobs: []*models.Job{},
+	}
+	watcher := &testWatcher{watched: map[string]bool{id: true}}
+
+	c.store.EXPECT().
+		GetWorkflowByID(gomock.Any(), gomock.Eq(id)).
+		Return(wf, nil)
+
+	running := string(sfn.ExecutionStatusRunning)
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{Status: &running}, nil)
+
+	// watched workflows have their jobs synced for their watchers
+	c.mockSFNAPI.EXPECT().
+		GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	c.store.EXPECT().
+		UpdateWorkflow(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(2)
+
+	c.mockSQSAPI.EXPECT().
+		DeleteMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue", watcher)
+	require.NoError(t, err)
+	require.Equal(t, id, wfID)
+	require.Len(t, watcher.updated, 1)
+	require.Equal(t, id, watcher.updated[0].ID)
+}
+
+func TestUpdatePendingWorkflowQueuedActivityJob(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newWfmTestController(t)
+	defer c.mockController.Finish()
+
+	id := uuid.NewV4().String()
+	wf := models.Workflow{
+		WorkflowSummary: models.WorkflowSummary{
+			ID:          id,
+			LastUpdated: strfmt.DateTime(time.Now()),
+			Status:      models.WorkflowStatusRunning,
+			WorkflowDefinition: &models.WorkflowDefinition{
+				StateMachine: &models.SLStateMachine{
+					StartAt: "foo",
+					States: map[string]models.SLState{
+						"foo": {Type: models.SLStateTypeTask, Resource: "fake-resource", End: true},
+					},
+				},
+			},
+		},
+		Jobs: []*models.Job{{ID: "1", State: "foo", Status: models.JobStatusQueued}},
+	}
+
+	c.store.EXPECT().
+		GetWorkflowByID(gomock.Any(), gomock.Eq(id)).
+		Return(wf, nil)
+
+	running := string(sfn.ExecutionStatusRunning)
+	c.mockSFNAPI.EXPECT().
+		DescribeExecutionWithContext(gomock.Any(), gomock.Any()).
+		Return(&sfn.DescribeExecutionOutput{Status: &running}, nil)
+
+	// jobs queued for activity workers are synced until they've started
+	c.mockSFNAPI.EXPECT().
+		GetExecutionHistoryPagesWithContext(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	c.store.EXPECT().
+		UpdateWorkflow(gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(2)
+
+	c.mockSQSAPI.EXPECT().
+		DeleteMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	c.mockSQSAPI.EXPECT().
+		SendMessageWithContext(gomock.Any(), gomock.Any()).
+		Return(nil, nil).
+		Times(1)
+
+	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue", nil)
 	require.NoError(t, err)
 	require.Equal(t, id, wfID)
 }
@@ -171,7 +303,7 @@ func TestUpdatePendingWorkflowStoreWorkflowFails(t *testing.T) {
 		Return(&descExecOutput, nil)
 
 	c.store.EXPECT().
-		UpdateWorkflow(gomock.Any(), gomock.Any()).
+		UpdateWorkflowFromStatus(gomock.Any(), gomock.Any(), models.WorkflowStatusRunning).
 		Return(errors.New("planned failure")).
 		Times(1)
 
@@ -186,7 +318,7 @@ func TestUpdatePendingWorkflowStoreWorkflowFails(t *testing.T) {
 		Return(nil, nil).
 		Times(1)
 
-	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue")
+	wfID, err := updatePendingWorkflow(ctx, &sqs.Message{Body: &id}, c.manager, c.store, c.mockSQSAPI, "urlQueue", nil)
 	require.Error(t, err)
 	require.Equal(t, "", wfID)
 }
@@ -200,7 +332,7 @@ func newWfmTestController(t *testing.T) *wfmTestController {
 	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
 
 	return &wfmTestController{
-		manager:            NewSFNWorkflowManager(mockSFNAPI, mockSQSAPI, mockStore, "", "", "", ""),
+		manager:            NewSFNWorkflowManager(mockSFNAPI, mockSQSAPI, nil, mockStore, "", "", "", ""),
 		mockController:     mockController,
 		mockSFNAPI:         mockSFNAPI,
 		mockSQSAPI:         mockSQSAPI,
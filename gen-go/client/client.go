@@ -238,17 +238,17 @@ func (c *WagClient) doHealthCheckRequest(ctx context.Context, req *http.Request,
 	}
 }
 
-// PostStateResource makes a POST request to /state-resources
+// StartBulkOperation makes a POST request to /bulk-operations
 //
-// 201: *models.StateResource
+// 201: *models.BulkOperation
 // 400: *models.BadRequest
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
+func (c *WagClient) StartBulkOperation(ctx context.Context, i *models.BulkOperationRequest) (*models.BulkOperation, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path := c.basePath + "/state-resources"
+	path := c.basePath + "/bulk-operations"
 
 	if i != nil {
 
@@ -267,10 +267,10 @@ func (c *WagClient) PostStateResource(ctx context.Context, i *models.NewStateRes
 		return nil, err
 	}
 
-	return c.doPostStateResourceRequest(ctx, req, headers)
+	return c.doStartBulkOperationRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doPostStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.StateResource, error) {
+func (c *WagClient) doStartBulkOperationRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.BulkOperation, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -280,7 +280,7 @@ func (c *WagClient) doPostStateResourceRequest(ctx context.Context, req *http.Re
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "postStateResource")
+	ctx = context.WithValue(ctx, opNameCtx{}, "startBulkOperation")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -317,7 +317,7 @@ func (c *WagClient) doPostStateResourceRequest(ctx context.Context, req *http.Re
 
 	case 201:
 
-		var output models.StateResource
+		var output models.BulkOperation
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
@@ -345,35 +345,35 @@ func (c *WagClient) doPostStateResourceRequest(ctx context.Context, req *http.Re
 	}
 }
 
-// DeleteStateResource makes a DELETE request to /state-resources/{namespace}/{name}
+// GetBulkOperationByID makes a GET request to /bulk-operations/{operationID}
 //
-// 200: nil
+// 200: *models.BulkOperation
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) DeleteStateResource(ctx context.Context, i *models.DeleteStateResourceInput) error {
+func (c *WagClient) GetBulkOperationByID(ctx context.Context, operationID string) (*models.BulkOperation, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := i.Path()
+	path, err := models.GetBulkOperationByIDInputPath(operationID)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	path = c.basePath + path
 
-	req, err := http.NewRequest("DELETE", path, bytes.NewBuffer(body))
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return c.doDeleteStateResourceRequest(ctx, req, headers)
+	return c.doGetBulkOperationByIDRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doDeleteStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+func (c *WagClient) doGetBulkOperationByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.BulkOperation, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -383,7 +383,7 @@ func (c *WagClient) doDeleteStateResourceRequest(ctx context.Context, req *http.
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "deleteStateResource")
+	ctx = context.WithValue(ctx, opNameCtx{}, "getBulkOperationByID")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -413,73 +413,83 @@ func (c *WagClient) doDeleteStateResourceRequest(ctx context.Context, req *http.
 	if err != nil {
 		logData["message"] = err.Error()
 		c.logger.ErrorD("client-request-finished", logData)
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
 	case 200:
 
-		return nil
+		var output models.BulkOperation
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
 
 	case 400:
 
 		var output models.BadRequest
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return err
+			return nil, err
 		}
-		return &output
+		return nil, &output
 
 	case 404:
 
 		var output models.NotFound
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return err
+			return nil, err
 		}
-		return &output
+		return nil, &output
 
 	case 500:
 
 		var output models.InternalError
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return err
+			return nil, err
 		}
-		return &output
+		return nil, &output
 
 	default:
-		return &models.InternalError{Message: "Unknown response"}
+		return nil, &models.InternalError{Message: "Unknown response"}
 	}
 }
 
-// GetStateResource makes a GET request to /state-resources/{namespace}/{name}
+// EvaluatePath makes a POST request to /path-evaluations
 //
-// 200: *models.StateResource
+// 200: *models.PathEvaluation
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) GetStateResource(ctx context.Context, i *models.GetStateResourceInput) (*models.StateResource, error) {
+func (c *WagClient) EvaluatePath(ctx context.Context, i *models.PathEvaluationRequest) (*models.PathEvaluation, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := i.Path()
+	path := c.basePath + "/path-evaluations"
 
-	if err != nil {
-		return nil, err
-	}
+	if i != nil {
 
-	path = c.basePath + path
+		var err error
+		body, err = json.Marshal(i)
 
-	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doGetStateResourceRequest(ctx, req, headers)
+	return c.doEvaluatePathRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doGetStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.StateResource, error) {
+func (c *WagClient) doEvaluatePathRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.PathEvaluation, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -489,7 +499,7 @@ func (c *WagClient) doGetStateResourceRequest(ctx context.Context, req *http.Req
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "getStateResource")
+	ctx = context.WithValue(ctx, opNameCtx{}, "evaluatePath")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -526,7 +536,7 @@ func (c *WagClient) doGetStateResourceRequest(ctx context.Context, req *http.Req
 
 	case 200:
 
-		var output models.StateResource
+		var output models.PathEvaluation
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
@@ -562,28 +572,22 @@ func (c *WagClient) doGetStateResourceRequest(ctx context.Context, req *http.Req
 	}
 }
 
-// PutStateResource makes a PUT request to /state-resources/{namespace}/{name}
+// PostStateResource makes a POST request to /state-resources
 //
 // 201: *models.StateResource
 // 400: *models.BadRequest
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) PutStateResource(ctx context.Context, i *models.PutStateResourceInput) (*models.StateResource, error) {
+func (c *WagClient) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := i.Path()
-
-	if err != nil {
-		return nil, err
-	}
-
-	path = c.basePath + path
+	path := c.basePath + "/state-resources"
 
-	if i.NewStateResource != nil {
+	if i != nil {
 
 		var err error
-		body, err = json.Marshal(i.NewStateResource)
+		body, err = json.Marshal(i)
 
 		if err != nil {
 			return nil, err
@@ -591,16 +595,16 @@ func (c *WagClient) PutStateResource(ctx context.Context, i *models.PutStateReso
 
 	}
 
-	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doPutStateResourceRequest(ctx, req, headers)
+	return c.doPostStateResourceRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doPutStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.StateResource, error) {
+func (c *WagClient) doPostStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.StateResource, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -610,7 +614,7 @@ func (c *WagClient) doPutStateResourceRequest(ctx context.Context, req *http.Req
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "putStateResource")
+	ctx = context.WithValue(ctx, opNameCtx{}, "postStateResource")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -675,28 +679,35 @@ func (c *WagClient) doPutStateResourceRequest(ctx context.Context, req *http.Req
 	}
 }
 
-// GetWorkflowDefinitions makes a GET request to /workflow-definitions
-// Get the latest versions of all available WorkflowDefinitions
-// 200: []models.WorkflowDefinition
+// DeleteStateResource makes a DELETE request to /state-resources/{namespace}/{name}
+//
+// 200: nil
 // 400: *models.BadRequest
+// 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
+func (c *WagClient) DeleteStateResource(ctx context.Context, i *models.DeleteStateResourceInput) error {
 	headers := make(map[string]string)
 
 	var body []byte
-	path := c.basePath + "/workflow-definitions"
+	path, err := i.Path()
 
-	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("DELETE", path, bytes.NewBuffer(body))
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return c.doGetWorkflowDefinitionsRequest(ctx, req, headers)
+	return c.doDeleteStateResourceRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doGetWorkflowDefinitionsRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.WorkflowDefinition, error) {
+func (c *WagClient) doDeleteStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -706,7 +717,7 @@ func (c *WagClient) doGetWorkflowDefinitionsRequest(ctx context.Context, req *ht
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitions")
+	ctx = context.WithValue(ctx, opNameCtx{}, "deleteStateResource")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -736,74 +747,73 @@ func (c *WagClient) doGetWorkflowDefinitionsRequest(ctx context.Context, req *ht
 	if err != nil {
 		logData["message"] = err.Error()
 		c.logger.ErrorD("client-request-finished", logData)
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
 	case 200:
 
-		var output []models.WorkflowDefinition
-		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
-		}
-
-		return output, nil
+		return nil
 
 	case 400:
 
 		var output models.BadRequest
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
+			return err
 		}
-		return nil, &output
+		return &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
 
 	case 500:
 
 		var output models.InternalError
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
+			return err
 		}
-		return nil, &output
+		return &output
 
 	default:
-		return nil, &models.InternalError{Message: "Unknown response"}
+		return &models.InternalError{Message: "Unknown response"}
 	}
 }
 
-// NewWorkflowDefinition makes a POST request to /workflow-definitions
+// GetStateResource makes a GET request to /state-resources/{namespace}/{name}
 //
-// 201: *models.WorkflowDefinition
+// 200: *models.StateResource
 // 400: *models.BadRequest
+// 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) NewWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
+func (c *WagClient) GetStateResource(ctx context.Context, i *models.GetStateResourceInput) (*models.StateResource, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path := c.basePath + "/workflow-definitions"
-
-	if i != nil {
-
-		var err error
-		body, err = json.Marshal(i)
-
-		if err != nil {
-			return nil, err
-		}
+	path, err := i.Path()
 
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doNewWorkflowDefinitionRequest(ctx, req, headers)
+	return c.doGetStateResourceRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doNewWorkflowDefinitionRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinition, error) {
+func (c *WagClient) doGetStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.StateResource, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -813,7 +823,7 @@ func (c *WagClient) doNewWorkflowDefinitionRequest(ctx context.Context, req *htt
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "newWorkflowDefinition")
+	ctx = context.WithValue(ctx, opNameCtx{}, "getStateResource")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -848,9 +858,9 @@ func (c *WagClient) doNewWorkflowDefinitionRequest(ctx context.Context, req *htt
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
-	case 201:
+	case 200:
 
-		var output models.WorkflowDefinition
+		var output models.StateResource
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
@@ -865,6 +875,14 @@ func (c *WagClient) doNewWorkflowDefinitionRequest(ctx context.Context, req *htt
 		}
 		return nil, &output
 
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
 	case 500:
 
 		var output models.InternalError
@@ -878,14 +896,13 @@ func (c *WagClient) doNewWorkflowDefinitionRequest(ctx context.Context, req *htt
 	}
 }
 
-// GetWorkflowDefinitionVersionsByName makes a GET request to /workflow-definitions/{name}
+// PutStateResource makes a PUT request to /state-resources/{namespace}/{name}
 //
-// 200: []models.WorkflowDefinition
+// 201: *models.StateResource
 // 400: *models.BadRequest
-// 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *models.GetWorkflowDefinitionVersionsByNameInput) ([]models.WorkflowDefinition, error) {
+func (c *WagClient) PutStateResource(ctx context.Context, i *models.PutStateResourceInput) (*models.StateResource, error) {
 	headers := make(map[string]string)
 
 	var body []byte
@@ -897,16 +914,27 @@ func (c *WagClient) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *
 
 	path = c.basePath + path
 
-	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+	if i.NewStateResource != nil {
+
+		var err error
+		body, err = json.Marshal(i.NewStateResource)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doGetWorkflowDefinitionVersionsByNameRequest(ctx, req, headers)
+	return c.doPutStateResourceRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doGetWorkflowDefinitionVersionsByNameRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.WorkflowDefinition, error) {
+func (c *WagClient) doPutStateResourceRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.StateResource, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -916,7 +944,7 @@ func (c *WagClient) doGetWorkflowDefinitionVersionsByNameRequest(ctx context.Con
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionVersionsByName")
+	ctx = context.WithValue(ctx, opNameCtx{}, "putStateResource")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -951,14 +979,14 @@ func (c *WagClient) doGetWorkflowDefinitionVersionsByNameRequest(ctx context.Con
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
-	case 200:
+	case 201:
 
-		var output []models.WorkflowDefinition
+		var output models.StateResource
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
 
-		return output, nil
+		return &output, nil
 
 	case 400:
 
@@ -968,14 +996,6 @@ func (c *WagClient) doGetWorkflowDefinitionVersionsByNameRequest(ctx context.Con
 		}
 		return nil, &output
 
-	case 404:
-
-		var output models.NotFound
-		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
-		}
-		return nil, &output
-
 	case 500:
 
 		var output models.InternalError
@@ -989,14 +1009,13 @@ func (c *WagClient) doGetWorkflowDefinitionVersionsByNameRequest(ctx context.Con
 	}
 }
 
-// UpdateWorkflowDefinition makes a PUT request to /workflow-definitions/{name}
+// GetWorkers makes a GET request to /workers
 //
-// 201: *models.WorkflowDefinition
+// 200: []models.ResourceWorkers
 // 400: *models.BadRequest
-// 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) UpdateWorkflowDefinition(ctx context.Context, i *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error) {
+func (c *WagClient) GetWorkers(ctx context.Context, i *models.GetWorkersInput) ([]models.ResourceWorkers, error) {
 	headers := make(map[string]string)
 
 	var body []byte
@@ -1008,27 +1027,16 @@ func (c *WagClient) UpdateWorkflowDefinition(ctx context.Context, i *models.Upda
 
 	path = c.basePath + path
 
-	if i.NewWorkflowDefinitionRequest != nil {
-
-		var err error
-		body, err = json.Marshal(i.NewWorkflowDefinitionRequest)
-
-		if err != nil {
-			return nil, err
-		}
-
-	}
-
-	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doUpdateWorkflowDefinitionRequest(ctx, req, headers)
+	return c.doGetWorkersRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doUpdateWorkflowDefinitionRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinition, error) {
+func (c *WagClient) doGetWorkersRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.ResourceWorkers, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1038,7 +1046,7 @@ func (c *WagClient) doUpdateWorkflowDefinitionRequest(ctx context.Context, req *
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "updateWorkflowDefinition")
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkers")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1073,14 +1081,14 @@ func (c *WagClient) doUpdateWorkflowDefinitionRequest(ctx context.Context, req *
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
-	case 201:
+	case 200:
 
-		var output models.WorkflowDefinition
+		var output []models.ResourceWorkers
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
 
-		return &output, nil
+		return output, nil
 
 	case 400:
 
@@ -1090,56 +1098,1910 @@ func (c *WagClient) doUpdateWorkflowDefinitionRequest(ctx context.Context, req *
 		}
 		return nil, &output
 
-	case 404:
+	case 500:
 
-		var output models.NotFound
+		var output models.InternalError
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
 		return nil, &output
 
-	case 500:
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// HeartbeatWorker makes a POST request to /workers
+//
+// 200: *models.Worker
+// 400: *models.BadRequest
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) HeartbeatWorker(ctx context.Context, i *models.WorkerHeartbeat) (*models.Worker, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path := c.basePath + "/workers"
+
+	if i != nil {
+
+		var err error
+		body, err = json.Marshal(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doHeartbeatWorkerRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doHeartbeatWorkerRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Worker, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "heartbeatWorker")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.Worker
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitions makes a GET request to /workflow-definitions
+// Get the latest versions of all available WorkflowDefinitions
+// 200: []models.WorkflowDefinition
+// 400: *models.BadRequest
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path := c.basePath + "/workflow-definitions"
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionsRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionsRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.WorkflowDefinition, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitions")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output []models.WorkflowDefinition
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// NewWorkflowDefinition makes a POST request to /workflow-definitions
+//
+// 201: *models.WorkflowDefinition
+// 400: *models.BadRequest
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) NewWorkflowDefinition(ctx context.Context, i *models.NewWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path := c.basePath + "/workflow-definitions"
+
+	if i != nil {
+
+		var err error
+		body, err = json.Marshal(i)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doNewWorkflowDefinitionRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doNewWorkflowDefinitionRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinition, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "newWorkflowDefinition")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 201:
+
+		var output models.WorkflowDefinition
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionVersionsByName makes a GET request to /workflow-definitions/{name}
+//
+// 200: []models.WorkflowDefinition
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionVersionsByName(ctx context.Context, i *models.GetWorkflowDefinitionVersionsByNameInput) ([]models.WorkflowDefinition, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionVersionsByNameRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionVersionsByNameRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.WorkflowDefinition, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionVersionsByName")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output []models.WorkflowDefinition
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// UpdateWorkflowDefinition makes a PUT request to /workflow-definitions/{name}
+//
+// 201: *models.WorkflowDefinition
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) UpdateWorkflowDefinition(ctx context.Context, i *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	if i.NewWorkflowDefinitionRequest != nil {
+
+		var err error
+		body, err = json.Marshal(i.NewWorkflowDefinitionRequest)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUpdateWorkflowDefinitionRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doUpdateWorkflowDefinitionRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinition, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "updateWorkflowDefinition")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 201:
+
+		var output models.WorkflowDefinition
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionAliases makes a GET request to /workflow-definitions/{name}/aliases
+//
+// 200: []models.WorkflowDefinitionAlias
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := models.GetWorkflowDefinitionAliasesInputPath(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionAliasesRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionAliasesRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.WorkflowDefinitionAlias, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionAliases")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output []models.WorkflowDefinitionAlias
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// DeleteWorkflowDefinitionAlias makes a DELETE request to /workflow-definitions/{name}/aliases/{alias}
+//
+// 200: nil
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("DELETE", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	return c.doDeleteWorkflowDefinitionAliasRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doDeleteWorkflowDefinitionAliasRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "deleteWorkflowDefinitionAlias")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		return nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	default:
+		return &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// SetWorkflowDefinitionAlias makes a PUT request to /workflow-definitions/{name}/aliases/{alias}
+//
+// 200: *models.WorkflowDefinitionAlias
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) SetWorkflowDefinitionAlias(ctx context.Context, i *models.SetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	if i.SetWorkflowDefinitionAliasRequest != nil {
+
+		var err error
+		body, err = json.Marshal(i.SetWorkflowDefinitionAliasRequest)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doSetWorkflowDefinitionAliasRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doSetWorkflowDefinitionAliasRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinitionAlias, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "setWorkflowDefinitionAlias")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.WorkflowDefinitionAlias
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionDiff makes a GET request to /workflow-definitions/{name}/diff
+//
+// 200: *models.WorkflowDefinitionDiff
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionDiffRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionDiffRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinitionDiff, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionDiff")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.WorkflowDefinitionDiff
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionEvents makes a GET request to /workflow-definitions/{name}/events
+//
+// 200: nil
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionEvents(ctx context.Context, name string) error {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := models.GetWorkflowDefinitionEventsInputPath(name)
+
+	if err != nil {
+		return err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	return c.doGetWorkflowDefinitionEventsRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionEventsRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionEvents")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		return nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	default:
+		return &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// DeleteWorkflowDefinitionRollout makes a DELETE request to /workflow-definitions/{name}/rollout
+//
+// 200: nil
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := models.DeleteWorkflowDefinitionRolloutInputPath(name)
+
+	if err != nil {
+		return err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("DELETE", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	return c.doDeleteWorkflowDefinitionRolloutRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doDeleteWorkflowDefinitionRolloutRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "deleteWorkflowDefinitionRollout")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		return nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	default:
+		return &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionRollout makes a GET request to /workflow-definitions/{name}/rollout
+//
+// 200: *models.WorkflowDefinitionRollout
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionRollout(ctx context.Context, name string) (*models.WorkflowDefinitionRollout, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := models.GetWorkflowDefinitionRolloutInputPath(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionRolloutRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionRolloutRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinitionRollout, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionRollout")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.WorkflowDefinitionRollout
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// SetWorkflowDefinitionRollout makes a PUT request to /workflow-definitions/{name}/rollout
+//
+// 200: *models.WorkflowDefinitionRollout
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) SetWorkflowDefinitionRollout(ctx context.Context, i *models.SetWorkflowDefinitionRolloutInput) (*models.WorkflowDefinitionRollout, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	if i.WorkflowDefinitionRollout != nil {
+
+		var err error
+		body, err = json.Marshal(i.WorkflowDefinitionRollout)
+
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doSetWorkflowDefinitionRolloutRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doSetWorkflowDefinitionRolloutRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinitionRollout, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "setWorkflowDefinitionRollout")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.WorkflowDefinitionRollout
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionByNameAndVersion makes a GET request to /workflow-definitions/{name}/{version}
+//
+// 200: *models.WorkflowDefinition
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionByNameAndVersionRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionByNameAndVersionRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinition, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionByNameAndVersion")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.WorkflowDefinition
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowDefinitionGraph makes a GET request to /workflow-definitions/{name}/{version}/graph
+//
+// 200: *models.WorkflowGraph
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.WorkflowGraph, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowDefinitionGraphRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowDefinitionGraphRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowGraph, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionGraph")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.WorkflowGraph
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflows makes a GET request to /workflows
+//
+// 200: []models.Workflow
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, _, err := c.doGetWorkflowsRequest(ctx, req, headers)
+	return resp, err
+}
+
+type getWorkflowsIterImpl struct {
+	c            *WagClient
+	ctx          context.Context
+	lastResponse []models.Workflow
+	index        int
+	err          error
+	nextURL      string
+	headers      map[string]string
+	body         []byte
+}
+
+// NewgetWorkflowsIter constructs an iterator that makes calls to getWorkflows for
+// each page.
+func (c *WagClient) NewGetWorkflowsIter(ctx context.Context, i *models.GetWorkflowsInput) (GetWorkflowsIter, error) {
+	path, err := i.Path()
+
+	if err != nil {
+		return nil, err
+	}
+
+	path = c.basePath + path
+
+	headers := make(map[string]string)
+
+	var body []byte
+
+	return &getWorkflowsIterImpl{
+		c:            c,
+		ctx:          ctx,
+		lastResponse: []models.Workflow{},
+		nextURL:      path,
+		headers:      headers,
+		body:         body,
+	}, nil
+}
+
+func (i *getWorkflowsIterImpl) refresh() error {
+	req, err := http.NewRequest("GET", i.nextURL, bytes.NewBuffer(i.body))
+
+	if err != nil {
+		i.err = err
+		return err
+	}
+
+	resp, nextPage, err := i.c.doGetWorkflowsRequest(i.ctx, req, i.headers)
+	if err != nil {
+		i.err = err
+		return err
+	}
+
+	i.lastResponse = resp
+	i.index = 0
+	if nextPage != "" {
+		i.nextURL = i.c.basePath + nextPage
+	} else {
+		i.nextURL = ""
+	}
+	return nil
+}
+
+// Next retrieves the next resource from the iterator and assigns it to the
+// provided pointer, fetching a new page if necessary. Returns true if it
+// successfully retrieves a new resource.
+func (i *getWorkflowsIterImpl) Next(v *models.Workflow) bool {
+	if i.err != nil {
+		return false
+	} else if i.index < len(i.lastResponse) {
+		*v = i.lastResponse[i.index]
+		i.index++
+		return true
+	} else if i.nextURL == "" {
+		return false
+	}
+
+	if err := i.refresh(); err != nil {
+		return false
+	}
+	return i.Next(v)
+}
+
+// Err returns an error if one occurred when .Next was called.
+func (i *getWorkflowsIterImpl) Err() error {
+	return i.err
+}
+
+func (c *WagClient) doGetWorkflowsRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.Workflow, string, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflows")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output []models.Workflow
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, "", err
+		}
+
+		return output, resp.Header.Get("X-Next-Page-Path"), nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, "", err
+		}
+		return nil, "", &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, "", err
+		}
+		return nil, "", &output
+
+	case 500:
 
 		var output models.InternalError
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
+			return nil, "", err
 		}
-		return nil, &output
+		return nil, "", &output
 
 	default:
-		return nil, &models.InternalError{Message: "Unknown response"}
+		return nil, "", &models.InternalError{Message: "Unknown response"}
 	}
 }
 
-// GetWorkflowDefinitionByNameAndVersion makes a GET request to /workflow-definitions/{name}/{version}
+// StartWorkflow makes a POST request to /workflows
 //
-// 200: *models.WorkflowDefinition
+// 200: *models.Workflow
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
+func (c *WagClient) StartWorkflow(ctx context.Context, i *models.StartWorkflowRequest) (*models.Workflow, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := i.Path()
+	path := c.basePath + "/workflows"
 
-	if err != nil {
-		return nil, err
-	}
+	if i != nil {
 
-	path = c.basePath + path
+		var err error
+		body, err = json.Marshal(i)
 
-	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doGetWorkflowDefinitionByNameAndVersionRequest(ctx, req, headers)
+	return c.doStartWorkflowRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doGetWorkflowDefinitionByNameAndVersionRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowDefinition, error) {
+func (c *WagClient) doStartWorkflowRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Workflow, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1149,7 +3011,7 @@ func (c *WagClient) doGetWorkflowDefinitionByNameAndVersionRequest(ctx context.C
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowDefinitionByNameAndVersion")
+	ctx = context.WithValue(ctx, opNameCtx{}, "startWorkflow")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1186,7 +3048,7 @@ func (c *WagClient) doGetWorkflowDefinitionByNameAndVersionRequest(ctx context.C
 
 	case 200:
 
-		var output models.WorkflowDefinition
+		var output models.Workflow
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
@@ -1222,19 +3084,136 @@ func (c *WagClient) doGetWorkflowDefinitionByNameAndVersionRequest(ctx context.C
 	}
 }
 
-// GetWorkflows makes a GET request to /workflows
+// CancelWorkflow makes a DELETE request to /workflows/{workflowID}
 //
-// 200: []models.Workflow
+// 200: nil
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, error) {
+func (c *WagClient) CancelWorkflow(ctx context.Context, i *models.CancelWorkflowInput) error {
 	headers := make(map[string]string)
 
 	var body []byte
 	path, err := i.Path()
 
+	if err != nil {
+		return err
+	}
+
+	path = c.basePath + path
+
+	if i.Reason != nil {
+
+		var err error
+		body, err = json.Marshal(i.Reason)
+
+		if err != nil {
+			return err
+		}
+
+	}
+
+	req, err := http.NewRequest("DELETE", path, bytes.NewBuffer(body))
+
+	if err != nil {
+		return err
+	}
+
+	return c.doCancelWorkflowRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doCancelWorkflowRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "CancelWorkflow")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		return nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
+
+	default:
+		return &models.InternalError{Message: "Unknown response"}
+	}
+}
+
+// GetWorkflowByID makes a GET request to /workflows/{workflowID}
+//
+// 200: *models.Workflow
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) GetWorkflowByID(ctx context.Context, workflowID string) (*models.Workflow, error) {
+	headers := make(map[string]string)
+
+	var body []byte
+	path, err := models.GetWorkflowByIDInputPath(workflowID)
+
 	if err != nil {
 		return nil, err
 	}
@@ -1243,28 +3222,107 @@ func (c *WagClient) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInpu
 
 	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
 
-	if err != nil {
-		return nil, err
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doGetWorkflowByIDRequest(ctx, req, headers)
+}
+
+func (c *WagClient) doGetWorkflowByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Workflow, error) {
+	client := &http.Client{Transport: c.transport}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for field, value := range headers {
+		req.Header.Set(field, value)
+	}
+
+	// Add the opname for doers like tracing
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowByID")
+	req = req.WithContext(ctx)
+	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
+	// until we've finished all the processing of the request object. Otherwise we'll cancel
+	// our own request before we've finished it.
+	if c.defaultTimeout != 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), c.defaultTimeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.requestDoer.Do(client, req)
+	retCode := 0
+	if resp != nil {
+		retCode = resp.StatusCode
+	}
+
+	// log all client failures and non-successful HT
+	logData := logger.M{
+		"backend":     "workflow-manager",
+		"method":      req.Method,
+		"uri":         req.URL,
+		"status_code": retCode,
+	}
+	if err == nil && retCode > 399 {
+		logData["message"] = resp.Status
+		c.logger.ErrorD("client-request-finished", logData)
+	}
+	if err != nil {
+		logData["message"] = err.Error()
+		c.logger.ErrorD("client-request-finished", logData)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+
+	case 200:
+
+		var output models.Workflow
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+
+		return &output, nil
+
+	case 400:
+
+		var output models.BadRequest
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 404:
+
+		var output models.NotFound
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	case 500:
+
+		var output models.InternalError
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return nil, err
+		}
+		return nil, &output
+
+	default:
+		return nil, &models.InternalError{Message: "Unknown response"}
 	}
-
-	resp, _, err := c.doGetWorkflowsRequest(ctx, req, headers)
-	return resp, err
 }
 
-type getWorkflowsIterImpl struct {
-	c            *WagClient
-	ctx          context.Context
-	lastResponse []models.Workflow
-	index        int
-	err          error
-	nextURL      string
-	headers      map[string]string
-	body         []byte
-}
+// ResumeWorkflowByID makes a POST request to /workflows/{workflowID}
+//
+// 200: *models.Workflow
+// 400: *models.BadRequest
+// 404: *models.NotFound
+// 500: *models.InternalError
+// default: client side HTTP errors, for example: context.DeadlineExceeded.
+func (c *WagClient) ResumeWorkflowByID(ctx context.Context, i *models.ResumeWorkflowByIDInput) (*models.Workflow, error) {
+	headers := make(map[string]string)
 
-// NewgetWorkflowsIter constructs an iterator that makes calls to getWorkflows for
-// each page.
-func (c *WagClient) NewGetWorkflowsIter(ctx context.Context, i *models.GetWorkflowsInput) (GetWorkflowsIter, error) {
+	var body []byte
 	path, err := i.Path()
 
 	if err != nil {
@@ -1273,70 +3331,27 @@ func (c *WagClient) NewGetWorkflowsIter(ctx context.Context, i *models.GetWorkfl
 
 	path = c.basePath + path
 
-	headers := make(map[string]string)
-
-	var body []byte
-
-	return &getWorkflowsIterImpl{
-		c:            c,
-		ctx:          ctx,
-		lastResponse: []models.Workflow{},
-		nextURL:      path,
-		headers:      headers,
-		body:         body,
-	}, nil
-}
-
-func (i *getWorkflowsIterImpl) refresh() error {
-	req, err := http.NewRequest("GET", i.nextURL, bytes.NewBuffer(i.body))
+	if i.Overrides != nil {
 
-	if err != nil {
-		i.err = err
-		return err
-	}
+		var err error
+		body, err = json.Marshal(i.Overrides)
 
-	resp, nextPage, err := i.c.doGetWorkflowsRequest(i.ctx, req, i.headers)
-	if err != nil {
-		i.err = err
-		return err
-	}
+		if err != nil {
+			return nil, err
+		}
 
-	i.lastResponse = resp
-	i.index = 0
-	if nextPage != "" {
-		i.nextURL = i.c.basePath + nextPage
-	} else {
-		i.nextURL = ""
 	}
-	return nil
-}
 
-// Next retrieves the next resource from the iterator and assigns it to the
-// provided pointer, fetching a new page if necessary. Returns true if it
-// successfully retrieves a new resource.
-func (i *getWorkflowsIterImpl) Next(v *models.Workflow) bool {
-	if i.err != nil {
-		return false
-	} else if i.index < len(i.lastResponse) {
-		*v = i.lastResponse[i.index]
-		i.index++
-		return true
-	} else if i.nextURL == "" {
-		return false
-	}
+	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
 
-	if err := i.refresh(); err != nil {
-		return false
+	if err != nil {
+		return nil, err
 	}
-	return i.Next(v)
-}
 
-// Err returns an error if one occurred when .Next was called.
-func (i *getWorkflowsIterImpl) Err() error {
-	return i.err
+	return c.doResumeWorkflowByIDRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doGetWorkflowsRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.Workflow, string, error) {
+func (c *WagClient) doResumeWorkflowByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Workflow, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1346,7 +3361,7 @@ func (c *WagClient) doGetWorkflowsRequest(ctx context.Context, req *http.Request
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflows")
+	ctx = context.WithValue(ctx, opNameCtx{}, "resumeWorkflowByID")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1376,83 +3391,78 @@ func (c *WagClient) doGetWorkflowsRequest(ctx context.Context, req *http.Request
 	if err != nil {
 		logData["message"] = err.Error()
 		c.logger.ErrorD("client-request-finished", logData)
-		return nil, "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
 	case 200:
 
-		var output []models.Workflow
+		var output models.Workflow
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, "", err
+			return nil, err
 		}
 
-		return output, resp.Header.Get("X-Next-Page-Path"), nil
+		return &output, nil
 
 	case 400:
 
 		var output models.BadRequest
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, "", err
+			return nil, err
 		}
-		return nil, "", &output
+		return nil, &output
 
 	case 404:
 
 		var output models.NotFound
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, "", err
+			return nil, err
 		}
-		return nil, "", &output
+		return nil, &output
 
 	case 500:
 
 		var output models.InternalError
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, "", err
+			return nil, err
 		}
-		return nil, "", &output
+		return nil, &output
 
 	default:
-		return nil, "", &models.InternalError{Message: "Unknown response"}
+		return nil, &models.InternalError{Message: "Unknown response"}
 	}
 }
 
-// StartWorkflow makes a POST request to /workflows
+// GetWorkflowChoices makes a GET request to /workflows/{workflowID}/choices
 //
-// 200: *models.Workflow
+// 200: []models.ChoiceEvaluation
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) StartWorkflow(ctx context.Context, i *models.StartWorkflowRequest) (*models.Workflow, error) {
+func (c *WagClient) GetWorkflowChoices(ctx context.Context, workflowID string) ([]models.ChoiceEvaluation, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path := c.basePath + "/workflows"
-
-	if i != nil {
-
-		var err error
-		body, err = json.Marshal(i)
-
-		if err != nil {
-			return nil, err
-		}
+	path, err := models.GetWorkflowChoicesInputPath(workflowID)
 
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
+	path = c.basePath + path
+
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return nil, err
 	}
 
-	return c.doStartWorkflowRequest(ctx, req, headers)
+	return c.doGetWorkflowChoicesRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doStartWorkflowRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Workflow, error) {
+func (c *WagClient) doGetWorkflowChoicesRequest(ctx context.Context, req *http.Request, headers map[string]string) ([]models.ChoiceEvaluation, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1462,7 +3472,7 @@ func (c *WagClient) doStartWorkflowRequest(ctx context.Context, req *http.Reques
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "startWorkflow")
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowChoices")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1499,12 +3509,12 @@ func (c *WagClient) doStartWorkflowRequest(ctx context.Context, req *http.Reques
 
 	case 200:
 
-		var output models.Workflow
+		var output []models.ChoiceEvaluation
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
 
-		return &output, nil
+		return output, nil
 
 	case 400:
 
@@ -1535,18 +3545,18 @@ func (c *WagClient) doStartWorkflowRequest(ctx context.Context, req *http.Reques
 	}
 }
 
-// CancelWorkflow makes a DELETE request to /workflows/{workflowID}
+// GetWorkflowEvents makes a GET request to /workflows/{workflowID}/events
 //
 // 200: nil
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) CancelWorkflow(ctx context.Context, i *models.CancelWorkflowInput) error {
+func (c *WagClient) GetWorkflowEvents(ctx context.Context, workflowID string) error {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := i.Path()
+	path, err := models.GetWorkflowEventsInputPath(workflowID)
 
 	if err != nil {
 		return err
@@ -1554,27 +3564,16 @@ func (c *WagClient) CancelWorkflow(ctx context.Context, i *models.CancelWorkflow
 
 	path = c.basePath + path
 
-	if i.Reason != nil {
-
-		var err error
-		body, err = json.Marshal(i.Reason)
-
-		if err != nil {
-			return err
-		}
-
-	}
-
-	req, err := http.NewRequest("DELETE", path, bytes.NewBuffer(body))
+	req, err := http.NewRequest("GET", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return err
 	}
 
-	return c.doCancelWorkflowRequest(ctx, req, headers)
+	return c.doGetWorkflowEventsRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doCancelWorkflowRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+func (c *WagClient) doGetWorkflowEventsRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1584,7 +3583,7 @@ func (c *WagClient) doCancelWorkflowRequest(ctx context.Context, req *http.Reque
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "CancelWorkflow")
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowEvents")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1652,18 +3651,18 @@ func (c *WagClient) doCancelWorkflowRequest(ctx context.Context, req *http.Reque
 	}
 }
 
-// GetWorkflowByID makes a GET request to /workflows/{workflowID}
+// GetWorkflowGraph makes a GET request to /workflows/{workflowID}/graph
 //
-// 200: *models.Workflow
+// 200: *models.WorkflowGraph
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) GetWorkflowByID(ctx context.Context, workflowID string) (*models.Workflow, error) {
+func (c *WagClient) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.WorkflowGraph, error) {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := models.GetWorkflowByIDInputPath(workflowID)
+	path, err := i.Path()
 
 	if err != nil {
 		return nil, err
@@ -1677,10 +3676,10 @@ func (c *WagClient) GetWorkflowByID(ctx context.Context, workflowID string) (*mo
 		return nil, err
 	}
 
-	return c.doGetWorkflowByIDRequest(ctx, req, headers)
+	return c.doGetWorkflowGraphRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doGetWorkflowByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Workflow, error) {
+func (c *WagClient) doGetWorkflowGraphRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.WorkflowGraph, error) {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1690,7 +3689,7 @@ func (c *WagClient) doGetWorkflowByIDRequest(ctx context.Context, req *http.Requ
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowByID")
+	ctx = context.WithValue(ctx, opNameCtx{}, "getWorkflowGraph")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1727,7 +3726,7 @@ func (c *WagClient) doGetWorkflowByIDRequest(ctx context.Context, req *http.Requ
 
 	case 200:
 
-		var output models.Workflow
+		var output models.WorkflowGraph
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
 			return nil, err
 		}
@@ -1763,46 +3762,36 @@ func (c *WagClient) doGetWorkflowByIDRequest(ctx context.Context, req *http.Requ
 	}
 }
 
-// ResumeWorkflowByID makes a POST request to /workflows/{workflowID}
+// ResolveWorkflowByID makes a POST request to /workflows/{workflowID}/resolved
 //
-// 200: *models.Workflow
+// 201: nil
 // 400: *models.BadRequest
 // 404: *models.NotFound
+// 409: *models.Conflict
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) ResumeWorkflowByID(ctx context.Context, i *models.ResumeWorkflowByIDInput) (*models.Workflow, error) {
+func (c *WagClient) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := i.Path()
+	path, err := models.ResolveWorkflowByIDInputPath(workflowID)
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	path = c.basePath + path
 
-	if i.Overrides != nil {
-
-		var err error
-		body, err = json.Marshal(i.Overrides)
-
-		if err != nil {
-			return nil, err
-		}
-
-	}
-
 	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return c.doResumeWorkflowByIDRequest(ctx, req, headers)
+	return c.doResolveWorkflowByIDRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doResumeWorkflowByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) (*models.Workflow, error) {
+func (c *WagClient) doResolveWorkflowByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1812,7 +3801,7 @@ func (c *WagClient) doResumeWorkflowByIDRequest(ctx context.Context, req *http.R
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "resumeWorkflowByID")
+	ctx = context.WithValue(ctx, opNameCtx{}, "resolveWorkflowByID")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1842,62 +3831,65 @@ func (c *WagClient) doResumeWorkflowByIDRequest(ctx context.Context, req *http.R
 	if err != nil {
 		logData["message"] = err.Error()
 		c.logger.ErrorD("client-request-finished", logData)
-		return nil, err
+		return err
 	}
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
-	case 200:
-
-		var output models.Workflow
-		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
-		}
+	case 201:
 
-		return &output, nil
+		return nil
 
 	case 400:
 
 		var output models.BadRequest
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
+			return err
 		}
-		return nil, &output
+		return &output
 
 	case 404:
 
 		var output models.NotFound
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
+			return err
 		}
-		return nil, &output
+		return &output
+
+	case 409:
+
+		var output models.Conflict
+		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+			return err
+		}
+		return &output
 
 	case 500:
 
 		var output models.InternalError
 		if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
-			return nil, err
+			return err
 		}
-		return nil, &output
+		return &output
 
 	default:
-		return nil, &models.InternalError{Message: "Unknown response"}
+		return &models.InternalError{Message: "Unknown response"}
 	}
 }
 
-// ResolveWorkflowByID makes a POST request to /workflows/{workflowID}/resolved
+// SignalWorkflowState makes a POST request to /workflows/{workflowID}/signals/{state}
 //
-// 201: nil
+// 200: nil
 // 400: *models.BadRequest
 // 404: *models.NotFound
 // 409: *models.Conflict
 // 500: *models.InternalError
 // default: client side HTTP errors, for example: context.DeadlineExceeded.
-func (c *WagClient) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
+func (c *WagClient) SignalWorkflowState(ctx context.Context, i *models.SignalWorkflowStateInput) error {
 	headers := make(map[string]string)
 
 	var body []byte
-	path, err := models.ResolveWorkflowByIDInputPath(workflowID)
+	path, err := i.Path()
 
 	if err != nil {
 		return err
@@ -1905,16 +3897,27 @@ func (c *WagClient) ResolveWorkflowByID(ctx context.Context, workflowID string)
 
 	path = c.basePath + path
 
+	if i.Signal != nil {
+
+		var err error
+		body, err = json.Marshal(i.Signal)
+
+		if err != nil {
+			return err
+		}
+
+	}
+
 	req, err := http.NewRequest("POST", path, bytes.NewBuffer(body))
 
 	if err != nil {
 		return err
 	}
 
-	return c.doResolveWorkflowByIDRequest(ctx, req, headers)
+	return c.doSignalWorkflowStateRequest(ctx, req, headers)
 }
 
-func (c *WagClient) doResolveWorkflowByIDRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
+func (c *WagClient) doSignalWorkflowStateRequest(ctx context.Context, req *http.Request, headers map[string]string) error {
 	client := &http.Client{Transport: c.transport}
 
 	req.Header.Set("Content-Type", "application/json")
@@ -1924,7 +3927,7 @@ func (c *WagClient) doResolveWorkflowByIDRequest(ctx context.Context, req *http.
 	}
 
 	// Add the opname for doers like tracing
-	ctx = context.WithValue(ctx, opNameCtx{}, "resolveWorkflowByID")
+	ctx = context.WithValue(ctx, opNameCtx{}, "signalWorkflowState")
 	req = req.WithContext(ctx)
 	// Don't add the timeout in a "doer" because we don't want to call "defer.cancel()"
 	// until we've finished all the processing of the request object. Otherwise we'll cancel
@@ -1959,7 +3962,7 @@ func (c *WagClient) doResolveWorkflowByIDRequest(ctx context.Context, req *http.
 	defer resp.Body.Close()
 	switch resp.StatusCode {
 
-	case 201:
+	case 200:
 
 		return nil
 
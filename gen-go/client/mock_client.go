@@ -46,6 +46,45 @@ func (mr *MockClientMockRecorder) HealthCheck(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockClient)(nil).HealthCheck), ctx)
 }
 
+// StartBulkOperation mocks base method
+func (m *MockClient) StartBulkOperation(ctx context.Context, i *models.BulkOperationRequest) (*models.BulkOperation, error) {
+	ret := m.ctrl.Call(m, "StartBulkOperation", ctx, i)
+	ret0, _ := ret[0].(*models.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBulkOperation indicates an expected call of StartBulkOperation
+func (mr *MockClientMockRecorder) StartBulkOperation(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBulkOperation", reflect.TypeOf((*MockClient)(nil).StartBulkOperation), ctx, i)
+}
+
+// GetBulkOperationByID mocks base method
+func (m *MockClient) GetBulkOperationByID(ctx context.Context, operationID string) (*models.BulkOperation, error) {
+	ret := m.ctrl.Call(m, "GetBulkOperationByID", ctx, operationID)
+	ret0, _ := ret[0].(*models.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBulkOperationByID indicates an expected call of GetBulkOperationByID
+func (mr *MockClientMockRecorder) GetBulkOperationByID(ctx, operationID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkOperationByID", reflect.TypeOf((*MockClient)(nil).GetBulkOperationByID), ctx, operationID)
+}
+
+// EvaluatePath mocks base method
+func (m *MockClient) EvaluatePath(ctx context.Context, i *models.PathEvaluationRequest) (*models.PathEvaluation, error) {
+	ret := m.ctrl.Call(m, "EvaluatePath", ctx, i)
+	ret0, _ := ret[0].(*models.PathEvaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluatePath indicates an expected call of EvaluatePath
+func (mr *MockClientMockRecorder) EvaluatePath(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluatePath", reflect.TypeOf((*MockClient)(nil).EvaluatePath), ctx, i)
+}
+
 // PostStateResource mocks base method
 func (m *MockClient) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
 	ret := m.ctrl.Call(m, "PostStateResource", ctx, i)
@@ -97,6 +136,32 @@ func (mr *MockClientMockRecorder) PutStateResource(ctx, i interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStateResource", reflect.TypeOf((*MockClient)(nil).PutStateResource), ctx, i)
 }
 
+// GetWorkers mocks base method
+func (m *MockClient) GetWorkers(ctx context.Context, i *models.GetWorkersInput) ([]models.ResourceWorkers, error) {
+	ret := m.ctrl.Call(m, "GetWorkers", ctx, i)
+	ret0, _ := ret[0].([]models.ResourceWorkers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkers indicates an expected call of GetWorkers
+func (mr *MockClientMockRecorder) GetWorkers(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkers", reflect.TypeOf((*MockClient)(nil).GetWorkers), ctx, i)
+}
+
+// HeartbeatWorker mocks base method
+func (m *MockClient) HeartbeatWorker(ctx context.Context, i *models.WorkerHeartbeat) (*models.Worker, error) {
+	ret := m.ctrl.Call(m, "HeartbeatWorker", ctx, i)
+	ret0, _ := ret[0].(*models.Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeartbeatWorker indicates an expected call of HeartbeatWorker
+func (mr *MockClientMockRecorder) HeartbeatWorker(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeartbeatWorker", reflect.TypeOf((*MockClient)(nil).HeartbeatWorker), ctx, i)
+}
+
 // GetWorkflowDefinitions mocks base method
 func (m *MockClient) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitions", ctx)
@@ -149,6 +214,107 @@ func (mr *MockClientMockRecorder) UpdateWorkflowDefinition(ctx, i interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowDefinition", reflect.TypeOf((*MockClient)(nil).UpdateWorkflowDefinition), ctx, i)
 }
 
+// GetWorkflowDefinitionAliases mocks base method
+func (m *MockClient) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionAliases", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionAliases indicates an expected call of GetWorkflowDefinitionAliases
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionAliases(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionAliases", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionAliases), ctx, name)
+}
+
+// DeleteWorkflowDefinitionAlias mocks base method
+func (m *MockClient) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionAlias indicates an expected call of DeleteWorkflowDefinitionAlias
+func (mr *MockClientMockRecorder) DeleteWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionAlias", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowDefinitionAlias), ctx, i)
+}
+
+// SetWorkflowDefinitionAlias mocks base method
+func (m *MockClient) SetWorkflowDefinitionAlias(ctx context.Context, i *models.SetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "SetWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkflowDefinitionAlias indicates an expected call of SetWorkflowDefinitionAlias
+func (mr *MockClientMockRecorder) SetWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkflowDefinitionAlias", reflect.TypeOf((*MockClient)(nil).SetWorkflowDefinitionAlias), ctx, i)
+}
+
+// GetWorkflowDefinitionDiff mocks base method
+func (m *MockClient) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionDiff", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionDiff indicates an expected call of GetWorkflowDefinitionDiff
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionDiff(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionDiff", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionDiff), ctx, i)
+}
+
+// GetWorkflowDefinitionEvents mocks base method
+func (m *MockClient) GetWorkflowDefinitionEvents(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionEvents", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWorkflowDefinitionEvents indicates an expected call of GetWorkflowDefinitionEvents
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionEvents(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionEvents", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionEvents), ctx, name)
+}
+
+// DeleteWorkflowDefinitionRollout mocks base method
+func (m *MockClient) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionRollout", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionRollout indicates an expected call of DeleteWorkflowDefinitionRollout
+func (mr *MockClientMockRecorder) DeleteWorkflowDefinitionRollout(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionRollout", reflect.TypeOf((*MockClient)(nil).DeleteWorkflowDefinitionRollout), ctx, name)
+}
+
+// GetWorkflowDefinitionRollout mocks base method
+func (m *MockClient) GetWorkflowDefinitionRollout(ctx context.Context, name string) (*models.WorkflowDefinitionRollout, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionRollout", ctx, name)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionRollout indicates an expected call of GetWorkflowDefinitionRollout
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionRollout(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionRollout", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionRollout), ctx, name)
+}
+
+// SetWorkflowDefinitionRollout mocks base method
+func (m *MockClient) SetWorkflowDefinitionRollout(ctx context.Context, i *models.SetWorkflowDefinitionRolloutInput) (*models.WorkflowDefinitionRollout, error) {
+	ret := m.ctrl.Call(m, "SetWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkflowDefinitionRollout indicates an expected call of SetWorkflowDefinitionRollout
+func (mr *MockClientMockRecorder) SetWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkflowDefinitionRollout", reflect.TypeOf((*MockClient)(nil).SetWorkflowDefinitionRollout), ctx, i)
+}
+
 // GetWorkflowDefinitionByNameAndVersion mocks base method
 func (m *MockClient) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitionByNameAndVersion", ctx, i)
@@ -162,6 +328,19 @@ func (mr *MockClientMockRecorder) GetWorkflowDefinitionByNameAndVersion(ctx, i i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionByNameAndVersion", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionByNameAndVersion), ctx, i)
 }
 
+// GetWorkflowDefinitionGraph mocks base method
+func (m *MockClient) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.WorkflowGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionGraph", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionGraph indicates an expected call of GetWorkflowDefinitionGraph
+func (mr *MockClientMockRecorder) GetWorkflowDefinitionGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionGraph", reflect.TypeOf((*MockClient)(nil).GetWorkflowDefinitionGraph), ctx, i)
+}
+
 // GetWorkflows mocks base method
 func (m *MockClient) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, error) {
 	ret := m.ctrl.Call(m, "GetWorkflows", ctx, i)
@@ -239,6 +418,44 @@ func (mr *MockClientMockRecorder) ResumeWorkflowByID(ctx, i interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowByID", reflect.TypeOf((*MockClient)(nil).ResumeWorkflowByID), ctx, i)
 }
 
+// GetWorkflowChoices mocks base method
+func (m *MockClient) GetWorkflowChoices(ctx context.Context, workflowID string) ([]models.ChoiceEvaluation, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowChoices", ctx, workflowID)
+	ret0, _ := ret[0].([]models.ChoiceEvaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowChoices indicates an expected call of GetWorkflowChoices
+func (mr *MockClientMockRecorder) GetWorkflowChoices(ctx, workflowID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowChoices", reflect.TypeOf((*MockClient)(nil).GetWorkflowChoices), ctx, workflowID)
+}
+
+// GetWorkflowEvents mocks base method
+func (m *MockClient) GetWorkflowEvents(ctx context.Context, workflowID string) error {
+	ret := m.ctrl.Call(m, "GetWorkflowEvents", ctx, workflowID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWorkflowEvents indicates an expected call of GetWorkflowEvents
+func (mr *MockClientMockRecorder) GetWorkflowEvents(ctx, workflowID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowEvents", reflect.TypeOf((*MockClient)(nil).GetWorkflowEvents), ctx, workflowID)
+}
+
+// GetWorkflowGraph mocks base method
+func (m *MockClient) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.WorkflowGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowGraph", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowGraph indicates an expected call of GetWorkflowGraph
+func (mr *MockClientMockRecorder) GetWorkflowGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowGraph", reflect.TypeOf((*MockClient)(nil).GetWorkflowGraph), ctx, i)
+}
+
 // ResolveWorkflowByID mocks base method
 func (m *MockClient) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
 	ret := m.ctrl.Call(m, "ResolveWorkflowByID", ctx, workflowID)
@@ -251,6 +468,18 @@ func (mr *MockClientMockRecorder) ResolveWorkflowByID(ctx, workflowID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWorkflowByID", reflect.TypeOf((*MockClient)(nil).ResolveWorkflowByID), ctx, workflowID)
 }
 
+// SignalWorkflowState mocks base method
+func (m *MockClient) SignalWorkflowState(ctx context.Context, i *models.SignalWorkflowStateInput) error {
+	ret := m.ctrl.Call(m, "SignalWorkflowState", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalWorkflowState indicates an expected call of SignalWorkflowState
+func (mr *MockClientMockRecorder) SignalWorkflowState(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowState", reflect.TypeOf((*MockClient)(nil).SignalWorkflowState), ctx, i)
+}
+
 // MockGetWorkflowsIter is a mock of GetWorkflowsIter interface
 type MockGetWorkflowsIter struct {
 	ctrl     *gomock.Controller
@@ -38,6 +38,35 @@ func (i HealthCheckInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetBulkOperationByIDInput holds the input parameters for a getBulkOperationByID operation.
+type GetBulkOperationByIDInput struct {
+	OperationID string
+}
+
+// ValidateGetBulkOperationByIDInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetBulkOperationByIDInput(operationID string) error {
+
+	return nil
+}
+
+// GetBulkOperationByIDInputPath returns the URI path for the input.
+func GetBulkOperationByIDInputPath(operationID string) (string, error) {
+	path := "/bulk-operations/{operationID}"
+	urlVals := url.Values{}
+
+	pathoperationID := operationID
+	if pathoperationID == "" {
+		err := fmt.Errorf("operationID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{operationID}", pathoperationID, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // DeleteStateResourceInput holds the input parameters for a deleteStateResource operation.
 type DeleteStateResourceInput struct {
 	Namespace string
@@ -161,6 +190,35 @@ func (i PutStateResourceInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetWorkersInput holds the input parameters for a getWorkers operation.
+type GetWorkersInput struct {
+	Namespace *string
+	Resource  *string
+}
+
+// Validate returns an error if any of the GetWorkersInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkersInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkersInput) Path() (string, error) {
+	path := "/workers"
+	urlVals := url.Values{}
+
+	if i.Namespace != nil {
+		urlVals.Add("namespace", *i.Namespace)
+	}
+
+	if i.Resource != nil {
+		urlVals.Add("resource", *i.Resource)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // GetWorkflowDefinitionsInput holds the input parameters for a getWorkflowDefinitions operation.
 type GetWorkflowDefinitionsInput struct {
 }
@@ -249,6 +307,276 @@ func (i UpdateWorkflowDefinitionInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetWorkflowDefinitionAliasesInput holds the input parameters for a getWorkflowDefinitionAliases operation.
+type GetWorkflowDefinitionAliasesInput struct {
+	Name string
+}
+
+// ValidateGetWorkflowDefinitionAliasesInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowDefinitionAliasesInput(name string) error {
+
+	return nil
+}
+
+// GetWorkflowDefinitionAliasesInputPath returns the URI path for the input.
+func GetWorkflowDefinitionAliasesInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/aliases"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// DeleteWorkflowDefinitionAliasInput holds the input parameters for a deleteWorkflowDefinitionAlias operation.
+type DeleteWorkflowDefinitionAliasInput struct {
+	Name  string
+	Alias string
+}
+
+// Validate returns an error if any of the DeleteWorkflowDefinitionAliasInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i DeleteWorkflowDefinitionAliasInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i DeleteWorkflowDefinitionAliasInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// SetWorkflowDefinitionAliasInput holds the input parameters for a setWorkflowDefinitionAlias operation.
+type SetWorkflowDefinitionAliasInput struct {
+	Name                              string
+	Alias                             string
+	SetWorkflowDefinitionAliasRequest *SetWorkflowDefinitionAliasRequest
+}
+
+// Validate returns an error if any of the SetWorkflowDefinitionAliasInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i SetWorkflowDefinitionAliasInput) Validate() error {
+
+	if i.SetWorkflowDefinitionAliasRequest != nil {
+		if err := i.SetWorkflowDefinitionAliasRequest.Validate(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i SetWorkflowDefinitionAliasInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/aliases/{alias}"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathalias := i.Alias
+	if pathalias == "" {
+		err := fmt.Errorf("alias cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{alias}", pathalias, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionDiffInput holds the input parameters for a getWorkflowDefinitionDiff operation.
+type GetWorkflowDefinitionDiffInput struct {
+	Name string
+	From int64
+	To   int64
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionDiffInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionDiffInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionDiffInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/diff"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	urlVals.Add("from", strconv.FormatInt(i.From, 10))
+
+	urlVals.Add("to", strconv.FormatInt(i.To, 10))
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionEventsInput holds the input parameters for a getWorkflowDefinitionEvents operation.
+type GetWorkflowDefinitionEventsInput struct {
+	Name string
+}
+
+// ValidateGetWorkflowDefinitionEventsInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowDefinitionEventsInput(name string) error {
+
+	return nil
+}
+
+// GetWorkflowDefinitionEventsInputPath returns the URI path for the input.
+func GetWorkflowDefinitionEventsInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/events"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// DeleteWorkflowDefinitionRolloutInput holds the input parameters for a deleteWorkflowDefinitionRollout operation.
+type DeleteWorkflowDefinitionRolloutInput struct {
+	Name string
+}
+
+// ValidateDeleteWorkflowDefinitionRolloutInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateDeleteWorkflowDefinitionRolloutInput(name string) error {
+
+	return nil
+}
+
+// DeleteWorkflowDefinitionRolloutInputPath returns the URI path for the input.
+func DeleteWorkflowDefinitionRolloutInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/rollout"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowDefinitionRolloutInput holds the input parameters for a getWorkflowDefinitionRollout operation.
+type GetWorkflowDefinitionRolloutInput struct {
+	Name string
+}
+
+// ValidateGetWorkflowDefinitionRolloutInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowDefinitionRolloutInput(name string) error {
+
+	return nil
+}
+
+// GetWorkflowDefinitionRolloutInputPath returns the URI path for the input.
+func GetWorkflowDefinitionRolloutInputPath(name string) (string, error) {
+	path := "/workflow-definitions/{name}/rollout"
+	urlVals := url.Values{}
+
+	pathname := name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// SetWorkflowDefinitionRolloutInput holds the input parameters for a setWorkflowDefinitionRollout operation.
+type SetWorkflowDefinitionRolloutInput struct {
+	Name                      string
+	WorkflowDefinitionRollout *WorkflowDefinitionRollout
+}
+
+// Validate returns an error if any of the SetWorkflowDefinitionRolloutInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i SetWorkflowDefinitionRolloutInput) Validate() error {
+
+	if i.WorkflowDefinitionRollout != nil {
+		if err := i.WorkflowDefinitionRollout.Validate(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i SetWorkflowDefinitionRolloutInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/rollout"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // GetWorkflowDefinitionByNameAndVersionInput holds the input parameters for a getWorkflowDefinitionByNameAndVersion operation.
 type GetWorkflowDefinitionByNameAndVersionInput struct {
 	Name    string
@@ -288,6 +616,50 @@ func (i GetWorkflowDefinitionByNameAndVersionInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetWorkflowDefinitionGraphInput holds the input parameters for a getWorkflowDefinitionGraph operation.
+type GetWorkflowDefinitionGraphInput struct {
+	Name    string
+	Version int64
+	Format  *string
+}
+
+// Validate returns an error if any of the GetWorkflowDefinitionGraphInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowDefinitionGraphInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowDefinitionGraphInput) Path() (string, error) {
+	path := "/workflow-definitions/{name}/{version}/graph"
+	urlVals := url.Values{}
+
+	pathname := i.Name
+	if pathname == "" {
+		err := fmt.Errorf("name cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{name}", pathname, -1)
+
+	pathversion := strconv.FormatInt(i.Version, 10)
+	if pathversion == "" {
+		err := fmt.Errorf("version cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{version}", pathversion, -1)
+
+	if i.Format != nil {
+		urlVals.Add("format", *i.Format)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // GetWorkflowsInput holds the input parameters for a getWorkflows operation.
 type GetWorkflowsInput struct {
 	Limit                  *int64
@@ -445,6 +817,98 @@ func (i ResumeWorkflowByIDInput) Path() (string, error) {
 	return path + "?" + urlVals.Encode(), nil
 }
 
+// GetWorkflowChoicesInput holds the input parameters for a getWorkflowChoices operation.
+type GetWorkflowChoicesInput struct {
+	WorkflowID string
+}
+
+// ValidateGetWorkflowChoicesInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowChoicesInput(workflowID string) error {
+
+	return nil
+}
+
+// GetWorkflowChoicesInputPath returns the URI path for the input.
+func GetWorkflowChoicesInputPath(workflowID string) (string, error) {
+	path := "/workflows/{workflowID}/choices"
+	urlVals := url.Values{}
+
+	pathworkflowID := workflowID
+	if pathworkflowID == "" {
+		err := fmt.Errorf("workflowID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{workflowID}", pathworkflowID, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowEventsInput holds the input parameters for a getWorkflowEvents operation.
+type GetWorkflowEventsInput struct {
+	WorkflowID string
+}
+
+// ValidateGetWorkflowEventsInput returns an error if the input parameter doesn't
+// satisfy the requirements in the swagger yml file.
+func ValidateGetWorkflowEventsInput(workflowID string) error {
+
+	return nil
+}
+
+// GetWorkflowEventsInputPath returns the URI path for the input.
+func GetWorkflowEventsInputPath(workflowID string) (string, error) {
+	path := "/workflows/{workflowID}/events"
+	urlVals := url.Values{}
+
+	pathworkflowID := workflowID
+	if pathworkflowID == "" {
+		err := fmt.Errorf("workflowID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{workflowID}", pathworkflowID, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
+// GetWorkflowGraphInput holds the input parameters for a getWorkflowGraph operation.
+type GetWorkflowGraphInput struct {
+	WorkflowID string
+	Format     *string
+}
+
+// Validate returns an error if any of the GetWorkflowGraphInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i GetWorkflowGraphInput) Validate() error {
+
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i GetWorkflowGraphInput) Path() (string, error) {
+	path := "/workflows/{workflowID}/graph"
+	urlVals := url.Values{}
+
+	pathworkflowID := i.WorkflowID
+	if pathworkflowID == "" {
+		err := fmt.Errorf("workflowID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{workflowID}", pathworkflowID, -1)
+
+	if i.Format != nil {
+		urlVals.Add("format", *i.Format)
+	}
+
+	return path + "?" + urlVals.Encode(), nil
+}
+
 // ResolveWorkflowByIDInput holds the input parameters for a resolveWorkflowByID operation.
 type ResolveWorkflowByIDInput struct {
 	WorkflowID string
@@ -473,3 +937,48 @@ func ResolveWorkflowByIDInputPath(workflowID string) (string, error) {
 
 	return path + "?" + urlVals.Encode(), nil
 }
+
+// SignalWorkflowStateInput holds the input parameters for a signalWorkflowState operation.
+type SignalWorkflowStateInput struct {
+	WorkflowID string
+	State      string
+	Signal     *SignalRequest
+}
+
+// Validate returns an error if any of the SignalWorkflowStateInput parameters don't satisfy the
+// requirements from the swagger yml file.
+func (i SignalWorkflowStateInput) Validate() error {
+
+	if i.Signal != nil {
+		if err := i.Signal.Validate(nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Path returns the URI path for the input.
+func (i SignalWorkflowStateInput) Path() (string, error) {
+	path := "/workflows/{workflowID}/signals/{state}"
+	urlVals := url.Values{}
+
+	pathworkflowID := i.WorkflowID
+	if pathworkflowID == "" {
+		err := fmt.Errorf("workflowID cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{workflowID}", pathworkflowID, -1)
+
+	pathstate := i.State
+	if pathstate == "" {
+		err := fmt.Errorf("state cannot be empty because it's a path parameter")
+		if err != nil {
+			return "", err
+		}
+	}
+	path = strings.Replace(path, "{state}", pathstate, -1)
+
+	return path + "?" + urlVals.Encode(), nil
+}
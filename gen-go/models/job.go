@@ -24,6 +24,9 @@ type Job struct {
 	// container
 	Container string `json:"container,omitempty"`
 
+	// why the output of the job doesn't match its state's output schema
+	ContractViolation string `json:"contractViolation,omitempty"`
+
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
@@ -59,6 +62,9 @@ type Job struct {
 
 	// stopped at
 	StoppedAt strfmt.DateTime `json:"stoppedAt,omitempty"`
+
+	// token of the task for callback states (Resource: callback:<name>) that are waiting for a signal
+	TaskToken string `json:"taskToken,omitempty"`
 }
 
 // Validate validates this job
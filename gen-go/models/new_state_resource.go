@@ -22,6 +22,9 @@ type NewStateResource struct {
 	// namespace
 	Namespace string `json:"namespace,omitempty"`
 
+	// type
+	Type StateResourceType `json:"type,omitempty"`
+
 	// uri
 	URI string `json:"uri,omitempty"`
 }
@@ -30,12 +33,33 @@ type NewStateResource struct {
 func (m *NewStateResource) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateType(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *NewStateResource) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
+		}
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NewStateResource) MarshalBinary() ([]byte, error) {
 	if m == nil {
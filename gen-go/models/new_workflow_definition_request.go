@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -16,23 +18,48 @@ import (
 // swagger:model NewWorkflowDefinitionRequest
 type NewWorkflowDefinitionRequest struct {
 
+	// auto retry
+	AutoRetry *AutoRetryPolicy `json:"autoRetry,omitempty"`
+
+	// deadlines
+	Deadlines *WorkflowDeadlines `json:"deadlines,omitempty"`
+
 	// defaultTags: object with key-value pairs; keys and values should be strings
 	DefaultTags map[string]interface{} `json:"defaultTags,omitempty"`
 
+	// JSON Schema that the input of workflows must match
+	InputSchema interface{} `json:"inputSchema,omitempty"`
+
 	// manager
 	Manager Manager `json:"manager,omitempty"`
 
 	// name
 	Name string `json:"name,omitempty"`
 
+	// JSON Schemas that the output of Task states must match, by state name
+	OutputSchemas map[string]interface{} `json:"outputSchemas,omitempty"`
+
 	// state machine
 	StateMachine *SLStateMachine `json:"stateMachine,omitempty"`
+
+	// triggers
+	Triggers []*WorkflowTrigger `json:"triggers"`
 }
 
 // Validate validates this new workflow definition request
 func (m *NewWorkflowDefinitionRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAutoRetry(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateDeadlines(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateManager(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -43,12 +70,55 @@ func (m *NewWorkflowDefinitionRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTriggers(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *NewWorkflowDefinitionRequest) validateAutoRetry(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AutoRetry) { // not required
+		return nil
+	}
+
+	if m.AutoRetry != nil {
+
+		if err := m.AutoRetry.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("autoRetry")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NewWorkflowDefinitionRequest) validateDeadlines(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Deadlines) { // not required
+		return nil
+	}
+
+	if m.Deadlines != nil {
+
+		if err := m.Deadlines.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("deadlines")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *NewWorkflowDefinitionRequest) validateManager(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Manager) { // not required
@@ -84,6 +154,33 @@ func (m *NewWorkflowDefinitionRequest) validateStateMachine(formats strfmt.Regis
 	return nil
 }
 
+func (m *NewWorkflowDefinitionRequest) validateTriggers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Triggers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Triggers); i++ {
+
+		if swag.IsZero(m.Triggers[i]) { // not required
+			continue
+		}
+
+		if m.Triggers[i] != nil {
+
+			if err := m.Triggers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("triggers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NewWorkflowDefinitionRequest) MarshalBinary() ([]byte, error) {
 	if m == nil {
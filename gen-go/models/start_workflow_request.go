@@ -16,6 +16,9 @@ import (
 // swagger:model StartWorkflowRequest
 type StartWorkflowRequest struct {
 
+	// deadlines
+	Deadlines *WorkflowDeadlines `json:"deadlines,omitempty"`
+
 	// input
 	Input string `json:"input,omitempty"`
 
@@ -36,6 +39,11 @@ type StartWorkflowRequest struct {
 func (m *StartWorkflowRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDeadlines(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateWorkflowDefinition(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -47,6 +55,25 @@ func (m *StartWorkflowRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StartWorkflowRequest) validateDeadlines(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Deadlines) { // not required
+		return nil
+	}
+
+	if m.Deadlines != nil {
+
+		if err := m.Deadlines.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("deadlines")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *StartWorkflowRequest) validateWorkflowDefinition(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.WorkflowDefinition) { // not required
@@ -25,6 +25,9 @@ type StateResource struct {
 	// namespace
 	Namespace string `json:"namespace,omitempty"`
 
+	// on jobs, whether the uri of the state came from a registered StateResource or the naming convention
+	Source StateResourceSource `json:"source,omitempty"`
+
 	// type
 	Type StateResourceType `json:"type,omitempty"`
 
@@ -36,6 +39,11 @@ type StateResource struct {
 func (m *StateResource) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateSource(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateType(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -47,6 +55,22 @@ func (m *StateResource) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *StateResource) validateSource(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Source) { // not required
+		return nil
+	}
+
+	if err := m.Source.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("source")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *StateResource) validateType(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Type) { // not required
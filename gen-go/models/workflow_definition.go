@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -16,24 +18,39 @@ import (
 // swagger:model WorkflowDefinition
 type WorkflowDefinition struct {
 
+	// auto retry
+	AutoRetry *AutoRetryPolicy `json:"autoRetry,omitempty"`
+
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
+	// deadlines
+	Deadlines *WorkflowDeadlines `json:"deadlines,omitempty"`
+
 	// defaultTags: object with key-value pairs; keys and values should be strings
 	DefaultTags map[string]interface{} `json:"defaultTags,omitempty"`
 
 	// id
 	ID string `json:"id,omitempty"`
 
+	// JSON Schema that the input of workflows must match
+	InputSchema interface{} `json:"inputSchema,omitempty"`
+
 	// manager
 	Manager Manager `json:"manager,omitempty"`
 
 	// name
 	Name string `json:"name,omitempty"`
 
+	// JSON Schemas that the output of Task states must match, by state name
+	OutputSchemas map[string]interface{} `json:"outputSchemas,omitempty"`
+
 	// state machine
 	StateMachine *SLStateMachine `json:"stateMachine,omitempty"`
 
+	// triggers
+	Triggers []*WorkflowTrigger `json:"triggers"`
+
 	// version
 	Version int64 `json:"version,omitempty"`
 }
@@ -42,6 +59,16 @@ type WorkflowDefinition struct {
 func (m *WorkflowDefinition) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAutoRetry(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateDeadlines(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateManager(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -52,12 +79,55 @@ func (m *WorkflowDefinition) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTriggers(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *WorkflowDefinition) validateAutoRetry(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AutoRetry) { // not required
+		return nil
+	}
+
+	if m.AutoRetry != nil {
+
+		if err := m.AutoRetry.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("autoRetry")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *WorkflowDefinition) validateDeadlines(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Deadlines) { // not required
+		return nil
+	}
+
+	if m.Deadlines != nil {
+
+		if err := m.Deadlines.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("deadlines")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *WorkflowDefinition) validateManager(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Manager) { // not required
@@ -93,6 +163,33 @@ func (m *WorkflowDefinition) validateStateMachine(formats strfmt.Registry) error
 	return nil
 }
 
+func (m *WorkflowDefinition) validateTriggers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Triggers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Triggers); i++ {
+
+		if swag.IsZero(m.Triggers[i]) { // not required
+			continue
+		}
+
+		if m.Triggers[i] != nil {
+
+			if err := m.Triggers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("triggers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *WorkflowDefinition) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -16,8 +16,17 @@ import (
 // swagger:model WorkflowDefinitionOverrides
 type WorkflowDefinitionOverrides struct {
 
+	// Input for the StartAt state. Defaults to the input the StartAt state received in the original workflow. Cannot be sent in the same request as InputPatch.
+	Input string `json:"Input,omitempty"`
+
+	// JSON merge patch (RFC 7386) applied to the input the StartAt state received in the original workflow.
+	InputPatch string `json:"InputPatch,omitempty"`
+
 	// start at
 	StartAt string `json:"StartAt,omitempty"`
+
+	// Version of the workflow definition to resume on. Defaults to the version the original workflow ran.
+	WorkflowDefinitionVersion *int64 `json:"WorkflowDefinitionVersion,omitempty"`
 }
 
 // Validate validates this workflow definition overrides
@@ -16,6 +16,9 @@ import (
 // swagger:model WorkflowDefinitionRef
 type WorkflowDefinitionRef struct {
 
+	// alias of the version to use, e.g. stable. Can't be combined with a version.
+	Alias string `json:"alias,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
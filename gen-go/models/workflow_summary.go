@@ -16,6 +16,15 @@ import (
 // swagger:model WorkflowSummary
 type WorkflowSummary struct {
 
+	// when the automatic retry of this failed workflow is scheduled to start
+	AutoRetryAt *strfmt.DateTime `json:"autoRetryAt,omitempty"`
+
+	// number of automatic retries that preceded this workflow
+	AutoRetryAttempt int64 `json:"autoRetryAttempt,omitempty"`
+
+	// workflow-id's of workflows started by this workflow's child workflow states
+	ChildWorkflowIDs []string `json:"childWorkflowIDs"`
+
 	// created at
 	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
 
@@ -31,6 +40,9 @@ type WorkflowSummary struct {
 	// namespace
 	Namespace string `json:"namespace,omitempty"`
 
+	// workflow-id of the parent workflow in case this was started by a child workflow state (Resource: workflow:<name>)
+	ParentWorkflowID string `json:"parentWorkflowID,omitempty"`
+
 	// queue
 	Queue string `json:"queue,omitempty"`
 
@@ -43,6 +55,12 @@ type WorkflowSummary struct {
 	// workflow-id of original workflow in case this is a retry
 	RetryFor string `json:"retryFor,omitempty"`
 
+	// arm of the definition's rollout (baseline or canary) that the workflow was routed to, if any
+	RolloutArm string `json:"rolloutArm,omitempty"`
+
+	// true if the workflow was still active at its soft deadline
+	SoftDeadlineBreached bool `json:"softDeadlineBreached,omitempty"`
+
 	// status
 	Status WorkflowStatus `json:"status,omitempty"`
 
@@ -52,6 +70,12 @@ type WorkflowSummary struct {
 	// tags: object with key-value pairs; keys and values should be strings
 	Tags map[string]interface{} `json:"tags,omitempty"`
 
+	// number of triggered workflows that preceded this workflow in a chain
+	TriggerDepth int64 `json:"triggerDepth,omitempty"`
+
+	// workflow-id of the workflow whose trigger started this workflow
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+
 	// workflow definition
 	WorkflowDefinition *WorkflowDefinition `json:"workflowDefinition,omitempty"`
 }
@@ -60,6 +84,11 @@ type WorkflowSummary struct {
 func (m *WorkflowSummary) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateChildWorkflowIDs(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateRetries(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -81,6 +110,15 @@ func (m *WorkflowSummary) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *WorkflowSummary) validateChildWorkflowIDs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ChildWorkflowIDs) { // not required
+		return nil
+	}
+
+	return nil
+}
+
 func (m *WorkflowSummary) validateRetries(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Retries) { // not required
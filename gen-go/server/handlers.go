@@ -122,6 +122,297 @@ func newHealthCheckInput(r *http.Request) (*models.HealthCheckInput, error) {
 	return &input, nil
 }
 
+// statusCodeForStartBulkOperation returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForStartBulkOperation(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.BulkOperation:
+		return 201
+
+	case *models.InternalError:
+		return 500
+
+	case models.BadRequest:
+		return 400
+
+	case models.BulkOperation:
+		return 201
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) StartBulkOperationHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newStartBulkOperationInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.StartBulkOperation(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForStartBulkOperation(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForStartBulkOperation(resp))
+	w.Write(respBytes)
+
+}
+
+// newStartBulkOperationInput takes in an http.Request an returns the input struct.
+func newStartBulkOperationInput(r *http.Request) (*models.BulkOperationRequest, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+
+		var input models.BulkOperationRequest
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+
+	}
+
+	return nil, nil
+}
+
+// statusCodeForGetBulkOperationByID returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetBulkOperationByID(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.BulkOperation:
+		return 200
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.BulkOperation:
+		return 200
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetBulkOperationByIDHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	operationID, err := newGetBulkOperationByIDInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetBulkOperationByIDInput(operationID)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetBulkOperationByID(ctx, operationID)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetBulkOperationByID(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetBulkOperationByID(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetBulkOperationByIDInput takes in an http.Request an returns the operationID parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetBulkOperationByIDInput(r *http.Request) (string, error) {
+	operationID := mux.Vars(r)["operationID"]
+	if len(operationID) == 0 {
+		return "", errors.New("Parameter operationID must be specified")
+	}
+	return operationID, nil
+}
+
+// statusCodeForEvaluatePath returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForEvaluatePath(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.PathEvaluation:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.PathEvaluation:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) EvaluatePathHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newEvaluatePathInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.EvaluatePath(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForEvaluatePath(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForEvaluatePath(resp))
+	w.Write(respBytes)
+
+}
+
+// newEvaluatePathInput takes in an http.Request an returns the input struct.
+func newEvaluatePathInput(r *http.Request) (*models.PathEvaluationRequest, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+
+		var input models.PathEvaluationRequest
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+
+	}
+
+	return nil, nil
+}
+
 // statusCodeForPostStateResource returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
 func statusCodeForPostStateResource(obj interface{}) int {
@@ -579,13 +870,13 @@ func newPutStateResourceInput(r *http.Request) (*models.PutStateResourceInput, e
 	return &input, nil
 }
 
-// statusCodeForGetWorkflowDefinitions returns the status code corresponding to the returned
+// statusCodeForGetWorkers returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
+func statusCodeForGetWorkers(obj interface{}) int {
 
 	switch obj.(type) {
 
-	case *[]models.WorkflowDefinition:
+	case *[]models.ResourceWorkers:
 		return 200
 
 	case *models.BadRequest:
@@ -594,7 +885,7 @@ func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
 	case *models.InternalError:
 		return 500
 
-	case []models.WorkflowDefinition:
+	case []models.ResourceWorkers:
 		return 200
 
 	case models.BadRequest:
@@ -608,7 +899,219 @@ func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
 	}
 }
 
-func (h handler) GetWorkflowDefinitionsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetWorkersHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkersInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkers(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.ResourceWorkers{}
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkers(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkers(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkersInput takes in an http.Request an returns the input struct.
+func newGetWorkersInput(r *http.Request) (*models.GetWorkersInput, error) {
+	var input models.GetWorkersInput
+
+	var err error
+	_ = err
+
+	namespaceStrs := r.URL.Query()["namespace"]
+
+	if len(namespaceStrs) > 0 {
+		var namespaceTmp string
+		namespaceStr := namespaceStrs[0]
+		namespaceTmp, err = namespaceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Namespace = &namespaceTmp
+	}
+
+	resourceStrs := r.URL.Query()["resource"]
+
+	if len(resourceStrs) > 0 {
+		var resourceTmp string
+		resourceStr := resourceStrs[0]
+		resourceTmp, err = resourceStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Resource = &resourceTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForHeartbeatWorker returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForHeartbeatWorker(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.Worker:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.Worker:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) HeartbeatWorkerHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newHeartbeatWorkerInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	if input != nil {
+		err = input.Validate(nil)
+	}
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.HeartbeatWorker(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForHeartbeatWorker(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForHeartbeatWorker(resp))
+	w.Write(respBytes)
+
+}
+
+// newHeartbeatWorkerInput takes in an http.Request an returns the input struct.
+func newHeartbeatWorkerInput(r *http.Request) (*models.WorkerHeartbeat, error) {
+	var err error
+	_ = err
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+
+		var input models.WorkerHeartbeat
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(&input); err != nil {
+			return nil, err
+		}
+		return &input, nil
+
+	}
+
+	return nil, nil
+}
+
+// statusCodeForGetWorkflowDefinitions returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitions(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.WorkflowDefinition:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case []models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.GetWorkflowDefinitions(ctx)
 
@@ -998,12 +1501,15 @@ func newUpdateWorkflowDefinitionInput(r *http.Request) (*models.UpdateWorkflowDe
 	return &input, nil
 }
 
-// statusCodeForGetWorkflowDefinitionByNameAndVersion returns the status code corresponding to the returned
+// statusCodeForGetWorkflowDefinitionAliases returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
+func statusCodeForGetWorkflowDefinitionAliases(obj interface{}) int {
 
 	switch obj.(type) {
 
+	case *[]models.WorkflowDefinitionAlias:
+		return 200
+
 	case *models.BadRequest:
 		return 400
 
@@ -1013,7 +1519,7 @@ func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
-	case *models.WorkflowDefinition:
+	case []models.WorkflowDefinitionAlias:
 		return 200
 
 	case models.BadRequest:
@@ -1025,24 +1531,21 @@ func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
 	case models.NotFound:
 		return 404
 
-	case models.WorkflowDefinition:
-		return 200
-
 	default:
 		return -1
 	}
 }
 
-func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetWorkflowDefinitionAliasesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newGetWorkflowDefinitionByNameAndVersionInput(r)
+	name, err := newGetWorkflowDefinitionAliasesInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
 		return
 	}
 
-	err = input.Validate()
+	err = models.ValidateGetWorkflowDefinitionAliasesInput(name)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
@@ -1050,14 +1553,20 @@ func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Contex
 		return
 	}
 
-	resp, err := h.GetWorkflowDefinitionByNameAndVersion(ctx, input)
+	resp, err := h.GetWorkflowDefinitionAliases(ctx, name)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.WorkflowDefinitionAlias{}
+	}
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForGetWorkflowDefinitionByNameAndVersion(err)
+		statusCode := statusCodeForGetWorkflowDefinitionAliases(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -1074,62 +1583,27 @@ func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Contex
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForGetWorkflowDefinitionByNameAndVersion(resp))
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionAliases(resp))
 	w.Write(respBytes)
 
 }
 
-// newGetWorkflowDefinitionByNameAndVersionInput takes in an http.Request an returns the input struct.
-func newGetWorkflowDefinitionByNameAndVersionInput(r *http.Request) (*models.GetWorkflowDefinitionByNameAndVersionInput, error) {
-	var input models.GetWorkflowDefinitionByNameAndVersionInput
-
-	var err error
-	_ = err
-
-	nameStr := mux.Vars(r)["name"]
-	if len(nameStr) == 0 {
-		return nil, errors.New("path parameter 'name' must be specified")
-	}
-	nameStrs := []string{nameStr}
-
-	if len(nameStrs) > 0 {
-		var nameTmp string
-		nameStr := nameStrs[0]
-		nameTmp, err = nameStr, error(nil)
-		if err != nil {
-			return nil, err
-		}
-		input.Name = nameTmp
-	}
-
-	versionStr := mux.Vars(r)["version"]
-	if len(versionStr) == 0 {
-		return nil, errors.New("path parameter 'version' must be specified")
-	}
-	versionStrs := []string{versionStr}
-
-	if len(versionStrs) > 0 {
-		var versionTmp int64
-		versionStr := versionStrs[0]
-		versionTmp, err = swag.ConvertInt64(versionStr)
-		if err != nil {
-			return nil, err
-		}
-		input.Version = versionTmp
+// newGetWorkflowDefinitionAliasesInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowDefinitionAliasesInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
 	}
-
-	return &input, nil
+	return name, nil
 }
 
-// statusCodeForGetWorkflows returns the status code corresponding to the returned
+// statusCodeForDeleteWorkflowDefinitionAlias returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflows(obj interface{}) int {
+func statusCodeForDeleteWorkflowDefinitionAlias(obj interface{}) int {
 
 	switch obj.(type) {
 
-	case *[]models.Workflow:
-		return 200
-
 	case *models.BadRequest:
 		return 400
 
@@ -1139,9 +1613,6 @@ func statusCodeForGetWorkflows(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
-	case []models.Workflow:
-		return 200
-
 	case models.BadRequest:
 		return 400
 
@@ -1156,9 +1627,9 @@ func statusCodeForGetWorkflows(obj interface{}) int {
 	}
 }
 
-func (h handler) GetWorkflowsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) DeleteWorkflowDefinitionAliasHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newGetWorkflowsInput(r)
+	input, err := newDeleteWorkflowDefinitionAliasInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
@@ -1173,10 +1644,1017 @@ func (h handler) GetWorkflowsHandler(ctx context.Context, w http.ResponseWriter,
 		return
 	}
 
-	resp, nextPageID, err := h.GetWorkflows(ctx, input)
+	err = h.DeleteWorkflowDefinitionAlias(ctx, input)
 
-	// Success types that return an array should never return nil so let's make this easier
-	// for consumers by converting nil arrays to empty arrays
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForDeleteWorkflowDefinitionAlias(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newDeleteWorkflowDefinitionAliasInput takes in an http.Request an returns the input struct.
+func newDeleteWorkflowDefinitionAliasInput(r *http.Request) (*models.DeleteWorkflowDefinitionAliasInput, error) {
+	var input models.DeleteWorkflowDefinitionAliasInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForSetWorkflowDefinitionAlias returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForSetWorkflowDefinitionAlias(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionAlias:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionAlias:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) SetWorkflowDefinitionAliasHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newSetWorkflowDefinitionAliasInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.SetWorkflowDefinitionAlias(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForSetWorkflowDefinitionAlias(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForSetWorkflowDefinitionAlias(resp))
+	w.Write(respBytes)
+
+}
+
+// newSetWorkflowDefinitionAliasInput takes in an http.Request an returns the input struct.
+func newSetWorkflowDefinitionAliasInput(r *http.Request) (*models.SetWorkflowDefinitionAliasInput, error) {
+	var input models.SetWorkflowDefinitionAliasInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	aliasStr := mux.Vars(r)["alias"]
+	if len(aliasStr) == 0 {
+		return nil, errors.New("path parameter 'alias' must be specified")
+	}
+	aliasStrs := []string{aliasStr}
+
+	if len(aliasStrs) > 0 {
+		var aliasTmp string
+		aliasStr := aliasStrs[0]
+		aliasTmp, err = aliasStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Alias = aliasTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+
+		input.SetWorkflowDefinitionAliasRequest = &models.SetWorkflowDefinitionAliasRequest{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.SetWorkflowDefinitionAliasRequest); err != nil {
+			return nil, err
+		}
+
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionDiff returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionDiff(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionDiff:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionDiff:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionDiffHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionDiffInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionDiff(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionDiff(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionDiff(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionDiffInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionDiffInput(r *http.Request) (*models.GetWorkflowDefinitionDiffInput, error) {
+	var input models.GetWorkflowDefinitionDiffInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	fromStrs := r.URL.Query()["from"]
+	if len(fromStrs) == 0 {
+		return nil, errors.New("query parameter 'from' must be specified")
+	}
+
+	if len(fromStrs) > 0 {
+		var fromTmp int64
+		fromStr := fromStrs[0]
+		fromTmp, err = swag.ConvertInt64(fromStr)
+		if err != nil {
+			return nil, err
+		}
+		input.From = fromTmp
+	}
+
+	toStrs := r.URL.Query()["to"]
+	if len(toStrs) == 0 {
+		return nil, errors.New("query parameter 'to' must be specified")
+	}
+
+	if len(toStrs) > 0 {
+		var toTmp int64
+		toStr := toStrs[0]
+		toTmp, err = swag.ConvertInt64(toStr)
+		if err != nil {
+			return nil, err
+		}
+		input.To = toTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionEvents returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionEvents(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionEventsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newGetWorkflowDefinitionEventsInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowDefinitionEventsInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.GetWorkflowDefinitionEvents(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionEvents(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newGetWorkflowDefinitionEventsInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowDefinitionEventsInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForDeleteWorkflowDefinitionRollout returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForDeleteWorkflowDefinitionRollout(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) DeleteWorkflowDefinitionRolloutHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newDeleteWorkflowDefinitionRolloutInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateDeleteWorkflowDefinitionRolloutInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.DeleteWorkflowDefinitionRollout(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForDeleteWorkflowDefinitionRollout(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newDeleteWorkflowDefinitionRolloutInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newDeleteWorkflowDefinitionRolloutInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForGetWorkflowDefinitionRollout returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionRollout(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionRollout:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionRollout:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionRolloutHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	name, err := newGetWorkflowDefinitionRolloutInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowDefinitionRolloutInput(name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionRollout(ctx, name)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionRollout(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionRollout(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionRolloutInput takes in an http.Request an returns the name parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowDefinitionRolloutInput(r *http.Request) (string, error) {
+	name := mux.Vars(r)["name"]
+	if len(name) == 0 {
+		return "", errors.New("Parameter name must be specified")
+	}
+	return name, nil
+}
+
+// statusCodeForSetWorkflowDefinitionRollout returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForSetWorkflowDefinitionRollout(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinitionRollout:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinitionRollout:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) SetWorkflowDefinitionRolloutHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newSetWorkflowDefinitionRolloutInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.SetWorkflowDefinitionRollout(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForSetWorkflowDefinitionRollout(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForSetWorkflowDefinitionRollout(resp))
+	w.Write(respBytes)
+
+}
+
+// newSetWorkflowDefinitionRolloutInput takes in an http.Request an returns the input struct.
+func newSetWorkflowDefinitionRolloutInput(r *http.Request) (*models.SetWorkflowDefinitionRolloutInput, error) {
+	var input models.SetWorkflowDefinitionRolloutInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+
+		input.WorkflowDefinitionRollout = &models.WorkflowDefinitionRollout{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.WorkflowDefinitionRollout); err != nil {
+			return nil, err
+		}
+
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionByNameAndVersion returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionByNameAndVersion(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowDefinition:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowDefinition:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionByNameAndVersionHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionByNameAndVersionInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionByNameAndVersion(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionByNameAndVersion(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionByNameAndVersion(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionByNameAndVersionInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionByNameAndVersionInput(r *http.Request) (*models.GetWorkflowDefinitionByNameAndVersionInput, error) {
+	var input models.GetWorkflowDefinitionByNameAndVersionInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	versionStr := mux.Vars(r)["version"]
+	if len(versionStr) == 0 {
+		return nil, errors.New("path parameter 'version' must be specified")
+	}
+	versionStrs := []string{versionStr}
+
+	if len(versionStrs) > 0 {
+		var versionTmp int64
+		versionStr := versionStrs[0]
+		versionTmp, err = swag.ConvertInt64(versionStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Version = versionTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowDefinitionGraph returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowDefinitionGraph(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowGraph:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.WorkflowGraph:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowDefinitionGraphHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowDefinitionGraphInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowDefinitionGraph(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowDefinitionGraph(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowDefinitionGraph(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowDefinitionGraphInput takes in an http.Request an returns the input struct.
+func newGetWorkflowDefinitionGraphInput(r *http.Request) (*models.GetWorkflowDefinitionGraphInput, error) {
+	var input models.GetWorkflowDefinitionGraphInput
+
+	var err error
+	_ = err
+
+	nameStr := mux.Vars(r)["name"]
+	if len(nameStr) == 0 {
+		return nil, errors.New("path parameter 'name' must be specified")
+	}
+	nameStrs := []string{nameStr}
+
+	if len(nameStrs) > 0 {
+		var nameTmp string
+		nameStr := nameStrs[0]
+		nameTmp, err = nameStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Name = nameTmp
+	}
+
+	versionStr := mux.Vars(r)["version"]
+	if len(versionStr) == 0 {
+		return nil, errors.New("path parameter 'version' must be specified")
+	}
+	versionStrs := []string{versionStr}
+
+	if len(versionStrs) > 0 {
+		var versionTmp int64
+		versionStr := versionStrs[0]
+		versionTmp, err = swag.ConvertInt64(versionStr)
+		if err != nil {
+			return nil, err
+		}
+		input.Version = versionTmp
+	}
+
+	formatStrs := r.URL.Query()["format"]
+
+	if len(formatStrs) > 0 {
+		var formatTmp string
+		formatStr := formatStrs[0]
+		formatTmp, err = formatStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.Format = &formatTmp
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflows returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflows(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *[]models.Workflow:
+		return 200
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case []models.Workflow:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newGetWorkflowsInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, nextPageID, err := h.GetWorkflows(ctx, input)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
 	if resp == nil {
 		resp = []models.Workflow{}
 	}
@@ -1427,7 +2905,205 @@ func newStartWorkflowInput(r *http.Request) (*models.StartWorkflowRequest, error
 
 // statusCodeForCancelWorkflow returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForCancelWorkflow(obj interface{}) int {
+func statusCodeForCancelWorkflow(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) CancelWorkflowHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newCancelWorkflowInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.CancelWorkflow(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForCancelWorkflow(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newCancelWorkflowInput takes in an http.Request an returns the input struct.
+func newCancelWorkflowInput(r *http.Request) (*models.CancelWorkflowInput, error) {
+	var input models.CancelWorkflowInput
+
+	var err error
+	_ = err
+
+	workflowIDStr := mux.Vars(r)["workflowID"]
+	if len(workflowIDStr) == 0 {
+		return nil, errors.New("path parameter 'workflowID' must be specified")
+	}
+	workflowIDStrs := []string{workflowIDStr}
+
+	if len(workflowIDStrs) > 0 {
+		var workflowIDTmp string
+		workflowIDStr := workflowIDStrs[0]
+		workflowIDTmp, err = workflowIDStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.WorkflowID = workflowIDTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+	if len(data) == 0 {
+		return nil, errors.New("request body is required, but was empty")
+	}
+
+	if len(data) > 0 {
+
+		input.Reason = &models.CancelReason{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.Reason); err != nil {
+			return nil, err
+		}
+
+	}
+
+	return &input, nil
+}
+
+// statusCodeForGetWorkflowByID returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowByID(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.Workflow:
+		return 200
+
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	case models.Workflow:
+		return 200
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowByIDHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	workflowID, err := newGetWorkflowByIDInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowByIDInput(workflowID)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.GetWorkflowByID(ctx, workflowID)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowByID(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForGetWorkflowByID(resp))
+	w.Write(respBytes)
+
+}
+
+// newGetWorkflowByIDInput takes in an http.Request an returns the workflowID parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowByIDInput(r *http.Request) (string, error) {
+	workflowID := mux.Vars(r)["workflowID"]
+	if len(workflowID) == 0 {
+		return "", errors.New("Parameter workflowID must be specified")
+	}
+	return workflowID, nil
+}
+
+// statusCodeForResumeWorkflowByID returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForResumeWorkflowByID(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -1440,6 +3116,9 @@ func statusCodeForCancelWorkflow(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
+	case *models.Workflow:
+		return 200
+
 	case models.BadRequest:
 		return 400
 
@@ -1449,14 +3128,17 @@ func statusCodeForCancelWorkflow(obj interface{}) int {
 	case models.NotFound:
 		return 404
 
+	case models.Workflow:
+		return 200
+
 	default:
 		return -1
 	}
 }
 
-func (h handler) CancelWorkflowHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) ResumeWorkflowByIDHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newCancelWorkflowInput(r)
+	input, err := newResumeWorkflowByIDInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
@@ -1471,14 +3153,14 @@ func (h handler) CancelWorkflowHandler(ctx context.Context, w http.ResponseWrite
 		return
 	}
 
-	err = h.CancelWorkflow(ctx, input)
+	resp, err := h.ResumeWorkflowByID(ctx, input)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForCancelWorkflow(err)
+		statusCode := statusCodeForResumeWorkflowByID(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -1487,14 +3169,22 @@ func (h handler) CancelWorkflowHandler(ctx context.Context, w http.ResponseWrite
 		return
 	}
 
-	w.WriteHeader(200)
-	w.Write([]byte(""))
+	respBytes, err := json.MarshalIndent(resp, "", "\t")
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.InternalError{Message: err.Error()}), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCodeForResumeWorkflowByID(resp))
+	w.Write(respBytes)
 
 }
 
-// newCancelWorkflowInput takes in an http.Request an returns the input struct.
-func newCancelWorkflowInput(r *http.Request) (*models.CancelWorkflowInput, error) {
-	var input models.CancelWorkflowInput
+// newResumeWorkflowByIDInput takes in an http.Request an returns the input struct.
+func newResumeWorkflowByIDInput(r *http.Request) (*models.ResumeWorkflowByIDInput, error) {
+	var input models.ResumeWorkflowByIDInput
 
 	var err error
 	_ = err
@@ -1522,8 +3212,8 @@ func newCancelWorkflowInput(r *http.Request) (*models.CancelWorkflowInput, error
 
 	if len(data) > 0 {
 
-		input.Reason = &models.CancelReason{}
-		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.Reason); err != nil {
+		input.Overrides = &models.WorkflowDefinitionOverrides{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.Overrides); err != nil {
 			return nil, err
 		}
 
@@ -1532,12 +3222,15 @@ func newCancelWorkflowInput(r *http.Request) (*models.CancelWorkflowInput, error
 	return &input, nil
 }
 
-// statusCodeForGetWorkflowByID returns the status code corresponding to the returned
+// statusCodeForGetWorkflowChoices returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForGetWorkflowByID(obj interface{}) int {
+func statusCodeForGetWorkflowChoices(obj interface{}) int {
 
 	switch obj.(type) {
 
+	case *[]models.ChoiceEvaluation:
+		return 200
+
 	case *models.BadRequest:
 		return 400
 
@@ -1547,7 +3240,7 @@ func statusCodeForGetWorkflowByID(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
-	case *models.Workflow:
+	case []models.ChoiceEvaluation:
 		return 200
 
 	case models.BadRequest:
@@ -1559,24 +3252,21 @@ func statusCodeForGetWorkflowByID(obj interface{}) int {
 	case models.NotFound:
 		return 404
 
-	case models.Workflow:
-		return 200
-
 	default:
 		return -1
 	}
 }
 
-func (h handler) GetWorkflowByIDHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetWorkflowChoicesHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	workflowID, err := newGetWorkflowByIDInput(r)
+	workflowID, err := newGetWorkflowChoicesInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
 		return
 	}
 
-	err = models.ValidateGetWorkflowByIDInput(workflowID)
+	err = models.ValidateGetWorkflowChoicesInput(workflowID)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
@@ -1584,14 +3274,20 @@ func (h handler) GetWorkflowByIDHandler(ctx context.Context, w http.ResponseWrit
 		return
 	}
 
-	resp, err := h.GetWorkflowByID(ctx, workflowID)
+	resp, err := h.GetWorkflowChoices(ctx, workflowID)
+
+	// Success types that return an array should never return nil so let's make this easier
+	// for consumers by converting nil arrays to empty arrays
+	if resp == nil {
+		resp = []models.ChoiceEvaluation{}
+	}
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForGetWorkflowByID(err)
+		statusCode := statusCodeForGetWorkflowChoices(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -1608,14 +3304,14 @@ func (h handler) GetWorkflowByIDHandler(ctx context.Context, w http.ResponseWrit
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForGetWorkflowByID(resp))
+	w.WriteHeader(statusCodeForGetWorkflowChoices(resp))
 	w.Write(respBytes)
 
 }
 
-// newGetWorkflowByIDInput takes in an http.Request an returns the workflowID parameter
+// newGetWorkflowChoicesInput takes in an http.Request an returns the workflowID parameter
 // that it contains. It returns an error if the request doesn't contain the parameter.
-func newGetWorkflowByIDInput(r *http.Request) (string, error) {
+func newGetWorkflowChoicesInput(r *http.Request) (string, error) {
 	workflowID := mux.Vars(r)["workflowID"]
 	if len(workflowID) == 0 {
 		return "", errors.New("Parameter workflowID must be specified")
@@ -1623,9 +3319,9 @@ func newGetWorkflowByIDInput(r *http.Request) (string, error) {
 	return workflowID, nil
 }
 
-// statusCodeForResumeWorkflowByID returns the status code corresponding to the returned
+// statusCodeForGetWorkflowEvents returns the status code corresponding to the returned
 // object. It returns -1 if the type doesn't correspond to anything.
-func statusCodeForResumeWorkflowByID(obj interface{}) int {
+func statusCodeForGetWorkflowEvents(obj interface{}) int {
 
 	switch obj.(type) {
 
@@ -1638,7 +3334,84 @@ func statusCodeForResumeWorkflowByID(obj interface{}) int {
 	case *models.NotFound:
 		return 404
 
-	case *models.Workflow:
+	case models.BadRequest:
+		return 400
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) GetWorkflowEventsHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	workflowID, err := newGetWorkflowEventsInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = models.ValidateGetWorkflowEventsInput(workflowID)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.GetWorkflowEvents(ctx, workflowID)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForGetWorkflowEvents(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newGetWorkflowEventsInput takes in an http.Request an returns the workflowID parameter
+// that it contains. It returns an error if the request doesn't contain the parameter.
+func newGetWorkflowEventsInput(r *http.Request) (string, error) {
+	workflowID := mux.Vars(r)["workflowID"]
+	if len(workflowID) == 0 {
+		return "", errors.New("Parameter workflowID must be specified")
+	}
+	return workflowID, nil
+}
+
+// statusCodeForGetWorkflowGraph returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForGetWorkflowGraph(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case *models.WorkflowGraph:
 		return 200
 
 	case models.BadRequest:
@@ -1650,7 +3423,7 @@ func statusCodeForResumeWorkflowByID(obj interface{}) int {
 	case models.NotFound:
 		return 404
 
-	case models.Workflow:
+	case models.WorkflowGraph:
 		return 200
 
 	default:
@@ -1658,9 +3431,9 @@ func statusCodeForResumeWorkflowByID(obj interface{}) int {
 	}
 }
 
-func (h handler) ResumeWorkflowByIDHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+func (h handler) GetWorkflowGraphHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 
-	input, err := newResumeWorkflowByIDInput(r)
+	input, err := newGetWorkflowGraphInput(r)
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
@@ -1675,14 +3448,14 @@ func (h handler) ResumeWorkflowByIDHandler(ctx context.Context, w http.ResponseW
 		return
 	}
 
-	resp, err := h.ResumeWorkflowByID(ctx, input)
+	resp, err := h.GetWorkflowGraph(ctx, input)
 
 	if err != nil {
 		logger.FromContext(ctx).AddContext("error", err.Error())
 		if btErr, ok := err.(*errors.Error); ok {
 			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
 		}
-		statusCode := statusCodeForResumeWorkflowByID(err)
+		statusCode := statusCodeForGetWorkflowGraph(err)
 		if statusCode == -1 {
 			err = models.InternalError{Message: err.Error()}
 			statusCode = 500
@@ -1699,14 +3472,14 @@ func (h handler) ResumeWorkflowByIDHandler(ctx context.Context, w http.ResponseW
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCodeForResumeWorkflowByID(resp))
+	w.WriteHeader(statusCodeForGetWorkflowGraph(resp))
 	w.Write(respBytes)
 
 }
 
-// newResumeWorkflowByIDInput takes in an http.Request an returns the input struct.
-func newResumeWorkflowByIDInput(r *http.Request) (*models.ResumeWorkflowByIDInput, error) {
-	var input models.ResumeWorkflowByIDInput
+// newGetWorkflowGraphInput takes in an http.Request an returns the input struct.
+func newGetWorkflowGraphInput(r *http.Request) (*models.GetWorkflowGraphInput, error) {
+	var input models.GetWorkflowGraphInput
 
 	var err error
 	_ = err
@@ -1727,18 +3500,16 @@ func newResumeWorkflowByIDInput(r *http.Request) (*models.ResumeWorkflowByIDInpu
 		input.WorkflowID = workflowIDTmp
 	}
 
-	data, err := ioutil.ReadAll(r.Body)
-	if len(data) == 0 {
-		return nil, errors.New("request body is required, but was empty")
-	}
-
-	if len(data) > 0 {
+	formatStrs := r.URL.Query()["format"]
 
-		input.Overrides = &models.WorkflowDefinitionOverrides{}
-		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.Overrides); err != nil {
+	if len(formatStrs) > 0 {
+		var formatTmp string
+		formatStr := formatStrs[0]
+		formatTmp, err = formatStr, error(nil)
+		if err != nil {
 			return nil, err
 		}
-
+		input.Format = &formatTmp
 	}
 
 	return &input, nil
@@ -1826,3 +3597,129 @@ func newResolveWorkflowByIDInput(r *http.Request) (string, error) {
 	}
 	return workflowID, nil
 }
+
+// statusCodeForSignalWorkflowState returns the status code corresponding to the returned
+// object. It returns -1 if the type doesn't correspond to anything.
+func statusCodeForSignalWorkflowState(obj interface{}) int {
+
+	switch obj.(type) {
+
+	case *models.BadRequest:
+		return 400
+
+	case *models.Conflict:
+		return 409
+
+	case *models.InternalError:
+		return 500
+
+	case *models.NotFound:
+		return 404
+
+	case models.BadRequest:
+		return 400
+
+	case models.Conflict:
+		return 409
+
+	case models.InternalError:
+		return 500
+
+	case models.NotFound:
+		return 404
+
+	default:
+		return -1
+	}
+}
+
+func (h handler) SignalWorkflowStateHandler(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+
+	input, err := newSignalWorkflowStateInput(r)
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = input.Validate()
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		http.Error(w, jsonMarshalNoError(models.BadRequest{Message: err.Error()}), http.StatusBadRequest)
+		return
+	}
+
+	err = h.SignalWorkflowState(ctx, input)
+
+	if err != nil {
+		logger.FromContext(ctx).AddContext("error", err.Error())
+		if btErr, ok := err.(*errors.Error); ok {
+			logger.FromContext(ctx).AddContext("stacktrace", string(btErr.Stack()))
+		}
+		statusCode := statusCodeForSignalWorkflowState(err)
+		if statusCode == -1 {
+			err = models.InternalError{Message: err.Error()}
+			statusCode = 500
+		}
+		http.Error(w, jsonMarshalNoError(err), statusCode)
+		return
+	}
+
+	w.WriteHeader(200)
+	w.Write([]byte(""))
+
+}
+
+// newSignalWorkflowStateInput takes in an http.Request an returns the input struct.
+func newSignalWorkflowStateInput(r *http.Request) (*models.SignalWorkflowStateInput, error) {
+	var input models.SignalWorkflowStateInput
+
+	var err error
+	_ = err
+
+	workflowIDStr := mux.Vars(r)["workflowID"]
+	if len(workflowIDStr) == 0 {
+		return nil, errors.New("path parameter 'workflowID' must be specified")
+	}
+	workflowIDStrs := []string{workflowIDStr}
+
+	if len(workflowIDStrs) > 0 {
+		var workflowIDTmp string
+		workflowIDStr := workflowIDStrs[0]
+		workflowIDTmp, err = workflowIDStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.WorkflowID = workflowIDTmp
+	}
+
+	stateStr := mux.Vars(r)["state"]
+	if len(stateStr) == 0 {
+		return nil, errors.New("path parameter 'state' must be specified")
+	}
+	stateStrs := []string{stateStr}
+
+	if len(stateStrs) > 0 {
+		var stateTmp string
+		stateStr := stateStrs[0]
+		stateTmp, err = stateStr, error(nil)
+		if err != nil {
+			return nil, err
+		}
+		input.State = stateTmp
+	}
+
+	data, err := ioutil.ReadAll(r.Body)
+
+	if len(data) > 0 {
+
+		input.Signal = &models.SignalRequest{}
+		if err := json.NewDecoder(bytes.NewReader(data)).Decode(input.Signal); err != nil {
+			return nil, err
+		}
+
+	}
+
+	return &input, nil
+}
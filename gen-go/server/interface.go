@@ -19,6 +19,32 @@ type Controller interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	HealthCheck(ctx context.Context) error
 
+	// StartBulkOperation handles POST requests to /bulk-operations
+	//
+	// 201: *models.BulkOperation
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	StartBulkOperation(ctx context.Context, i *models.BulkOperationRequest) (*models.BulkOperation, error)
+
+	// GetBulkOperationByID handles GET requests to /bulk-operations/{operationID}
+	//
+	// 200: *models.BulkOperation
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetBulkOperationByID(ctx context.Context, operationID string) (*models.BulkOperation, error)
+
+	// EvaluatePath handles POST requests to /path-evaluations
+	//
+	// 200: *models.PathEvaluation
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	EvaluatePath(ctx context.Context, i *models.PathEvaluationRequest) (*models.PathEvaluation, error)
+
 	// PostStateResource handles POST requests to /state-resources
 	//
 	// 201: *models.StateResource
@@ -53,6 +79,22 @@ type Controller interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	PutStateResource(ctx context.Context, i *models.PutStateResourceInput) (*models.StateResource, error)
 
+	// GetWorkers handles GET requests to /workers
+	//
+	// 200: []models.ResourceWorkers
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkers(ctx context.Context, i *models.GetWorkersInput) ([]models.ResourceWorkers, error)
+
+	// HeartbeatWorker handles POST requests to /workers
+	//
+	// 200: *models.Worker
+	// 400: *models.BadRequest
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	HeartbeatWorker(ctx context.Context, i *models.WorkerHeartbeat) (*models.Worker, error)
+
 	// GetWorkflowDefinitions handles GET requests to /workflow-definitions
 	// Get the latest versions of all available WorkflowDefinitions
 	// 200: []models.WorkflowDefinition
@@ -87,6 +129,78 @@ type Controller interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	UpdateWorkflowDefinition(ctx context.Context, i *models.UpdateWorkflowDefinitionInput) (*models.WorkflowDefinition, error)
 
+	// GetWorkflowDefinitionAliases handles GET requests to /workflow-definitions/{name}/aliases
+	//
+	// 200: []models.WorkflowDefinitionAlias
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error)
+
+	// DeleteWorkflowDefinitionAlias handles DELETE requests to /workflow-definitions/{name}/aliases/{alias}
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error
+
+	// SetWorkflowDefinitionAlias handles PUT requests to /workflow-definitions/{name}/aliases/{alias}
+	//
+	// 200: *models.WorkflowDefinitionAlias
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	SetWorkflowDefinitionAlias(ctx context.Context, i *models.SetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error)
+
+	// GetWorkflowDefinitionDiff handles GET requests to /workflow-definitions/{name}/diff
+	//
+	// 200: *models.WorkflowDefinitionDiff
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error)
+
+	// GetWorkflowDefinitionEvents handles GET requests to /workflow-definitions/{name}/events
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionEvents(ctx context.Context, name string) error
+
+	// DeleteWorkflowDefinitionRollout handles DELETE requests to /workflow-definitions/{name}/rollout
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error
+
+	// GetWorkflowDefinitionRollout handles GET requests to /workflow-definitions/{name}/rollout
+	//
+	// 200: *models.WorkflowDefinitionRollout
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionRollout(ctx context.Context, name string) (*models.WorkflowDefinitionRollout, error)
+
+	// SetWorkflowDefinitionRollout handles PUT requests to /workflow-definitions/{name}/rollout
+	//
+	// 200: *models.WorkflowDefinitionRollout
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	SetWorkflowDefinitionRollout(ctx context.Context, i *models.SetWorkflowDefinitionRolloutInput) (*models.WorkflowDefinitionRollout, error)
+
 	// GetWorkflowDefinitionByNameAndVersion handles GET requests to /workflow-definitions/{name}/{version}
 	//
 	// 200: *models.WorkflowDefinition
@@ -96,6 +210,15 @@ type Controller interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error)
 
+	// GetWorkflowDefinitionGraph handles GET requests to /workflow-definitions/{name}/{version}/graph
+	//
+	// 200: *models.WorkflowGraph
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.WorkflowGraph, error)
+
 	// GetWorkflows handles GET requests to /workflows
 	// Returns response object and the ID of the next page
 	//
@@ -142,6 +265,33 @@ type Controller interface {
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	ResumeWorkflowByID(ctx context.Context, i *models.ResumeWorkflowByIDInput) (*models.Workflow, error)
 
+	// GetWorkflowChoices handles GET requests to /workflows/{workflowID}/choices
+	//
+	// 200: []models.ChoiceEvaluation
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowChoices(ctx context.Context, workflowID string) ([]models.ChoiceEvaluation, error)
+
+	// GetWorkflowEvents handles GET requests to /workflows/{workflowID}/events
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowEvents(ctx context.Context, workflowID string) error
+
+	// GetWorkflowGraph handles GET requests to /workflows/{workflowID}/graph
+	//
+	// 200: *models.WorkflowGraph
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.WorkflowGraph, error)
+
 	// ResolveWorkflowByID handles POST requests to /workflows/{workflowID}/resolved
 	//
 	// 201: nil
@@ -151,4 +301,13 @@ type Controller interface {
 	// 500: *models.InternalError
 	// default: client side HTTP errors, for example: context.DeadlineExceeded.
 	ResolveWorkflowByID(ctx context.Context, workflowID string) error
+	// SignalWorkflowState handles POST requests to /workflows/{workflowID}/signals/{state}
+	//
+	// 200: nil
+	// 400: *models.BadRequest
+	// 404: *models.NotFound
+	// 409: *models.Conflict
+	// 500: *models.InternalError
+	// default: client side HTTP errors, for example: context.DeadlineExceeded.
+	SignalWorkflowState(ctx context.Context, i *models.SignalWorkflowStateInput) error
 }
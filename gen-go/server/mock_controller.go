@@ -46,6 +46,45 @@ func (mr *MockControllerMockRecorder) HealthCheck(ctx interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockController)(nil).HealthCheck), ctx)
 }
 
+// StartBulkOperation mocks base method
+func (m *MockController) StartBulkOperation(ctx context.Context, i *models.BulkOperationRequest) (*models.BulkOperation, error) {
+	ret := m.ctrl.Call(m, "StartBulkOperation", ctx, i)
+	ret0, _ := ret[0].(*models.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBulkOperation indicates an expected call of StartBulkOperation
+func (mr *MockControllerMockRecorder) StartBulkOperation(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBulkOperation", reflect.TypeOf((*MockController)(nil).StartBulkOperation), ctx, i)
+}
+
+// GetBulkOperationByID mocks base method
+func (m *MockController) GetBulkOperationByID(ctx context.Context, operationID string) (*models.BulkOperation, error) {
+	ret := m.ctrl.Call(m, "GetBulkOperationByID", ctx, operationID)
+	ret0, _ := ret[0].(*models.BulkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBulkOperationByID indicates an expected call of GetBulkOperationByID
+func (mr *MockControllerMockRecorder) GetBulkOperationByID(ctx, operationID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkOperationByID", reflect.TypeOf((*MockController)(nil).GetBulkOperationByID), ctx, operationID)
+}
+
+// EvaluatePath mocks base method
+func (m *MockController) EvaluatePath(ctx context.Context, i *models.PathEvaluationRequest) (*models.PathEvaluation, error) {
+	ret := m.ctrl.Call(m, "EvaluatePath", ctx, i)
+	ret0, _ := ret[0].(*models.PathEvaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluatePath indicates an expected call of EvaluatePath
+func (mr *MockControllerMockRecorder) EvaluatePath(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluatePath", reflect.TypeOf((*MockController)(nil).EvaluatePath), ctx, i)
+}
+
 // PostStateResource mocks base method
 func (m *MockController) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
 	ret := m.ctrl.Call(m, "PostStateResource", ctx, i)
@@ -97,6 +136,32 @@ func (mr *MockControllerMockRecorder) PutStateResource(ctx, i interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutStateResource", reflect.TypeOf((*MockController)(nil).PutStateResource), ctx, i)
 }
 
+// GetWorkers mocks base method
+func (m *MockController) GetWorkers(ctx context.Context, i *models.GetWorkersInput) ([]models.ResourceWorkers, error) {
+	ret := m.ctrl.Call(m, "GetWorkers", ctx, i)
+	ret0, _ := ret[0].([]models.ResourceWorkers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkers indicates an expected call of GetWorkers
+func (mr *MockControllerMockRecorder) GetWorkers(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkers", reflect.TypeOf((*MockController)(nil).GetWorkers), ctx, i)
+}
+
+// HeartbeatWorker mocks base method
+func (m *MockController) HeartbeatWorker(ctx context.Context, i *models.WorkerHeartbeat) (*models.Worker, error) {
+	ret := m.ctrl.Call(m, "HeartbeatWorker", ctx, i)
+	ret0, _ := ret[0].(*models.Worker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeartbeatWorker indicates an expected call of HeartbeatWorker
+func (mr *MockControllerMockRecorder) HeartbeatWorker(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeartbeatWorker", reflect.TypeOf((*MockController)(nil).HeartbeatWorker), ctx, i)
+}
+
 // GetWorkflowDefinitions mocks base method
 func (m *MockController) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitions", ctx)
@@ -149,6 +214,107 @@ func (mr *MockControllerMockRecorder) UpdateWorkflowDefinition(ctx, i interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowDefinition", reflect.TypeOf((*MockController)(nil).UpdateWorkflowDefinition), ctx, i)
 }
 
+// GetWorkflowDefinitionAliases mocks base method
+func (m *MockController) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionAliases", ctx, name)
+	ret0, _ := ret[0].([]models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionAliases indicates an expected call of GetWorkflowDefinitionAliases
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionAliases(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionAliases", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionAliases), ctx, name)
+}
+
+// DeleteWorkflowDefinitionAlias mocks base method
+func (m *MockController) DeleteWorkflowDefinitionAlias(ctx context.Context, i *models.DeleteWorkflowDefinitionAliasInput) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionAlias indicates an expected call of DeleteWorkflowDefinitionAlias
+func (mr *MockControllerMockRecorder) DeleteWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionAlias", reflect.TypeOf((*MockController)(nil).DeleteWorkflowDefinitionAlias), ctx, i)
+}
+
+// SetWorkflowDefinitionAlias mocks base method
+func (m *MockController) SetWorkflowDefinitionAlias(ctx context.Context, i *models.SetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	ret := m.ctrl.Call(m, "SetWorkflowDefinitionAlias", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionAlias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkflowDefinitionAlias indicates an expected call of SetWorkflowDefinitionAlias
+func (mr *MockControllerMockRecorder) SetWorkflowDefinitionAlias(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkflowDefinitionAlias", reflect.TypeOf((*MockController)(nil).SetWorkflowDefinitionAlias), ctx, i)
+}
+
+// GetWorkflowDefinitionDiff mocks base method
+func (m *MockController) GetWorkflowDefinitionDiff(ctx context.Context, i *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionDiff", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionDiff indicates an expected call of GetWorkflowDefinitionDiff
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionDiff(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionDiff", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionDiff), ctx, i)
+}
+
+// GetWorkflowDefinitionEvents mocks base method
+func (m *MockController) GetWorkflowDefinitionEvents(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionEvents", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWorkflowDefinitionEvents indicates an expected call of GetWorkflowDefinitionEvents
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionEvents(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionEvents", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionEvents), ctx, name)
+}
+
+// DeleteWorkflowDefinitionRollout mocks base method
+func (m *MockController) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	ret := m.ctrl.Call(m, "DeleteWorkflowDefinitionRollout", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflowDefinitionRollout indicates an expected call of DeleteWorkflowDefinitionRollout
+func (mr *MockControllerMockRecorder) DeleteWorkflowDefinitionRollout(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowDefinitionRollout", reflect.TypeOf((*MockController)(nil).DeleteWorkflowDefinitionRollout), ctx, name)
+}
+
+// GetWorkflowDefinitionRollout mocks base method
+func (m *MockController) GetWorkflowDefinitionRollout(ctx context.Context, name string) (*models.WorkflowDefinitionRollout, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionRollout", ctx, name)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionRollout indicates an expected call of GetWorkflowDefinitionRollout
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionRollout(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionRollout", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionRollout), ctx, name)
+}
+
+// SetWorkflowDefinitionRollout mocks base method
+func (m *MockController) SetWorkflowDefinitionRollout(ctx context.Context, i *models.SetWorkflowDefinitionRolloutInput) (*models.WorkflowDefinitionRollout, error) {
+	ret := m.ctrl.Call(m, "SetWorkflowDefinitionRollout", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowDefinitionRollout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWorkflowDefinitionRollout indicates an expected call of SetWorkflowDefinitionRollout
+func (mr *MockControllerMockRecorder) SetWorkflowDefinitionRollout(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkflowDefinitionRollout", reflect.TypeOf((*MockController)(nil).SetWorkflowDefinitionRollout), ctx, i)
+}
+
 // GetWorkflowDefinitionByNameAndVersion mocks base method
 func (m *MockController) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, i *models.GetWorkflowDefinitionByNameAndVersionInput) (*models.WorkflowDefinition, error) {
 	ret := m.ctrl.Call(m, "GetWorkflowDefinitionByNameAndVersion", ctx, i)
@@ -162,6 +328,19 @@ func (mr *MockControllerMockRecorder) GetWorkflowDefinitionByNameAndVersion(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionByNameAndVersion", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionByNameAndVersion), ctx, i)
 }
 
+// GetWorkflowDefinitionGraph mocks base method
+func (m *MockController) GetWorkflowDefinitionGraph(ctx context.Context, i *models.GetWorkflowDefinitionGraphInput) (*models.WorkflowGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowDefinitionGraph", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowDefinitionGraph indicates an expected call of GetWorkflowDefinitionGraph
+func (mr *MockControllerMockRecorder) GetWorkflowDefinitionGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowDefinitionGraph", reflect.TypeOf((*MockController)(nil).GetWorkflowDefinitionGraph), ctx, i)
+}
+
 // GetWorkflows mocks base method
 func (m *MockController) GetWorkflows(ctx context.Context, i *models.GetWorkflowsInput) ([]models.Workflow, string, error) {
 	ret := m.ctrl.Call(m, "GetWorkflows", ctx, i)
@@ -227,6 +406,44 @@ func (mr *MockControllerMockRecorder) ResumeWorkflowByID(ctx, i interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeWorkflowByID", reflect.TypeOf((*MockController)(nil).ResumeWorkflowByID), ctx, i)
 }
 
+// GetWorkflowChoices mocks base method
+func (m *MockController) GetWorkflowChoices(ctx context.Context, workflowID string) ([]models.ChoiceEvaluation, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowChoices", ctx, workflowID)
+	ret0, _ := ret[0].([]models.ChoiceEvaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowChoices indicates an expected call of GetWorkflowChoices
+func (mr *MockControllerMockRecorder) GetWorkflowChoices(ctx, workflowID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowChoices", reflect.TypeOf((*MockController)(nil).GetWorkflowChoices), ctx, workflowID)
+}
+
+// GetWorkflowEvents mocks base method
+func (m *MockController) GetWorkflowEvents(ctx context.Context, workflowID string) error {
+	ret := m.ctrl.Call(m, "GetWorkflowEvents", ctx, workflowID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWorkflowEvents indicates an expected call of GetWorkflowEvents
+func (mr *MockControllerMockRecorder) GetWorkflowEvents(ctx, workflowID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowEvents", reflect.TypeOf((*MockController)(nil).GetWorkflowEvents), ctx, workflowID)
+}
+
+// GetWorkflowGraph mocks base method
+func (m *MockController) GetWorkflowGraph(ctx context.Context, i *models.GetWorkflowGraphInput) (*models.WorkflowGraph, error) {
+	ret := m.ctrl.Call(m, "GetWorkflowGraph", ctx, i)
+	ret0, _ := ret[0].(*models.WorkflowGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowGraph indicates an expected call of GetWorkflowGraph
+func (mr *MockControllerMockRecorder) GetWorkflowGraph(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowGraph", reflect.TypeOf((*MockController)(nil).GetWorkflowGraph), ctx, i)
+}
+
 // ResolveWorkflowByID mocks base method
 func (m *MockController) ResolveWorkflowByID(ctx context.Context, workflowID string) error {
 	ret := m.ctrl.Call(m, "ResolveWorkflowByID", ctx, workflowID)
@@ -238,3 +455,15 @@ func (m *MockController) ResolveWorkflowByID(ctx context.Context, workflowID str
 func (mr *MockControllerMockRecorder) ResolveWorkflowByID(ctx, workflowID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWorkflowByID", reflect.TypeOf((*MockController)(nil).ResolveWorkflowByID), ctx, workflowID)
 }
+
+// SignalWorkflowState mocks base method
+func (m *MockController) SignalWorkflowState(ctx context.Context, i *models.SignalWorkflowStateInput) error {
+	ret := m.ctrl.Call(m, "SignalWorkflowState", ctx, i)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalWorkflowState indicates an expected call of SignalWorkflowState
+func (mr *MockControllerMockRecorder) SignalWorkflowState(ctx, i interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowState", reflect.TypeOf((*MockController)(nil).SignalWorkflowState), ctx, i)
+}
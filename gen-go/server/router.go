@@ -142,6 +142,27 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("POST").Path("/bulk-operations").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "startBulkOperation")
+		h.StartBulkOperationHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "startBulkOperation")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/bulk-operations/{operationID}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getBulkOperationByID")
+		h.GetBulkOperationByIDHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getBulkOperationByID")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/path-evaluations").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "evaluatePath")
+		h.EvaluatePathHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "evaluatePath")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("POST").Path("/state-resources").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "postStateResource")
 		h.PostStateResourceHandler(r.Context(), w, r)
@@ -170,6 +191,20 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workers").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkers")
+		h.GetWorkersHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkers")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("POST").Path("/workers").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "heartbeatWorker")
+		h.HeartbeatWorkerHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "heartbeatWorker")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflow-definitions").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitions")
 		h.GetWorkflowDefinitionsHandler(r.Context(), w, r)
@@ -198,6 +233,62 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workflow-definitions/{name}/aliases").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionAliases")
+		h.GetWorkflowDefinitionAliasesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionAliases")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/workflow-definitions/{name}/aliases/{alias}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "deleteWorkflowDefinitionAlias")
+		h.DeleteWorkflowDefinitionAliasHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "deleteWorkflowDefinitionAlias")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("PUT").Path("/workflow-definitions/{name}/aliases/{alias}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "setWorkflowDefinitionAlias")
+		h.SetWorkflowDefinitionAliasHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "setWorkflowDefinitionAlias")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/diff").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionDiff")
+		h.GetWorkflowDefinitionDiffHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionDiff")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/events").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionEvents")
+		h.GetWorkflowDefinitionEventsHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionEvents")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("DELETE").Path("/workflow-definitions/{name}/rollout").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "deleteWorkflowDefinitionRollout")
+		h.DeleteWorkflowDefinitionRolloutHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "deleteWorkflowDefinitionRollout")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflow-definitions/{name}/rollout").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionRollout")
+		h.GetWorkflowDefinitionRolloutHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionRollout")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("PUT").Path("/workflow-definitions/{name}/rollout").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "setWorkflowDefinitionRollout")
+		h.SetWorkflowDefinitionRolloutHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "setWorkflowDefinitionRollout")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflow-definitions/{name}/{version}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionByNameAndVersion")
 		h.GetWorkflowDefinitionByNameAndVersionHandler(r.Context(), w, r)
@@ -205,6 +296,13 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workflow-definitions/{name}/{version}/graph").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowDefinitionGraph")
+		h.GetWorkflowDefinitionGraphHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowDefinitionGraph")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("GET").Path("/workflows").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "getWorkflows")
 		h.GetWorkflowsHandler(r.Context(), w, r)
@@ -240,6 +338,27 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("GET").Path("/workflows/{workflowID}/choices").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowChoices")
+		h.GetWorkflowChoicesHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowChoices")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflows/{workflowID}/events").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowEvents")
+		h.GetWorkflowEventsHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowEvents")
+		r = r.WithContext(ctx)
+	})
+
+	router.Methods("GET").Path("/workflows/{workflowID}/graph").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "getWorkflowGraph")
+		h.GetWorkflowGraphHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "getWorkflowGraph")
+		r = r.WithContext(ctx)
+	})
+
 	router.Methods("POST").Path("/workflows/{workflowID}/resolved").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger.FromContext(r.Context()).AddContext("op", "resolveWorkflowByID")
 		h.ResolveWorkflowByIDHandler(r.Context(), w, r)
@@ -247,6 +366,13 @@ func NewWithMiddleware(c Controller, addr string, m []func(http.Handler) http.Ha
 		r = r.WithContext(ctx)
 	})
 
+	router.Methods("POST").Path("/workflows/{workflowID}/signals/{state}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.FromContext(r.Context()).AddContext("op", "signalWorkflowState")
+		h.SignalWorkflowStateHandler(r.Context(), w, r)
+		ctx := WithTracingOpName(r.Context(), "signalWorkflowState")
+		r = r.WithContext(ctx)
+	})
+
 	handler := withMiddleware("workflow-manager", router, m)
 	return &Server{Handler: handler, addr: addr, l: l}
 }
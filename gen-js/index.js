@@ -354,7 +354,7 @@ class WorkflowManager {
   }
 
   /**
-   * @param NewStateResource
+   * @param BulkOperationRequest
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -366,12 +366,12 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  postStateResource(NewStateResource, options, cb) {
-    return this._hystrixCommand.execute(this._postStateResource, arguments);
+  startBulkOperation(BulkOperationRequest, options, cb) {
+    return this._hystrixCommand.execute(this._startBulkOperation, arguments);
   }
-  _postStateResource(NewStateResource, options, cb) {
+  _startBulkOperation(BulkOperationRequest, options, cb) {
     const params = {};
-    params["NewStateResource"] = NewStateResource;
+    params["BulkOperationRequest"] = BulkOperationRequest;
 
     if (!cb && typeof options === "function") {
       cb = options;
@@ -408,13 +408,13 @@ class WorkflowManager {
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("POST /state-resources");
+        span.logEvent("POST /bulk-operations");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
         method: "POST",
-        uri: this.address + "/state-resources",
+        uri: this.address + "/bulk-operations",
         json: true,
         timeout,
         headers,
@@ -425,7 +425,7 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
-      requestOptions.body = params.NewStateResource;
+      requestOptions.body = params.BulkOperationRequest;
   
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
@@ -477,25 +477,26 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {string} params.namespace
-   * @param {string} params.name
+   * @param {string} operationID
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
    * @param {function} [cb]
    * @returns {Promise}
-   * @fulfill {undefined}
+   * @fulfill {Object}
    * @reject {module:workflow-manager.Errors.BadRequest}
    * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  deleteStateResource(params, options, cb) {
-    return this._hystrixCommand.execute(this._deleteStateResource, arguments);
+  getBulkOperationByID(operationID, options, cb) {
+    return this._hystrixCommand.execute(this._getBulkOperationByID, arguments);
   }
-  _deleteStateResource(params, options, cb) {
+  _getBulkOperationByID(operationID, options, cb) {
+    const params = {};
+    params["operationID"] = operationID;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -525,12 +526,8 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
-      if (!params.namespace) {
-        rejecter(new Error("namespace must be non-empty because it's a path parameter"));
-        return;
-      }
-      if (!params.name) {
-        rejecter(new Error("name must be non-empty because it's a path parameter"));
+      if (!params.operationID) {
+        rejecter(new Error("operationID must be non-empty because it's a path parameter"));
         return;
       }
 
@@ -539,13 +536,13 @@ class WorkflowManager {
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("DELETE /state-resources/{namespace}/{name}");
+        span.logEvent("GET /bulk-operations/{operationID}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "DELETE",
-        uri: this.address + "/state-resources/" + params.namespace + "/" + params.name + "",
+        method: "GET",
+        uri: this.address + "/bulk-operations/" + params.operationID + "",
         json: true,
         timeout,
         headers,
@@ -579,7 +576,7 @@ class WorkflowManager {
 
           switch (response.statusCode) {
             case 200:
-              resolver();
+              resolver(body);
               break;
             
             case 400:
@@ -612,9 +609,7 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {string} params.namespace
-   * @param {string} params.name
+   * @param PathEvaluationRequest
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -627,10 +622,13 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  getStateResource(params, options, cb) {
-    return this._hystrixCommand.execute(this._getStateResource, arguments);
+  evaluatePath(PathEvaluationRequest, options, cb) {
+    return this._hystrixCommand.execute(this._evaluatePath, arguments);
   }
-  _getStateResource(params, options, cb) {
+  _evaluatePath(PathEvaluationRequest, options, cb) {
+    const params = {};
+    params["PathEvaluationRequest"] = PathEvaluationRequest;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -660,27 +658,19 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
-      if (!params.namespace) {
-        rejecter(new Error("namespace must be non-empty because it's a path parameter"));
-        return;
-      }
-      if (!params.name) {
-        rejecter(new Error("name must be non-empty because it's a path parameter"));
-        return;
-      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("GET /state-resources/{namespace}/{name}");
+        span.logEvent("POST /path-evaluations");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "GET",
-        uri: this.address + "/state-resources/" + params.namespace + "/" + params.name + "",
+        method: "POST",
+        uri: this.address + "/path-evaluations",
         json: true,
         timeout,
         headers,
@@ -691,6 +681,8 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
+      requestOptions.body = params.PathEvaluationRequest;
+  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -747,10 +739,7 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {string} params.namespace
-   * @param {string} params.name
-   * @param [params.NewStateResource]
+   * @param NewStateResource
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -762,10 +751,13 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  putStateResource(params, options, cb) {
-    return this._hystrixCommand.execute(this._putStateResource, arguments);
+  postStateResource(NewStateResource, options, cb) {
+    return this._hystrixCommand.execute(this._postStateResource, arguments);
   }
-  _putStateResource(params, options, cb) {
+  _postStateResource(NewStateResource, options, cb) {
+    const params = {};
+    params["NewStateResource"] = NewStateResource;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -795,27 +787,19 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
-      if (!params.namespace) {
-        rejecter(new Error("namespace must be non-empty because it's a path parameter"));
-        return;
-      }
-      if (!params.name) {
-        rejecter(new Error("name must be non-empty because it's a path parameter"));
-        return;
-      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("PUT /state-resources/{namespace}/{name}");
+        span.logEvent("POST /state-resources");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "PUT",
-        uri: this.address + "/state-resources/" + params.namespace + "/" + params.name + "",
+        method: "POST",
+        uri: this.address + "/state-resources",
         json: true,
         timeout,
         headers,
@@ -878,24 +862,25 @@ class WorkflowManager {
   }
 
   /**
-   * Get the latest versions of all available WorkflowDefinitions
+   * @param {Object} params
+   * @param {string} params.namespace
+   * @param {string} params.name
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
    * @param {function} [cb]
    * @returns {Promise}
-   * @fulfill {Object[]}
+   * @fulfill {undefined}
    * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  getWorkflowDefinitions(options, cb) {
-    return this._hystrixCommand.execute(this._getWorkflowDefinitions, arguments);
+  deleteStateResource(params, options, cb) {
+    return this._hystrixCommand.execute(this._deleteStateResource, arguments);
   }
-  _getWorkflowDefinitions(options, cb) {
-    const params = {};
-
+  _deleteStateResource(params, options, cb) {
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -925,19 +910,27 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
+      if (!params.namespace) {
+        rejecter(new Error("namespace must be non-empty because it's a path parameter"));
+        return;
+      }
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("GET /workflow-definitions");
+        span.logEvent("DELETE /state-resources/{namespace}/{name}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "GET",
-        uri: this.address + "/workflow-definitions",
+        method: "DELETE",
+        uri: this.address + "/state-resources/" + params.namespace + "/" + params.name + "",
         json: true,
         timeout,
         headers,
@@ -971,7 +964,7 @@ class WorkflowManager {
 
           switch (response.statusCode) {
             case 200:
-              resolver(body);
+              resolver();
               break;
             
             case 400:
@@ -980,6 +973,12 @@ class WorkflowManager {
               rejecter(err);
               return;
             
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
@@ -998,7 +997,9 @@ class WorkflowManager {
   }
 
   /**
-   * @param NewWorkflowDefinitionRequest
+   * @param {Object} params
+   * @param {string} params.namespace
+   * @param {string} params.name
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -1007,16 +1008,14 @@ class WorkflowManager {
    * @returns {Promise}
    * @fulfill {Object}
    * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  newWorkflowDefinition(NewWorkflowDefinitionRequest, options, cb) {
-    return this._hystrixCommand.execute(this._newWorkflowDefinition, arguments);
+  getStateResource(params, options, cb) {
+    return this._hystrixCommand.execute(this._getStateResource, arguments);
   }
-  _newWorkflowDefinition(NewWorkflowDefinitionRequest, options, cb) {
-    const params = {};
-    params["NewWorkflowDefinitionRequest"] = NewWorkflowDefinitionRequest;
-
+  _getStateResource(params, options, cb) {
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -1046,19 +1045,27 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
+      if (!params.namespace) {
+        rejecter(new Error("namespace must be non-empty because it's a path parameter"));
+        return;
+      }
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("POST /workflow-definitions");
+        span.logEvent("GET /state-resources/{namespace}/{name}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "POST",
-        uri: this.address + "/workflow-definitions",
+        method: "GET",
+        uri: this.address + "/state-resources/" + params.namespace + "/" + params.name + "",
         json: true,
         timeout,
         headers,
@@ -1069,8 +1076,6 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
-      requestOptions.body = params.NewWorkflowDefinitionRequest;
-  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -1093,7 +1098,7 @@ class WorkflowManager {
           }
 
           switch (response.statusCode) {
-            case 201:
+            case 200:
               resolver(body);
               break;
             
@@ -1103,6 +1108,12 @@ class WorkflowManager {
               rejecter(err);
               return;
             
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
@@ -1122,24 +1133,24 @@ class WorkflowManager {
 
   /**
    * @param {Object} params
+   * @param {string} params.namespace
    * @param {string} params.name
-   * @param {boolean} [params.latest=true]
+   * @param [params.NewStateResource]
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
    * @param {function} [cb]
    * @returns {Promise}
-   * @fulfill {Object[]}
+   * @fulfill {Object}
    * @reject {module:workflow-manager.Errors.BadRequest}
-   * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  getWorkflowDefinitionVersionsByName(params, options, cb) {
-    return this._hystrixCommand.execute(this._getWorkflowDefinitionVersionsByName, arguments);
+  putStateResource(params, options, cb) {
+    return this._hystrixCommand.execute(this._putStateResource, arguments);
   }
-  _getWorkflowDefinitionVersionsByName(params, options, cb) {
+  _putStateResource(params, options, cb) {
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -1169,27 +1180,27 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
+      if (!params.namespace) {
+        rejecter(new Error("namespace must be non-empty because it's a path parameter"));
+        return;
+      }
       if (!params.name) {
         rejecter(new Error("name must be non-empty because it's a path parameter"));
         return;
       }
 
       const query = {};
-      if (typeof params.latest !== "undefined") {
-        query["latest"] = params.latest;
-      }
-  
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("GET /workflow-definitions/{name}");
+        span.logEvent("PUT /state-resources/{namespace}/{name}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "GET",
-        uri: this.address + "/workflow-definitions/" + params.name + "",
+        method: "PUT",
+        uri: this.address + "/state-resources/" + params.namespace + "/" + params.name + "",
         json: true,
         timeout,
         headers,
@@ -1200,6 +1211,8 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
+      requestOptions.body = params.NewStateResource;
+  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -1222,7 +1235,7 @@ class WorkflowManager {
           }
 
           switch (response.statusCode) {
-            case 200:
+            case 201:
               resolver(body);
               break;
             
@@ -1232,12 +1245,6 @@ class WorkflowManager {
               rejecter(err);
               return;
             
-            case 404:
-              var err = new Errors.NotFound(body || {});
-              responseLog(logger, requestOptions, response, err);
-              rejecter(err);
-              return;
-            
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
@@ -1257,24 +1264,23 @@ class WorkflowManager {
 
   /**
    * @param {Object} params
-   * @param [params.NewWorkflowDefinitionRequest]
-   * @param {string} params.name
+   * @param {string} [params.namespace]
+   * @param {string} [params.resource]
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
    * @param {function} [cb]
    * @returns {Promise}
-   * @fulfill {Object}
+   * @fulfill {Object[]}
    * @reject {module:workflow-manager.Errors.BadRequest}
-   * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  updateWorkflowDefinition(params, options, cb) {
-    return this._hystrixCommand.execute(this._updateWorkflowDefinition, arguments);
+  getWorkers(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkers, arguments);
   }
-  _updateWorkflowDefinition(params, options, cb) {
+  _getWorkers(params, options, cb) {
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -1304,23 +1310,27 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
-      if (!params.name) {
-        rejecter(new Error("name must be non-empty because it's a path parameter"));
-        return;
-      }
 
       const query = {};
+      if (typeof params.namespace !== "undefined") {
+        query["namespace"] = params.namespace;
+      }
+  
+      if (typeof params.resource !== "undefined") {
+        query["resource"] = params.resource;
+      }
+  
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("PUT /workflow-definitions/{name}");
+        span.logEvent("GET /workers");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "PUT",
-        uri: this.address + "/workflow-definitions/" + params.name + "",
+        method: "GET",
+        uri: this.address + "/workers",
         json: true,
         timeout,
         headers,
@@ -1331,8 +1341,6 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
-      requestOptions.body = params.NewWorkflowDefinitionRequest;
-  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -1355,7 +1363,7 @@ class WorkflowManager {
           }
 
           switch (response.statusCode) {
-            case 201:
+            case 200:
               resolver(body);
               break;
             
@@ -1365,12 +1373,6 @@ class WorkflowManager {
               rejecter(err);
               return;
             
-            case 404:
-              var err = new Errors.NotFound(body || {});
-              responseLog(logger, requestOptions, response, err);
-              rejecter(err);
-              return;
-            
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
@@ -1389,9 +1391,7 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {string} params.name
-   * @param {number} params.version
+   * @param WorkerHeartbeat
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -1400,14 +1400,16 @@ class WorkflowManager {
    * @returns {Promise}
    * @fulfill {Object}
    * @reject {module:workflow-manager.Errors.BadRequest}
-   * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  getWorkflowDefinitionByNameAndVersion(params, options, cb) {
-    return this._hystrixCommand.execute(this._getWorkflowDefinitionByNameAndVersion, arguments);
+  heartbeatWorker(WorkerHeartbeat, options, cb) {
+    return this._hystrixCommand.execute(this._heartbeatWorker, arguments);
   }
-  _getWorkflowDefinitionByNameAndVersion(params, options, cb) {
+  _heartbeatWorker(WorkerHeartbeat, options, cb) {
+    const params = {};
+    params["WorkerHeartbeat"] = WorkerHeartbeat;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -1437,27 +1439,19 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
-      if (!params.name) {
-        rejecter(new Error("name must be non-empty because it's a path parameter"));
-        return;
-      }
-      if (!params.version) {
-        rejecter(new Error("version must be non-empty because it's a path parameter"));
-        return;
-      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("GET /workflow-definitions/{name}/{version}");
+        span.logEvent("POST /workers");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "GET",
-        uri: this.address + "/workflow-definitions/" + params.name + "/" + params.version + "",
+        method: "POST",
+        uri: this.address + "/workers",
         json: true,
         timeout,
         headers,
@@ -1468,6 +1462,8 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
+      requestOptions.body = params.WorkerHeartbeat;
+  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -1500,12 +1496,6 @@ class WorkflowManager {
               rejecter(err);
               return;
             
-            case 404:
-              var err = new Errors.NotFound(body || {});
-              responseLog(logger, requestOptions, response, err);
-              rejecter(err);
-              return;
-            
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
@@ -1524,14 +1514,252 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {number} [params.limit=10] - Maximum number of workflows to return. Defaults to 10. Restricted to a max of 10,000.
-   * @param {boolean} [params.oldestFirst]
-   * @param {string} [params.pageToken]
-   * @param {string} [params.status] - The status of the workflow (queued, running, etc.). Cannot be sent in the same request as the resolvedByUser parameter.
-   * @param {boolean} [params.resolvedByUser] - A flag that indicates whether the workflow has been marked resolved by a user. Cannot be sent in the same request as the status parameter.
-   * @param {boolean} [params.summaryOnly] - Limits workflow data to the bare minimum - omits the full workflow definition and job data.
-   * @param {string} params.workflowDefinitionName
+   * Get the latest versions of all available WorkflowDefinitions
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object[]}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitions(options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitions, arguments);
+  }
+  _getWorkflowDefinitions(options, cb) {
+    const params = {};
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param NewWorkflowDefinitionRequest
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  newWorkflowDefinition(NewWorkflowDefinitionRequest, options, cb) {
+    return this._hystrixCommand.execute(this._newWorkflowDefinition, arguments);
+  }
+  _newWorkflowDefinition(NewWorkflowDefinitionRequest, options, cb) {
+    const params = {};
+    params["NewWorkflowDefinitionRequest"] = NewWorkflowDefinitionRequest;
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("POST /workflow-definitions");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "POST",
+        uri: this.address + "/workflow-definitions",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+      requestOptions.body = params.NewWorkflowDefinitionRequest;
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 201:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param {boolean} [params.latest=true]
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -1544,10 +1772,2231 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  getWorkflows(params, options, cb) {
-    return this._hystrixCommand.execute(this._getWorkflows, arguments);
+  getWorkflowDefinitionVersionsByName(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionVersionsByName, arguments);
+  }
+  _getWorkflowDefinitionVersionsByName(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+      if (typeof params.latest !== "undefined") {
+        query["latest"] = params.latest;
+      }
+  
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param [params.NewWorkflowDefinitionRequest]
+   * @param {string} params.name
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  updateWorkflowDefinition(params, options, cb) {
+    return this._hystrixCommand.execute(this._updateWorkflowDefinition, arguments);
+  }
+  _updateWorkflowDefinition(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("PUT /workflow-definitions/{name}");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "PUT",
+        uri: this.address + "/workflow-definitions/" + params.name + "",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+      requestOptions.body = params.NewWorkflowDefinitionRequest;
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 201:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {string} name
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object[]}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitionAliases(name, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionAliases, arguments);
+  }
+  _getWorkflowDefinitionAliases(name, options, cb) {
+    const params = {};
+    params["name"] = name;
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}/aliases");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "/aliases",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param {string} params.alias
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  deleteWorkflowDefinitionAlias(params, options, cb) {
+    return this._hystrixCommand.execute(this._deleteWorkflowDefinitionAlias, arguments);
+  }
+  _deleteWorkflowDefinitionAlias(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+      if (!params.alias) {
+        rejecter(new Error("alias must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("DELETE /workflow-definitions/{name}/aliases/{alias}");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "DELETE",
+        uri: this.address + "/workflow-definitions/" + params.name + "/aliases/" + params.alias + "",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver();
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param {string} params.alias
+   * @param [params.SetWorkflowDefinitionAliasRequest]
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  setWorkflowDefinitionAlias(params, options, cb) {
+    return this._hystrixCommand.execute(this._setWorkflowDefinitionAlias, arguments);
+  }
+  _setWorkflowDefinitionAlias(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+      if (!params.alias) {
+        rejecter(new Error("alias must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("PUT /workflow-definitions/{name}/aliases/{alias}");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "PUT",
+        uri: this.address + "/workflow-definitions/" + params.name + "/aliases/" + params.alias + "",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+      requestOptions.body = params.SetWorkflowDefinitionAliasRequest;
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param {number} params.from
+   * @param {number} params.to
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitionDiff(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionDiff, arguments);
+  }
+  _getWorkflowDefinitionDiff(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+      query["from"] = params.from;
+  
+      query["to"] = params.to;
+  
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}/diff");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "/diff",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {string} name
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitionEvents(name, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionEvents, arguments);
+  }
+  _getWorkflowDefinitionEvents(name, options, cb) {
+    const params = {};
+    params["name"] = name;
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}/events");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "/events",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver();
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {string} name
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  deleteWorkflowDefinitionRollout(name, options, cb) {
+    return this._hystrixCommand.execute(this._deleteWorkflowDefinitionRollout, arguments);
+  }
+  _deleteWorkflowDefinitionRollout(name, options, cb) {
+    const params = {};
+    params["name"] = name;
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("DELETE /workflow-definitions/{name}/rollout");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "DELETE",
+        uri: this.address + "/workflow-definitions/" + params.name + "/rollout",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver();
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {string} name
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitionRollout(name, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionRollout, arguments);
+  }
+  _getWorkflowDefinitionRollout(name, options, cb) {
+    const params = {};
+    params["name"] = name;
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}/rollout");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "/rollout",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param [params.WorkflowDefinitionRollout]
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  setWorkflowDefinitionRollout(params, options, cb) {
+    return this._hystrixCommand.execute(this._setWorkflowDefinitionRollout, arguments);
+  }
+  _setWorkflowDefinitionRollout(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("PUT /workflow-definitions/{name}/rollout");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "PUT",
+        uri: this.address + "/workflow-definitions/" + params.name + "/rollout",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+      requestOptions.body = params.WorkflowDefinitionRollout;
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param {number} params.version
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitionByNameAndVersion(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionByNameAndVersion, arguments);
+  }
+  _getWorkflowDefinitionByNameAndVersion(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+      if (!params.version) {
+        rejecter(new Error("version must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}/{version}");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "/" + params.version + "",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.name
+   * @param {number} params.version
+   * @param {string} [params.format]
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowDefinitionGraph(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowDefinitionGraph, arguments);
+  }
+  _getWorkflowDefinitionGraph(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.name) {
+        rejecter(new Error("name must be non-empty because it's a path parameter"));
+        return;
+      }
+      if (!params.version) {
+        rejecter(new Error("version must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+      if (typeof params.format !== "undefined") {
+        query["format"] = params.format;
+      }
+  
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflow-definitions/{name}/{version}/graph");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflow-definitions/" + params.name + "/" + params.version + "/graph",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {number} [params.limit=10] - Maximum number of workflows to return. Defaults to 10. Restricted to a max of 10,000.
+   * @param {boolean} [params.oldestFirst]
+   * @param {string} [params.pageToken]
+   * @param {string} [params.status] - The status of the workflow (queued, running, etc.). Cannot be sent in the same request as the resolvedByUser parameter.
+   * @param {boolean} [params.resolvedByUser] - A flag that indicates whether the workflow has been marked resolved by a user. Cannot be sent in the same request as the status parameter.
+   * @param {boolean} [params.summaryOnly] - Limits workflow data to the bare minimum - omits the full workflow definition and job data.
+   * @param {string} params.workflowDefinitionName
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object[]}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflows(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflows, arguments);
+  }
+  _getWorkflows(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+
+      const query = {};
+      if (typeof params.limit !== "undefined") {
+        query["limit"] = params.limit;
+      }
+  
+      if (typeof params.oldestFirst !== "undefined") {
+        query["oldestFirst"] = params.oldestFirst;
+      }
+  
+      if (typeof params.pageToken !== "undefined") {
+        query["pageToken"] = params.pageToken;
+      }
+  
+      if (typeof params.status !== "undefined") {
+        query["status"] = params.status;
+      }
+  
+      if (typeof params.resolvedByUser !== "undefined") {
+        query["resolvedByUser"] = params.resolvedByUser;
+      }
+  
+      if (typeof params.summaryOnly !== "undefined") {
+        query["summaryOnly"] = params.summaryOnly;
+      }
+  
+      query["workflowDefinitionName"] = params.workflowDefinitionName;
+  
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("GET /workflows");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflows",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+
+  /**
+   * @param {Object} params
+   * @param {number} [params.limit=10] - Maximum number of workflows to return. Defaults to 10. Restricted to a max of 10,000.
+   * @param {boolean} [params.oldestFirst]
+   * @param {string} [params.pageToken]
+   * @param {string} [params.status] - The status of the workflow (queued, running, etc.). Cannot be sent in the same request as the resolvedByUser parameter.
+   * @param {boolean} [params.resolvedByUser] - A flag that indicates whether the workflow has been marked resolved by a user. Cannot be sent in the same request as the status parameter.
+   * @param {boolean} [params.summaryOnly] - Limits workflow data to the bare minimum - omits the full workflow definition and job data.
+   * @param {string} params.workflowDefinitionName
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @returns {Object} iter
+   * @returns {function} iter.map - takes in a function, applies it to each resource, and returns a promise to the result as an array
+   * @returns {function} iter.toArray - returns a promise to the resources as an array
+   * @returns {function} iter.forEach - takes in a function, applies it to each resource
+   */
+  getWorkflowsIter(params, options) {
+    const it = (f, saveResults, cb) => new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+
+      const query = {};
+      if (typeof params.limit !== "undefined") {
+        query["limit"] = params.limit;
+      }
+  
+      if (typeof params.oldestFirst !== "undefined") {
+        query["oldestFirst"] = params.oldestFirst;
+      }
+  
+      if (typeof params.pageToken !== "undefined") {
+        query["pageToken"] = params.pageToken;
+      }
+  
+      if (typeof params.status !== "undefined") {
+        query["status"] = params.status;
+      }
+  
+      if (typeof params.resolvedByUser !== "undefined") {
+        query["resolvedByUser"] = params.resolvedByUser;
+      }
+  
+      if (typeof params.summaryOnly !== "undefined") {
+        query["summaryOnly"] = params.summaryOnly;
+      }
+  
+      query["workflowDefinitionName"] = params.workflowDefinitionName;
+  
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "GET",
+        uri: this.address + "/workflows",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let results = [];
+      async.whilst(
+        () => requestOptions.uri !== "",
+        cbW => {
+          if (span) {
+            span.logEvent("GET /workflows");
+          }
+      const address = this.address;
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            cbW(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              if (saveResults) {
+                results = results.concat(body.map(f));
+              } else {
+                body.forEach(f);
+              }
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              cbW(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              cbW(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              cbW(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              cbW(err);
+              return;
+          }
+
+          requestOptions.qs = null;
+          requestOptions.useQuerystring = false;
+          requestOptions.uri = "";
+          if (response.headers["x-next-page-path"]) {
+            requestOptions.uri = address + response.headers["x-next-page-path"];
+          }
+          cbW();
+        });
+      }());
+        },
+        err => {
+          if (err) {
+            rejecter(err);
+            return;
+          }
+          if (saveResults) {
+            resolver(results);
+          } else {
+            resolver();
+          }
+        }
+      );
+    });
+
+    return {
+      map: (f, cb) => this._hystrixCommand.execute(it, [f, true, cb]),
+      toArray: cb => this._hystrixCommand.execute(it, [x => x, true, cb]),
+      forEach: (f, cb) => this._hystrixCommand.execute(it, [f, false, cb]),
+    };
+  }
+
+  /**
+   * @param StartWorkflowRequest - Parameters for starting a workflow (workflow definition, input, and optionally namespace, queue, and tags)
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  startWorkflow(StartWorkflowRequest, options, cb) {
+    return this._hystrixCommand.execute(this._startWorkflow, arguments);
+  }
+  _startWorkflow(StartWorkflowRequest, options, cb) {
+    const params = {};
+    params["StartWorkflowRequest"] = StartWorkflowRequest;
+
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("POST /workflows");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "POST",
+        uri: this.address + "/workflows",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+      requestOptions.body = params.StartWorkflowRequest;
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver(body);
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {Object} params
+   * @param {string} params.workflowID
+   * @param params.reason
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {undefined}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  CancelWorkflow(params, options, cb) {
+    return this._hystrixCommand.execute(this._CancelWorkflow, arguments);
+  }
+  _CancelWorkflow(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
+      const rejecter = (err) => {
+        reject(err);
+        if (cb) {
+          cb(err);
+        }
+      };
+      const resolver = (data) => {
+        resolve(data);
+        if (cb) {
+          cb(null, data);
+        }
+      };
+
+
+      if (!options) {
+        options = {};
+      }
+
+      const timeout = options.timeout || this.timeout;
+      const tracer = options.tracer || this.tracer;
+      const span = options.span;
+
+      const headers = {};
+      if (!params.workflowID) {
+        rejecter(new Error("workflowID must be non-empty because it's a path parameter"));
+        return;
+      }
+
+      const query = {};
+
+      if (span) {
+        // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
+        tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("DELETE /workflows/{workflowID}");
+        span.setTag("span.kind", "client");
+      }
+
+	  const requestOptions = {
+        method: "DELETE",
+        uri: this.address + "/workflows/" + params.workflowID + "",
+        json: true,
+        timeout,
+        headers,
+        qs: query,
+        useQuerystring: true,
+      };
+      if (this.keepalive) {
+        requestOptions.forever = true;
+      }
+  
+      requestOptions.body = params.reason;
+  
+
+      const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
+      const backoffs = retryPolicy.backoffs();
+      const logger = this.logger;
+  
+      let retries = 0;
+      (function requestOnce() {
+        request(requestOptions, (err, response, body) => {
+          if (retries < backoffs.length && retryPolicy.retry(requestOptions, err, response, body)) {
+            const backoff = backoffs[retries];
+            retries += 1;
+            setTimeout(requestOnce, backoff);
+            return;
+          }
+          if (err) {
+            err._fromRequest = true;
+            responseLog(logger, requestOptions, response, err)
+            rejecter(err);
+            return;
+          }
+
+          switch (response.statusCode) {
+            case 200:
+              resolver();
+              break;
+            
+            case 400:
+              var err = new Errors.BadRequest(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 404:
+              var err = new Errors.NotFound(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            case 500:
+              var err = new Errors.InternalError(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
+            default:
+              var err = new Error("Received unexpected statusCode " + response.statusCode);
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+          }
+        });
+      }());
+    });
+  }
+
+  /**
+   * @param {string} workflowID
+   * @param {object} [options]
+   * @param {number} [options.timeout] - A request specific timeout
+   * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
+   * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
+   */
+  getWorkflowByID(workflowID, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowByID, arguments);
   }
-  _getWorkflows(params, options, cb) {
+  _getWorkflowByID(workflowID, options, cb) {
+    const params = {};
+    params["workflowID"] = workflowID;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -1577,45 +4026,23 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
+      if (!params.workflowID) {
+        rejecter(new Error("workflowID must be non-empty because it's a path parameter"));
+        return;
+      }
 
       const query = {};
-      if (typeof params.limit !== "undefined") {
-        query["limit"] = params.limit;
-      }
-  
-      if (typeof params.oldestFirst !== "undefined") {
-        query["oldestFirst"] = params.oldestFirst;
-      }
-  
-      if (typeof params.pageToken !== "undefined") {
-        query["pageToken"] = params.pageToken;
-      }
-  
-      if (typeof params.status !== "undefined") {
-        query["status"] = params.status;
-      }
-  
-      if (typeof params.resolvedByUser !== "undefined") {
-        query["resolvedByUser"] = params.resolvedByUser;
-      }
-  
-      if (typeof params.summaryOnly !== "undefined") {
-        query["summaryOnly"] = params.summaryOnly;
-      }
-  
-      query["workflowDefinitionName"] = params.workflowDefinitionName;
-  
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("GET /workflows");
+        span.logEvent("GET /workflows/{workflowID}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
         method: "GET",
-        uri: this.address + "/workflows",
+        uri: this.address + "/workflows/" + params.workflowID + "",
         json: true,
         timeout,
         headers,
@@ -1681,27 +4108,32 @@ class WorkflowManager {
     });
   }
 
-
   /**
    * @param {Object} params
-   * @param {number} [params.limit=10] - Maximum number of workflows to return. Defaults to 10. Restricted to a max of 10,000.
-   * @param {boolean} [params.oldestFirst]
-   * @param {string} [params.pageToken]
-   * @param {string} [params.status] - The status of the workflow (queued, running, etc.). Cannot be sent in the same request as the resolvedByUser parameter.
-   * @param {boolean} [params.resolvedByUser] - A flag that indicates whether the workflow has been marked resolved by a user. Cannot be sent in the same request as the status parameter.
-   * @param {boolean} [params.summaryOnly] - Limits workflow data to the bare minimum - omits the full workflow definition and job data.
-   * @param {string} params.workflowDefinitionName
+   * @param {string} params.workflowID
+   * @param params.overrides
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
-   * @returns {Object} iter
-   * @returns {function} iter.map - takes in a function, applies it to each resource, and returns a promise to the result as an array
-   * @returns {function} iter.toArray - returns a promise to the resources as an array
-   * @returns {function} iter.forEach - takes in a function, applies it to each resource
+   * @param {function} [cb]
+   * @returns {Promise}
+   * @fulfill {Object}
+   * @reject {module:workflow-manager.Errors.BadRequest}
+   * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.InternalError}
+   * @reject {Error}
    */
-  getWorkflowsIter(params, options) {
-    const it = (f, saveResults, cb) => new Promise((resolve, reject) => {
+  resumeWorkflowByID(params, options, cb) {
+    return this._hystrixCommand.execute(this._resumeWorkflowByID, arguments);
+  }
+  _resumeWorkflowByID(params, options, cb) {
+    if (!cb && typeof options === "function") {
+      cb = options;
+      options = undefined;
+    }
+
+    return new Promise((resolve, reject) => {
       const rejecter = (err) => {
         reject(err);
         if (cb) {
@@ -1725,44 +4157,23 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
+      if (!params.workflowID) {
+        rejecter(new Error("workflowID must be non-empty because it's a path parameter"));
+        return;
+      }
 
       const query = {};
-      if (typeof params.limit !== "undefined") {
-        query["limit"] = params.limit;
-      }
-  
-      if (typeof params.oldestFirst !== "undefined") {
-        query["oldestFirst"] = params.oldestFirst;
-      }
-  
-      if (typeof params.pageToken !== "undefined") {
-        query["pageToken"] = params.pageToken;
-      }
-  
-      if (typeof params.status !== "undefined") {
-        query["status"] = params.status;
-      }
-  
-      if (typeof params.resolvedByUser !== "undefined") {
-        query["resolvedByUser"] = params.resolvedByUser;
-      }
-  
-      if (typeof params.summaryOnly !== "undefined") {
-        query["summaryOnly"] = params.summaryOnly;
-      }
-  
-      query["workflowDefinitionName"] = params.workflowDefinitionName;
-  
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
+        span.logEvent("POST /workflows/{workflowID}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "GET",
-        uri: this.address + "/workflows",
+        method: "POST",
+        uri: this.address + "/workflows/" + params.workflowID + "",
         json: true,
         timeout,
         headers,
@@ -1773,19 +4184,13 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
+      requestOptions.body = params.overrides;
+  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
       const logger = this.logger;
   
-      let results = [];
-      async.whilst(
-        () => requestOptions.uri !== "",
-        cbW => {
-          if (span) {
-            span.logEvent("GET /workflows");
-          }
-      const address = this.address;
       let retries = 0;
       (function requestOnce() {
         request(requestOptions, (err, response, body) => {
@@ -1798,95 +4203,64 @@ class WorkflowManager {
           if (err) {
             err._fromRequest = true;
             responseLog(logger, requestOptions, response, err)
-            cbW(err);
+            rejecter(err);
             return;
           }
 
           switch (response.statusCode) {
             case 200:
-              if (saveResults) {
-                results = results.concat(body.map(f));
-              } else {
-                body.forEach(f);
-              }
+              resolver(body);
               break;
             
             case 400:
               var err = new Errors.BadRequest(body || {});
               responseLog(logger, requestOptions, response, err);
-              cbW(err);
+              rejecter(err);
               return;
             
             case 404:
               var err = new Errors.NotFound(body || {});
               responseLog(logger, requestOptions, response, err);
-              cbW(err);
+              rejecter(err);
               return;
             
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
-              cbW(err);
+              rejecter(err);
               return;
             
             default:
               var err = new Error("Received unexpected statusCode " + response.statusCode);
               responseLog(logger, requestOptions, response, err);
-              cbW(err);
+              rejecter(err);
               return;
           }
-
-          requestOptions.qs = null;
-          requestOptions.useQuerystring = false;
-          requestOptions.uri = "";
-          if (response.headers["x-next-page-path"]) {
-            requestOptions.uri = address + response.headers["x-next-page-path"];
-          }
-          cbW();
         });
       }());
-        },
-        err => {
-          if (err) {
-            rejecter(err);
-            return;
-          }
-          if (saveResults) {
-            resolver(results);
-          } else {
-            resolver();
-          }
-        }
-      );
     });
-
-    return {
-      map: (f, cb) => this._hystrixCommand.execute(it, [f, true, cb]),
-      toArray: cb => this._hystrixCommand.execute(it, [x => x, true, cb]),
-      forEach: (f, cb) => this._hystrixCommand.execute(it, [f, false, cb]),
-    };
   }
 
   /**
-   * @param StartWorkflowRequest - Parameters for starting a workflow (workflow definition, input, and optionally namespace, queue, and tags)
+   * @param {string} workflowID
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
    * @param {function} [cb]
    * @returns {Promise}
-   * @fulfill {Object}
+   * @fulfill {Object[]}
    * @reject {module:workflow-manager.Errors.BadRequest}
    * @reject {module:workflow-manager.Errors.NotFound}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  startWorkflow(StartWorkflowRequest, options, cb) {
-    return this._hystrixCommand.execute(this._startWorkflow, arguments);
+  getWorkflowChoices(workflowID, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowChoices, arguments);
   }
-  _startWorkflow(StartWorkflowRequest, options, cb) {
+  _getWorkflowChoices(workflowID, options, cb) {
     const params = {};
-    params["StartWorkflowRequest"] = StartWorkflowRequest;
+    params["workflowID"] = workflowID;
 
     if (!cb && typeof options === "function") {
       cb = options;
@@ -1917,19 +4291,23 @@ class WorkflowManager {
       const span = options.span;
 
       const headers = {};
+      if (!params.workflowID) {
+        rejecter(new Error("workflowID must be non-empty because it's a path parameter"));
+        return;
+      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("POST /workflows");
+        span.logEvent("GET /workflows/{workflowID}/choices");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "POST",
-        uri: this.address + "/workflows",
+        method: "GET",
+        uri: this.address + "/workflows/" + params.workflowID + "/choices",
         json: true,
         timeout,
         headers,
@@ -1940,8 +4318,6 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
-      requestOptions.body = params.StartWorkflowRequest;
-  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -1998,9 +4374,7 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {string} params.workflowID
-   * @param params.reason
+   * @param {string} workflowID
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -2013,10 +4387,13 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  CancelWorkflow(params, options, cb) {
-    return this._hystrixCommand.execute(this._CancelWorkflow, arguments);
+  getWorkflowEvents(workflowID, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowEvents, arguments);
   }
-  _CancelWorkflow(params, options, cb) {
+  _getWorkflowEvents(workflowID, options, cb) {
+    const params = {};
+    params["workflowID"] = workflowID;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -2056,13 +4433,13 @@ class WorkflowManager {
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("DELETE /workflows/{workflowID}");
+        span.logEvent("GET /workflows/{workflowID}/events");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
-        method: "DELETE",
-        uri: this.address + "/workflows/" + params.workflowID + "",
+        method: "GET",
+        uri: this.address + "/workflows/" + params.workflowID + "/events",
         json: true,
         timeout,
         headers,
@@ -2073,8 +4450,6 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
-      requestOptions.body = params.reason;
-  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -2131,7 +4506,9 @@ class WorkflowManager {
   }
 
   /**
-   * @param {string} workflowID
+   * @param {Object} params
+   * @param {string} params.workflowID
+   * @param {string} [params.format]
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -2144,13 +4521,10 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  getWorkflowByID(workflowID, options, cb) {
-    return this._hystrixCommand.execute(this._getWorkflowByID, arguments);
+  getWorkflowGraph(params, options, cb) {
+    return this._hystrixCommand.execute(this._getWorkflowGraph, arguments);
   }
-  _getWorkflowByID(workflowID, options, cb) {
-    const params = {};
-    params["workflowID"] = workflowID;
-
+  _getWorkflowGraph(params, options, cb) {
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -2186,17 +4560,21 @@ class WorkflowManager {
       }
 
       const query = {};
+      if (typeof params.format !== "undefined") {
+        query["format"] = params.format;
+      }
+  
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("GET /workflows/{workflowID}");
+        span.logEvent("GET /workflows/{workflowID}/graph");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
         method: "GET",
-        uri: this.address + "/workflows/" + params.workflowID + "",
+        uri: this.address + "/workflows/" + params.workflowID + "/graph",
         json: true,
         timeout,
         headers,
@@ -2263,25 +4641,27 @@ class WorkflowManager {
   }
 
   /**
-   * @param {Object} params
-   * @param {string} params.workflowID
-   * @param params.overrides
+   * @param {string} workflowID
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
    * @param {module:workflow-manager.RetryPolicies} [options.retryPolicy] - A request specific retryPolicy
    * @param {function} [cb]
    * @returns {Promise}
-   * @fulfill {Object}
+   * @fulfill {undefined}
    * @reject {module:workflow-manager.Errors.BadRequest}
    * @reject {module:workflow-manager.Errors.NotFound}
+   * @reject {module:workflow-manager.Errors.Conflict}
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  resumeWorkflowByID(params, options, cb) {
-    return this._hystrixCommand.execute(this._resumeWorkflowByID, arguments);
+  resolveWorkflowByID(workflowID, options, cb) {
+    return this._hystrixCommand.execute(this._resolveWorkflowByID, arguments);
   }
-  _resumeWorkflowByID(params, options, cb) {
+  _resolveWorkflowByID(workflowID, options, cb) {
+    const params = {};
+    params["workflowID"] = workflowID;
+
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -2321,13 +4701,13 @@ class WorkflowManager {
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("POST /workflows/{workflowID}");
+        span.logEvent("POST /workflows/{workflowID}/resolved");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
         method: "POST",
-        uri: this.address + "/workflows/" + params.workflowID + "",
+        uri: this.address + "/workflows/" + params.workflowID + "/resolved",
         json: true,
         timeout,
         headers,
@@ -2338,8 +4718,6 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
-      requestOptions.body = params.overrides;
-  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -2362,8 +4740,8 @@ class WorkflowManager {
           }
 
           switch (response.statusCode) {
-            case 200:
-              resolver(body);
+            case 201:
+              resolver();
               break;
             
             case 400:
@@ -2378,6 +4756,12 @@ class WorkflowManager {
               rejecter(err);
               return;
             
+            case 409:
+              var err = new Errors.Conflict(body || {});
+              responseLog(logger, requestOptions, response, err);
+              rejecter(err);
+              return;
+            
             case 500:
               var err = new Errors.InternalError(body || {});
               responseLog(logger, requestOptions, response, err);
@@ -2396,7 +4780,10 @@ class WorkflowManager {
   }
 
   /**
-   * @param {string} workflowID
+   * @param {Object} params
+   * @param {string} params.workflowID
+   * @param {string} params.state
+   * @param [params.signal]
    * @param {object} [options]
    * @param {number} [options.timeout] - A request specific timeout
    * @param {external:Span} [options.span] - An OpenTracing span - For example from the parent request
@@ -2410,13 +4797,10 @@ class WorkflowManager {
    * @reject {module:workflow-manager.Errors.InternalError}
    * @reject {Error}
    */
-  resolveWorkflowByID(workflowID, options, cb) {
-    return this._hystrixCommand.execute(this._resolveWorkflowByID, arguments);
+  signalWorkflowState(params, options, cb) {
+    return this._hystrixCommand.execute(this._signalWorkflowState, arguments);
   }
-  _resolveWorkflowByID(workflowID, options, cb) {
-    const params = {};
-    params["workflowID"] = workflowID;
-
+  _signalWorkflowState(params, options, cb) {
     if (!cb && typeof options === "function") {
       cb = options;
       options = undefined;
@@ -2450,19 +4834,23 @@ class WorkflowManager {
         rejecter(new Error("workflowID must be non-empty because it's a path parameter"));
         return;
       }
+      if (!params.state) {
+        rejecter(new Error("state must be non-empty because it's a path parameter"));
+        return;
+      }
 
       const query = {};
 
       if (span) {
         // Need to get tracer to inject. Use HTTP headers format so we can properly escape special characters
         tracer.inject(span, opentracing.FORMAT_HTTP_HEADERS, headers);
-        span.logEvent("POST /workflows/{workflowID}/resolved");
+        span.logEvent("POST /workflows/{workflowID}/signals/{state}");
         span.setTag("span.kind", "client");
       }
 
 	  const requestOptions = {
         method: "POST",
-        uri: this.address + "/workflows/" + params.workflowID + "/resolved",
+        uri: this.address + "/workflows/" + params.workflowID + "/signals/" + params.state + "",
         json: true,
         timeout,
         headers,
@@ -2473,6 +4861,8 @@ class WorkflowManager {
         requestOptions.forever = true;
       }
   
+      requestOptions.body = params.signal;
+  
 
       const retryPolicy = options.retryPolicy || this.retryPolicy || singleRetryPolicy;
       const backoffs = retryPolicy.backoffs();
@@ -2495,7 +4885,7 @@ class WorkflowManager {
           }
 
           switch (response.statusCode) {
-            case 201:
+            case 200:
               resolver();
               break;
             
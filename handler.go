@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"gopkg.in/Clever/kayvee-go.v6/logger"
@@ -13,10 +16,14 @@ import (
 	"github.com/Clever/workflow-manager/store"
 )
 
+// aliasRegex matches the names of workflow definition aliases, which can't be mistaken for versions
+var aliasRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+
 // Handler implements the wag Controller
 type Handler struct {
 	store   store.Store
 	manager executor.WorkflowManager
+	events  *workflowEvents
 }
 
 // HealthCheck returns 200 if workflow-manager can respond to requests
@@ -97,9 +104,174 @@ func (h Handler) GetWorkflowDefinitionByNameAndVersion(ctx context.Context, inpu
 	return &wfd, nil
 }
 
+// GetWorkflowDefinitionDiff returns the changes between two versions of a WorkflowDefinition
+func (h Handler) GetWorkflowDefinitionDiff(ctx context.Context, input *models.GetWorkflowDefinitionDiffInput) (*models.WorkflowDefinitionDiff, error) {
+	from, err := h.store.GetWorkflowDefinition(ctx, input.Name, int(input.From))
+	if err != nil {
+		return nil, err
+	}
+	to, err := h.store.GetWorkflowDefinition(ctx, input.Name, int(input.To))
+	if err != nil {
+		return nil, err
+	}
+	return resources.DiffWorkflowDefinitions(from, to)
+}
+
+// GetWorkflowDefinitionGraph renders the state machine of a WorkflowDefinition as a graph
+func (h Handler) GetWorkflowDefinitionGraph(ctx context.Context, input *models.GetWorkflowDefinitionGraphInput) (*models.WorkflowGraph, error) {
+	format, err := graphFormat(input.Format)
+	if err != nil {
+		return nil, err
+	}
+	def, err := h.store.GetWorkflowDefinition(ctx, input.Name, int(input.Version))
+	if err != nil {
+		return nil, err
+	}
+	graph, err := resources.WorkflowDefinitionGraph(def, format)
+	if err != nil {
+		return nil, err
+	}
+	return &models.WorkflowGraph{Format: format, Graph: graph}, nil
+}
+
+// graphFormat parses the format of a graph, which defaults to DOT
+func graphFormat(format *string) (models.GraphFormat, error) {
+	if format == nil || *format == "" {
+		return models.GraphFormatDOT, nil
+	}
+	switch f := models.GraphFormat(*format); f {
+	case models.GraphFormatDOT, models.GraphFormatMermaid, models.GraphFormatSVG:
+		return f, nil
+	}
+	return "", models.BadRequest{Message: fmt.Sprintf("unknown graph format %s, must be dot, mermaid or svg", *format)}
+}
+
+// GetWorkflowDefinitionAliases lists the aliases of a WorkflowDefinition
+func (h Handler) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	if _, err := h.store.LatestWorkflowDefinition(ctx, name); err != nil {
+		return []models.WorkflowDefinitionAlias{}, err
+	}
+	return h.store.GetWorkflowDefinitionAliases(ctx, name)
+}
+
+// SetWorkflowDefinitionAlias creates an alias of a WorkflowDefinition, or moves it to another version
+func (h Handler) SetWorkflowDefinitionAlias(ctx context.Context, input *models.SetWorkflowDefinitionAliasInput) (*models.WorkflowDefinitionAlias, error) {
+	if !aliasRegex.MatchString(input.Alias) {
+		return nil, models.BadRequest{
+			Message: fmt.Sprintf("alias %s must start with a letter and contain only letters, digits, '-' and '_'", input.Alias),
+		}
+	}
+	if input.SetWorkflowDefinitionAliasRequest == nil || input.SetWorkflowDefinitionAliasRequest.Version < 0 {
+		return nil, models.BadRequest{Message: "a non-negative version is required"}
+	}
+	version := input.SetWorkflowDefinitionAliasRequest.Version
+	if _, err := h.store.GetWorkflowDefinition(ctx, input.Name, int(version)); err != nil {
+		return nil, err
+	}
+
+	if err := h.store.SaveWorkflowDefinitionAlias(ctx, models.WorkflowDefinitionAlias{
+		Name:    input.Name,
+		Alias:   input.Alias,
+		Version: version,
+	}); err != nil {
+		return nil, err
+	}
+	alias, err := h.store.GetWorkflowDefinitionAlias(ctx, input.Name, input.Alias)
+	if err != nil {
+		return nil, err
+	}
+	return &alias, nil
+}
+
+// DeleteWorkflowDefinitionAlias deletes an alias of a WorkflowDefinition
+func (h Handler) DeleteWorkflowDefinitionAlias(ctx context.Context, input *models.DeleteWorkflowDefinitionAliasInput) error {
+	return h.store.DeleteWorkflowDefinitionAlias(ctx, input.Name, input.Alias)
+}
+
+// GetWorkflowDefinitionRollout gets the rollout of a WorkflowDefinition
+func (h Handler) GetWorkflowDefinitionRollout(ctx context.Context, name string) (*models.WorkflowDefinitionRollout, error) {
+	rollout, err := h.store.GetWorkflowDefinitionRollout(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &rollout, nil
+}
+
+// SetWorkflowDefinitionRollout starts or adjusts the rollout of a WorkflowDefinition
+func (h Handler) SetWorkflowDefinitionRollout(ctx context.Context, input *models.SetWorkflowDefinitionRolloutInput) (*models.WorkflowDefinitionRollout, error) {
+	req := input.WorkflowDefinitionRollout
+	if req == nil {
+		return nil, models.BadRequest{Message: "a rollout is required"}
+	}
+	if req.CanaryWeight < 0 || req.CanaryWeight > 100 {
+		return nil, models.BadRequest{Message: "canaryWeight must be between 0 and 100"}
+	}
+	if req.BaselineVersion < 0 || req.CanaryVersion < 0 {
+		return nil, models.BadRequest{Message: "baselineVersion and canaryVersion must be non-negative"}
+	}
+	for _, version := range []int64{req.BaselineVersion, req.CanaryVersion} {
+		if _, err := h.store.GetWorkflowDefinition(ctx, input.Name, int(version)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.store.SaveWorkflowDefinitionRollout(ctx, models.WorkflowDefinitionRollout{
+		Name:            input.Name,
+		BaselineVersion: req.BaselineVersion,
+		CanaryVersion:   req.CanaryVersion,
+		CanaryWeight:    req.CanaryWeight,
+	}); err != nil {
+		return nil, err
+	}
+	return h.GetWorkflowDefinitionRollout(ctx, input.Name)
+}
+
+// DeleteWorkflowDefinitionRollout ends the rollout of a WorkflowDefinition
+func (h Handler) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	return h.store.DeleteWorkflowDefinitionRollout(ctx, name)
+}
+
+// workflowDefinitionFromRef gets the WorkflowDefinition a ref points at, by alias, by version, or
+// the latest version for negative versions. Refs to the latest version of a definition with a
+// rollout get the version of a randomly picked arm of the rollout, which is also returned.
+func (h Handler) workflowDefinitionFromRef(ctx context.Context, ref *models.WorkflowDefinitionRef) (models.WorkflowDefinition, string, error) {
+	if ref.Alias != "" && ref.Version > 0 {
+		return models.WorkflowDefinition{}, "", models.BadRequest{
+			Message: "workflow definition ref can't have both an alias and a version",
+		}
+	}
+	if ref.Alias != "" {
+		alias, err := h.store.GetWorkflowDefinitionAlias(ctx, ref.Name, ref.Alias)
+		if err != nil {
+			return models.WorkflowDefinition{}, "", err
+		}
+		def, err := h.store.GetWorkflowDefinition(ctx, ref.Name, int(alias.Version))
+		return def, "", err
+	}
+	if ref.Version >= 0 {
+		def, err := h.store.GetWorkflowDefinition(ctx, ref.Name, int(ref.Version))
+		return def, "", err
+	}
+
+	rollout, err := h.store.GetWorkflowDefinitionRollout(ctx, ref.Name)
+	if err != nil {
+		if _, ok := err.(models.NotFound); ok {
+			def, err := h.store.LatestWorkflowDefinition(ctx, ref.Name)
+			return def, "", err
+		}
+		return models.WorkflowDefinition{}, "", err
+	}
+	arm, version := resources.RolloutArmBaseline, rollout.BaselineVersion
+	if rand.Int63n(100) < rollout.CanaryWeight {
+		arm, version = resources.RolloutArmCanary, rollout.CanaryVersion
+	}
+	def, err := h.store.GetWorkflowDefinition(ctx, ref.Name, int(version))
+	return def, arm, err
+}
+
 // PostStateResource creates a new state resource
 func (h Handler) PostStateResource(ctx context.Context, i *models.NewStateResource) (*models.StateResource, error) {
-	stateResource := resources.NewStateResource(i.Name, i.Namespace, i.URI)
+	stateResource := resources.NewStateResource(i.Name, i.Namespace, i.URI, i.Type)
 	if err := h.store.SaveStateResource(ctx, *stateResource); err != nil {
 		return &models.StateResource{}, err
 	}
@@ -119,7 +291,7 @@ func (h Handler) PutStateResource(ctx context.Context, i *models.PutStateResourc
 		}
 	}
 
-	stateResource := resources.NewStateResource(i.NewStateResource.Name, i.NewStateResource.Namespace, i.NewStateResource.URI)
+	stateResource := resources.NewStateResource(i.NewStateResource.Name, i.NewStateResource.Namespace, i.NewStateResource.URI, i.NewStateResource.Type)
 	if err := h.store.SaveStateResource(ctx, *stateResource); err != nil {
 		return &models.StateResource{}, err
 	}
@@ -143,19 +315,14 @@ func (h Handler) DeleteStateResource(ctx context.Context, i *models.DeleteStateR
 
 // StartWorkflow starts a new Workflow for the given WorkflowDefinition
 func (h Handler) StartWorkflow(ctx context.Context, req *models.StartWorkflowRequest) (*models.Workflow, error) {
-	var workflowDefinition models.WorkflowDefinition
-	var err error
-	if req.WorkflowDefinition.Version < 0 {
-		workflowDefinition, err = h.store.LatestWorkflowDefinition(ctx, req.WorkflowDefinition.Name)
-	} else {
-		workflowDefinition, err = h.store.GetWorkflowDefinition(ctx, req.WorkflowDefinition.Name, int(req.WorkflowDefinition.Version))
-	}
+	workflowDefinition, rolloutArm, err := h.workflowDefinitionFromRef(ctx, req.WorkflowDefinition)
 	switch err.(type) {
 	case nil: // Do nothing
 	case models.NotFound:
 		logger.FromContext(ctx).WarnD("start-unknown-workflow", logger.M{
 			"name":    req.WorkflowDefinition.Name,
 			"version": req.WorkflowDefinition.Version,
+			"alias":   req.WorkflowDefinition.Alias,
 		})
 		return &models.Workflow{}, err
 	default:
@@ -178,7 +345,17 @@ func (h Handler) StartWorkflow(ctx context.Context, req *models.StartWorkflowReq
 		req.Input = "{}"
 	}
 
-	return h.manager.CreateWorkflow(ctx, workflowDefinition, req.Input, req.Namespace, req.Queue, req.Tags)
+	if err := resources.ValidateInput(workflowDefinition, req.Input); err != nil {
+		return &models.Workflow{}, models.BadRequest{Message: err.Error()}
+	}
+
+	// deadlines set on submission take precedence over the definition's. They're recorded on the
+	// copy of the definition that is saved with the workflow.
+	if req.Deadlines != nil {
+		workflowDefinition.Deadlines = mergeDeadlines(workflowDefinition.Deadlines, req.Deadlines)
+	}
+
+	return h.manager.CreateWorkflow(ctx, workflowDefinition, req.Input, req.Namespace, req.Queue, req.Tags, rolloutArm)
 }
 
 // GetWorkflows returns a summary of all workflows matching the given query.
@@ -247,6 +424,26 @@ func (h Handler) GetWorkflowByID(ctx context.Context, workflowID string) (*model
 	return &workflow, nil
 }
 
+// GetWorkflowGraph renders the state machine of a Workflow as a graph, with the status of its jobs
+func (h Handler) GetWorkflowGraph(ctx context.Context, input *models.GetWorkflowGraphInput) (*models.WorkflowGraph, error) {
+	format, err := graphFormat(input.Format)
+	if err != nil {
+		return nil, err
+	}
+	workflow, err := h.store.GetWorkflowByID(ctx, input.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.manager.UpdateWorkflowHistory(ctx, &workflow); err != nil {
+		return nil, err
+	}
+	graph, err := resources.WorkflowGraph(workflow, format)
+	if err != nil {
+		return nil, err
+	}
+	return &models.WorkflowGraph{Format: format, Graph: graph}, nil
+}
+
 // CancelWorkflow cancels all the jobs currently running or queued for the Workflow and
 // marks the workflow as cancelled
 func (h Handler) CancelWorkflow(ctx context.Context, input *models.CancelWorkflowInput) error {
@@ -261,6 +458,13 @@ func (h Handler) CancelWorkflow(ctx context.Context, input *models.CancelWorkflo
 // ResumeWorkflowByID starts a new Workflow based on an existing completed Workflow
 // from the provided position. Uses existing inputs and outputs when required
 func (h Handler) ResumeWorkflowByID(ctx context.Context, input *models.ResumeWorkflowByIDInput) (*models.Workflow, error) {
+	overrides := input.Overrides
+	if overrides.Input != "" && overrides.InputPatch != "" {
+		return &models.Workflow{}, models.BadRequest{
+			Message: "Input and InputPatch cannot both be set",
+		}
+	}
+
 	workflow, err := h.store.GetWorkflowByID(ctx, input.WorkflowID)
 	if err != nil {
 		return &models.Workflow{}, err
@@ -270,31 +474,67 @@ func (h Handler) ResumeWorkflowByID(ctx context.Context, input *models.ResumeWor
 	if !resources.WorkflowIsDone(&workflow) {
 		return &models.Workflow{}, fmt.Errorf("Workflow %s active: %s", workflow.ID, workflow.Status)
 	}
-	if _, ok := workflow.WorkflowDefinition.StateMachine.States[input.Overrides.StartAt]; !ok {
-		return &models.Workflow{}, fmt.Errorf("Invalid StartAt state %s", input.Overrides.StartAt)
+
+	// resume on a different version of the definition, e.g. one containing a bug fix
+	workflowDefinition := *workflow.WorkflowDefinition
+	if overrides.WorkflowDefinitionVersion != nil {
+		workflowDefinition, err = h.store.GetWorkflowDefinition(
+			ctx, workflow.WorkflowDefinition.Name, int(*overrides.WorkflowDefinitionVersion),
+		)
+		if err != nil {
+			return &models.Workflow{}, err
+		}
+	}
+	if _, ok := workflowDefinition.StateMachine.States[overrides.StartAt]; !ok {
+		return &models.Workflow{}, fmt.Errorf("Invalid StartAt state %s", overrides.StartAt)
+	}
+
+	effectiveInput := overrides.Input
+	if effectiveInput == "" {
+		effectiveInput, err = inferStartAtInput(workflow, overrides.StartAt)
+		if err != nil {
+			return &models.Workflow{}, err
+		}
+	}
+	if overrides.InputPatch != "" {
+		effectiveInput, err = resources.MergePatchInput(effectiveInput, overrides.InputPatch)
+		if err != nil {
+			return &models.Workflow{}, models.BadRequest{Message: err.Error()}
+		}
+	}
+
+	// the inputSchema describes the input of the first state, which later states don't receive
+	if overrides.StartAt == workflowDefinition.StateMachine.StartAt {
+		if err := resources.ValidateInput(workflowDefinition, effectiveInput); err != nil {
+			return &models.Workflow{}, models.BadRequest{Message: err.Error()}
+		}
 	}
 
-	// find the input to the StartAt state
-	effectiveInput := ""
+	// this retry replaces any automatic one
+	if err := executor.ClearAutoRetry(ctx, h.store, &workflow); err != nil {
+		return &models.Workflow{}, err
+	}
+	return h.manager.RetryWorkflow(ctx, workflow, workflowDefinition, overrides.StartAt, effectiveInput)
+}
+
+// inferStartAtInput finds the input the StartAt state received in the original workflow
+func inferStartAtInput(workflow models.Workflow, startAt string) (string, error) {
 	for _, job := range workflow.Jobs {
-		if job.State == input.Overrides.StartAt {
+		if job.State == startAt {
 			// if job was never started then we should probably not trust the input
 			if job.Status == models.JobStatusAbortedDepsFailed ||
 				job.Status == models.JobStatusQueued ||
 				job.Status == models.JobStatusWaitingForDeps ||
 				job.Status == models.JobStatusCreated {
 
-				return &models.Workflow{},
-					fmt.Errorf("Job %s for StartAt %s was not started for Workflow: %s. Could not infer input",
-						job.ID, job.State, workflow.ID)
+				return "", fmt.Errorf("Job %s for StartAt %s was not started for Workflow: %s. Could not infer input",
+					job.ID, job.State, workflow.ID)
 			}
 
-			effectiveInput = job.Input
-			break
+			return job.Input, nil
 		}
 	}
-
-	return h.manager.RetryWorkflow(ctx, workflow, input.Overrides.StartAt, effectiveInput)
+	return "", nil
 }
 
 // ResolveWorkflowByID sets a workflow's ResolvedByUser to true if it is currently false.
@@ -311,12 +551,105 @@ func (h Handler) ResolveWorkflowByID(ctx context.Context, workflowID string) err
 			Message: fmt.Sprintf("workflow %s already resolved", workflow.ID),
 		}
 	}
-	// set the ResolvedByUser value to true
+	// set the ResolvedByUser value to true, and don't retry the workflow automatically anymore
+	if err := executor.ClearAutoRetry(ctx, h.store, &workflow); err != nil {
+		return err
+	}
 	workflow.ResolvedByUser = true
 
 	return h.store.UpdateWorkflow(ctx, workflow)
 }
 
+// SignalWorkflowState completes a callback state of a running Workflow with an output or an error
+func (h Handler) SignalWorkflowState(ctx context.Context, input *models.SignalWorkflowStateInput) error {
+	workflow, err := h.store.GetWorkflowByID(ctx, input.WorkflowID)
+	if err != nil {
+		return err
+	}
+
+	state, ok := workflow.WorkflowDefinition.StateMachine.States[input.State]
+	if !ok {
+		return models.BadRequest{
+			Message: fmt.Sprintf("state %s does not exist in workflow definition %s", input.State, workflow.WorkflowDefinition.Name),
+		}
+	}
+	if state.Type != models.SLStateTypeTask || !resources.IsCallbackResource(state.Resource) {
+		return models.BadRequest{
+			Message: fmt.Sprintf("state %s is not a callback state", input.State),
+		}
+	}
+	if resources.WorkflowIsDone(&workflow) {
+		return models.Conflict{
+			Message: fmt.Sprintf("workflow %s is %s", workflow.ID, workflow.Status),
+		}
+	}
+
+	signal := models.SignalRequest{}
+	if input.Signal != nil {
+		signal = *input.Signal
+	}
+	if signal.Error == "" && signal.Output != "" && !json.Valid([]byte(signal.Output)) {
+		return models.BadRequest{Message: "output is not valid JSON"}
+	}
+
+	return h.manager.SignalWorkflow(ctx, &workflow, input.State, signal)
+}
+
+// EvaluatePath evaluates the path a sample input would take through a WorkflowDefinition
+func (h Handler) EvaluatePath(ctx context.Context, req *models.PathEvaluationRequest) (*models.PathEvaluation, error) {
+	if req == nil {
+		return nil, models.BadRequest{Message: "a path evaluation request is required"}
+	}
+	stateMachine := req.StateMachine
+	if stateMachine == nil {
+		if req.WorkflowDefinition == nil {
+			return nil, models.BadRequest{Message: "a workflowDefinition or stateMachine is required"}
+		}
+		// the rollout arm doesn't matter, since nothing is started
+		def, _, err := h.workflowDefinitionFromRef(ctx, req.WorkflowDefinition)
+		if err != nil {
+			return nil, err
+		}
+		stateMachine = def.StateMachine
+	}
+
+	evaluation, err := resources.EvaluatePath(stateMachine, req.Input, req.StubOutputs)
+	if err != nil {
+		return nil, models.BadRequest{Message: err.Error()}
+	}
+	return evaluation, nil
+}
+
+// GetWorkflowChoices explains the branch each Choice state of a Workflow took, using the
+// recorded inputs of its jobs
+func (h Handler) GetWorkflowChoices(ctx context.Context, workflowID string) ([]models.ChoiceEvaluation, error) {
+	workflow, err := h.store.GetWorkflowByID(ctx, workflowID)
+	if err != nil {
+		return []models.ChoiceEvaluation{}, err
+	}
+	if err := h.manager.UpdateWorkflowHistory(ctx, &workflow); err != nil {
+		return []models.ChoiceEvaluation{}, err
+	}
+
+	choices := []models.ChoiceEvaluation{}
+	for _, job := range workflow.Jobs {
+		state, ok := workflow.WorkflowDefinition.StateMachine.States[job.State]
+		if !ok || state.Type != models.SLStateTypeChoice {
+			continue
+		}
+		evaluation, err := resources.EvaluateChoiceInput(job.State, state, job.Input)
+		if err != nil {
+			// a rule that failed to evaluate is explained in the evaluation
+			if _, ok := err.(resources.ChoiceRuleError); !ok {
+				return []models.ChoiceEvaluation{}, err
+			}
+		}
+		evaluation.JobID = job.ID
+		choices = append(choices, *evaluation)
+	}
+	return choices, nil
+}
+
 func newWorkflowDefinitionFromRequest(req models.NewWorkflowDefinitionRequest) (*models.WorkflowDefinition, error) {
 	if req.StateMachine.StartAt == "" {
 		return nil, fmt.Errorf("StartAt is a required field")
@@ -337,7 +670,106 @@ func newWorkflowDefinitionFromRequest(req models.NewWorkflowDefinitionRequest) (
 		return nil, err
 	}
 
-	return resources.NewWorkflowDefinition(req.Name, req.Manager, req.StateMachine, req.DefaultTags)
+	if err := validateAutoRetryPolicy(req.AutoRetry); err != nil {
+		return nil, err
+	}
+
+	if err := validateChildWorkflowStates(req.Name, req.StateMachine); err != nil {
+		return nil, err
+	}
+
+	if err := validateWorkflowTriggers(req.Triggers); err != nil {
+		return nil, err
+	}
+
+	if err := resources.ValidateInputSchema(req.InputSchema); err != nil {
+		return nil, err
+	}
+
+	if err := resources.ValidateOutputSchemas(req.StateMachine, req.OutputSchemas); err != nil {
+		return nil, err
+	}
+
+	wd, err := resources.NewWorkflowDefinition(req.Name, req.Manager, req.StateMachine, req.DefaultTags)
+	if err != nil {
+		return nil, err
+	}
+	wd.AutoRetry = req.AutoRetry
+	wd.Deadlines = req.Deadlines
+	wd.Triggers = req.Triggers
+	wd.InputSchema = req.InputSchema
+	wd.OutputSchemas = req.OutputSchemas
+	return wd, nil
+}
+
+// validateAutoRetryPolicy checks the fields of an AutoRetryPolicy that swagger validation can't
+func validateAutoRetryPolicy(policy *models.AutoRetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	if policy.MaxAttempts < 1 {
+		return fmt.Errorf("autoRetry.maxAttempts must be at least 1")
+	}
+	if policy.BackoffRate != 0 && policy.BackoffRate < 1 {
+		return fmt.Errorf("autoRetry.backoffRate must be at least 1.0")
+	}
+	if _, err := regexp.Compile(policy.StatusReasonPattern); err != nil {
+		return fmt.Errorf("invalid autoRetry.statusReasonPattern: %s", err)
+	}
+	return nil
+}
+
+// validateChildWorkflowStates ensures child workflow states name a workflow definition other than
+// the one they belong to, which would otherwise start child workflows forever
+func validateChildWorkflowStates(name string, stateMachine *models.SLStateMachine) error {
+	for stateName, state := range stateMachine.States {
+		if state.Type != models.SLStateTypeTask || !resources.IsChildWorkflowResource(state.Resource) {
+			continue
+		}
+		childName, _, err := resources.ParseChildWorkflowResource(state.Resource)
+		if err != nil {
+			return err
+		}
+		if childName == name {
+			return fmt.Errorf("state %s can not start a child workflow of its own workflow definition", stateName)
+		}
+	}
+	return nil
+}
+
+// validateWorkflowTriggers ensures triggers fire on a terminal status and start a named workflow definition
+func validateWorkflowTriggers(triggers []*models.WorkflowTrigger) error {
+	for _, trigger := range triggers {
+		switch trigger.On {
+		case models.WorkflowStatusSucceeded, models.WorkflowStatusFailed, models.WorkflowStatusCancelled:
+		default:
+			return fmt.Errorf("trigger.on must be succeeded, failed or cancelled, not '%s'", trigger.On)
+		}
+		if trigger.WorkflowDefinitionName == "" {
+			return fmt.Errorf("trigger.workflowDefinitionName is required")
+		}
+		for _, path := range trigger.InputMapping {
+			if err := resources.ValidateTriggerPath(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// mergeDeadlines returns the definition's deadlines with any set in overrides replaced
+func mergeDeadlines(deadlines, overrides *models.WorkflowDeadlines) *models.WorkflowDeadlines {
+	merged := models.WorkflowDeadlines{}
+	if deadlines != nil {
+		merged = *deadlines
+	}
+	if overrides.SoftDeadlineSeconds != 0 {
+		merged.SoftDeadlineSeconds = overrides.SoftDeadlineSeconds
+	}
+	if overrides.MaxAgeSeconds != 0 {
+		merged.MaxAgeSeconds = overrides.MaxAgeSeconds
+	}
+	return &merged
 }
 
 // validateTagsMap ensures that all tags values are strings
@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
 	"github.com/Clever/workflow-manager/mocks"
 	"github.com/Clever/workflow-manager/resources"
 	"github.com/Clever/workflow-manager/store/memory"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,6 +46,15 @@ func TestNewWorkflowDefinitionFromRequest(t *testing.T) {
 	_, err := newWorkflowDefinitionFromRequest(workflowReq)
 	t.Log("No error converting from new workflow request to resource")
 	assert.Nil(t, err)
+
+	t.Log("Child workflow states must start another workflow definition")
+	workflowReq.StateMachine.States["second-state"] = models.SLState{
+		Type:     models.SLStateTypeTask,
+		Next:     "end-state",
+		Resource: "workflow:test-workflow",
+	}
+	_, err = newWorkflowDefinitionFromRequest(workflowReq)
+	assert.Error(t, err)
 }
 
 func TestValidateTagsMap(t *testing.T) {
@@ -52,6 +64,51 @@ func TestValidateTagsMap(t *testing.T) {
 	assert.Error(t, validateTagsMap(apiTags))
 }
 
+func TestValidateAutoRetryPolicy(t *testing.T) {
+	assert.NoError(t, validateAutoRetryPolicy(nil))
+	assert.NoError(t, validateAutoRetryPolicy(&models.AutoRetryPolicy{
+		MaxAttempts:         2,
+		BackoffRate:         2.0,
+		StatusReasonPattern: "^connection (reset|refused)",
+	}))
+	assert.Error(t, validateAutoRetryPolicy(&models.AutoRetryPolicy{}))
+	assert.Error(t, validateAutoRetryPolicy(&models.AutoRetryPolicy{MaxAttempts: 1, BackoffRate: 0.5}))
+	assert.Error(t, validateAutoRetryPolicy(&models.AutoRetryPolicy{MaxAttempts: 1, StatusReasonPattern: "("}))
+}
+
+func TestValidateWorkflowTriggers(t *testing.T) {
+	assert.NoError(t, validateWorkflowTriggers([]*models.WorkflowTrigger{{
+		On:                     models.WorkflowStatusSucceeded,
+		WorkflowDefinitionName: "next",
+		InputMapping:           map[string]string{"key": "$.output.key"},
+	}}))
+	assert.Error(t, validateWorkflowTriggers([]*models.WorkflowTrigger{{
+		On:                     models.WorkflowStatusRunning,
+		WorkflowDefinitionName: "next",
+	}}))
+	assert.Error(t, validateWorkflowTriggers([]*models.WorkflowTrigger{{
+		On: models.WorkflowStatusFailed,
+	}}))
+	assert.Error(t, validateWorkflowTriggers([]*models.WorkflowTrigger{{
+		On:                     models.WorkflowStatusFailed,
+		WorkflowDefinitionName: "next",
+		InputMapping:           map[string]string{"key": "output.key"},
+	}}))
+}
+
+func TestMergeDeadlines(t *testing.T) {
+	overrides := &models.WorkflowDeadlines{MaxAgeSeconds: 10}
+	assert.Equal(t, overrides, mergeDeadlines(nil, overrides))
+
+	deadlines := &models.WorkflowDeadlines{SoftDeadlineSeconds: 5, MaxAgeSeconds: 20}
+	assert.Equal(t,
+		&models.WorkflowDeadlines{SoftDeadlineSeconds: 5, MaxAgeSeconds: 10},
+		mergeDeadlines(deadlines, overrides),
+	)
+	t.Log("the definition's deadlines are not modified")
+	assert.Equal(t, int64(20), deadlines.MaxAgeSeconds)
+}
+
 func TestParamsToWorkflowsQuery(t *testing.T) {
 	boolTrue := true
 	boolFalse := false
@@ -110,6 +167,38 @@ func TestParamsToWorkflowsQuery(t *testing.T) {
 	assert.Equal(t, false, workflowQuery.ResolvedByUserWrapper.IsSet)
 }
 
+func TestGetWorkflowDefinitionDiff(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	h := Handler{store: store}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	updated := *workflowDefinition
+	updated.DefaultTags = map[string]interface{}{"tag1": "changed", "tag2": "val2", "tag3": "val3"}
+	updated, err := store.UpdateWorkflowDefinition(ctx, updated)
+	require.NoError(t, err)
+
+	diff, err := h.GetWorkflowDefinitionDiff(ctx, &models.GetWorkflowDefinitionDiffInput{
+		Name: workflowDefinition.Name,
+		From: workflowDefinition.Version,
+		To:   updated.Version,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*models.FieldChange{
+		{Field: "defaultTags.tag1", From: `"val1"`, To: `"changed"`},
+	}, diff.Changes)
+	assert.Empty(t, diff.ChangedStates)
+
+	t.Log("Fails for an unknown version")
+	_, err = h.GetWorkflowDefinitionDiff(ctx, &models.GetWorkflowDefinitionDiffInput{
+		Name: workflowDefinition.Name,
+		From: workflowDefinition.Version,
+		To:   100,
+	})
+	assert.IsType(t, models.NotFound{}, err)
+}
+
 func TestStartWorkflow(t *testing.T) {
 	mockController := gomock.NewController(t)
 	defer mockController.Finish()
@@ -128,7 +217,7 @@ func TestStartWorkflow(t *testing.T) {
 	t.Log("Verify that StartWorkflow handler converts empty string to empty dictionary")
 	for _, input := range []string{"", "{}"} {
 		mockWFM.EXPECT().
-			CreateWorkflow(gomock.Any(), gomock.Any(), "{}", gomock.Any(), gomock.Any(), gomock.Any()).
+			CreateWorkflow(gomock.Any(), gomock.Any(), "{}", gomock.Any(), gomock.Any(), gomock.Any(), "").
 			Return(&models.Workflow{}, nil)
 
 		_, err := h.StartWorkflow(context.Background(), &models.StartWorkflowRequest{
@@ -140,4 +229,526 @@ func TestStartWorkflow(t *testing.T) {
 		})
 		assert.NoError(t, err)
 	}
+
+	t.Log("Verify that StartWorkflow rejects input that doesn't match the inputSchema")
+	workflowDefinition.InputSchema = map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"id"},
+	}
+	_, err := store.UpdateWorkflowDefinition(context.Background(), *workflowDefinition)
+	require.NoError(t, err)
+	_, err = h.StartWorkflow(context.Background(), &models.StartWorkflowRequest{
+		Input: `{"other": 1}`,
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:    workflowDefinition.Name,
+			Version: -1,
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+}
+
+func TestWorkflowDefinitionAliases(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	_, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+
+	t.Log("Points an alias at a version")
+	alias, err := h.SetWorkflowDefinitionAlias(ctx, &models.SetWorkflowDefinitionAliasInput{
+		Name:                              workflowDefinition.Name,
+		Alias:                             "stable",
+		SetWorkflowDefinitionAliasRequest: &models.SetWorkflowDefinitionAliasRequest{Version: 0},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), alias.Version)
+
+	t.Log("StartWorkflow uses the version an alias points at")
+	mockWFM.EXPECT().
+		CreateWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, def models.WorkflowDefinition, input, namespace, queue string, tags map[string]interface{}, rolloutArm string) {
+			assert.Equal(t, int64(0), def.Version)
+		}).
+		Return(&models.Workflow{}, nil)
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:    workflowDefinition.Name,
+			Version: -1,
+			Alias:   "stable",
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Log("StartWorkflow fails for an unknown alias")
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		WorkflowDefinition: &models.WorkflowDefinitionRef{Name: workflowDefinition.Name, Alias: "canary"},
+	})
+	assert.IsType(t, models.NotFound{}, err)
+
+	t.Log("StartWorkflow fails for refs with both an alias and a version")
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		WorkflowDefinition: &models.WorkflowDefinitionRef{
+			Name:    workflowDefinition.Name,
+			Version: 1,
+			Alias:   "stable",
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Rejects invalid aliases and unknown versions")
+	_, err = h.SetWorkflowDefinitionAlias(ctx, &models.SetWorkflowDefinitionAliasInput{
+		Name:                              workflowDefinition.Name,
+		Alias:                             "1",
+		SetWorkflowDefinitionAliasRequest: &models.SetWorkflowDefinitionAliasRequest{Version: 0},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.SetWorkflowDefinitionAlias(ctx, &models.SetWorkflowDefinitionAliasInput{
+		Name:                              workflowDefinition.Name,
+		Alias:                             "canary",
+		SetWorkflowDefinitionAliasRequest: &models.SetWorkflowDefinitionAliasRequest{Version: 2},
+	})
+	assert.IsType(t, models.NotFound{}, err)
+
+	t.Log("Lists and deletes aliases")
+	aliases, err := h.GetWorkflowDefinitionAliases(ctx, workflowDefinition.Name)
+	require.NoError(t, err)
+	require.Len(t, aliases, 1)
+	assert.Equal(t, "stable", aliases[0].Alias)
+	require.NoError(t, h.DeleteWorkflowDefinitionAlias(ctx, &models.DeleteWorkflowDefinitionAliasInput{
+		Name:  workflowDefinition.Name,
+		Alias: "stable",
+	}))
+	aliases, err = h.GetWorkflowDefinitionAliases(ctx, workflowDefinition.Name)
+	require.NoError(t, err)
+	assert.Empty(t, aliases)
+	_, err = h.GetWorkflowDefinitionAliases(ctx, "unknown")
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+func TestWorkflowDefinitionRollout(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	_, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+
+	t.Log("Rejects weights outside of 0-100 and unknown versions")
+	_, err = h.SetWorkflowDefinitionRollout(ctx, &models.SetWorkflowDefinitionRolloutInput{
+		Name: workflowDefinition.Name,
+		WorkflowDefinitionRollout: &models.WorkflowDefinitionRollout{
+			BaselineVersion: 0, CanaryVersion: 1, CanaryWeight: 101,
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.SetWorkflowDefinitionRollout(ctx, &models.SetWorkflowDefinitionRolloutInput{
+		Name: workflowDefinition.Name,
+		WorkflowDefinitionRollout: &models.WorkflowDefinitionRollout{
+			BaselineVersion: 0, CanaryVersion: 2, CanaryWeight: 10,
+		},
+	})
+	assert.IsType(t, models.NotFound{}, err)
+
+	startLatest := func(expectedVersion int64, expectedArm string) {
+		mockWFM.EXPECT().
+			CreateWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), expectedArm).
+			Do(func(ctx context.Context, def models.WorkflowDefinition, input, namespace, queue string, tags map[string]interface{}, rolloutArm string) {
+				assert.Equal(t, expectedVersion, def.Version)
+			}).
+			Return(&models.Workflow{}, nil)
+		_, err := h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+			WorkflowDefinition: &models.WorkflowDefinitionRef{Name: workflowDefinition.Name, Version: -1},
+		})
+		require.NoError(t, err)
+	}
+
+	t.Log("Routes workflows started for the latest version to the baseline")
+	rollout, err := h.SetWorkflowDefinitionRollout(ctx, &models.SetWorkflowDefinitionRolloutInput{
+		Name: workflowDefinition.Name,
+		WorkflowDefinitionRollout: &models.WorkflowDefinitionRollout{
+			BaselineVersion: 0, CanaryVersion: 1, CanaryWeight: 0,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, workflowDefinition.Name, rollout.Name)
+	startLatest(0, resources.RolloutArmBaseline)
+
+	t.Log("Routes workflows started for the latest version to the canary")
+	_, err = h.SetWorkflowDefinitionRollout(ctx, &models.SetWorkflowDefinitionRolloutInput{
+		Name: workflowDefinition.Name,
+		WorkflowDefinitionRollout: &models.WorkflowDefinitionRollout{
+			BaselineVersion: 0, CanaryVersion: 1, CanaryWeight: 100,
+		},
+	})
+	require.NoError(t, err)
+	startLatest(1, resources.RolloutArmCanary)
+
+	t.Log("Workflows started for a specific version ignore the rollout")
+	mockWFM.EXPECT().
+		CreateWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), "").
+		Return(&models.Workflow{}, nil)
+	_, err = h.StartWorkflow(ctx, &models.StartWorkflowRequest{
+		WorkflowDefinition: &models.WorkflowDefinitionRef{Name: workflowDefinition.Name, Version: 0},
+	})
+	require.NoError(t, err)
+
+	t.Log("Ends the rollout")
+	require.NoError(t, h.DeleteWorkflowDefinitionRollout(ctx, workflowDefinition.Name))
+	_, err = h.GetWorkflowDefinitionRollout(ctx, workflowDefinition.Name)
+	assert.IsType(t, models.NotFound{}, err)
+}
+
+func TestResumeWorkflowByID(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	fixedDefinition, err := store.UpdateWorkflowDefinition(ctx, *workflowDefinition)
+	require.NoError(t, err)
+
+	workflow := resources.NewWorkflow(workflowDefinition, `{"a": 1}`, "namespace", "queue", map[string]interface{}{})
+	workflow.Status = models.WorkflowStatusFailed
+	startJob := resources.NewJob("job-1", "start-state", "start-state", nil, `{"a": 1, "b": 2}`)
+	startJob.Status = models.JobStatusSucceeded
+	secondJob := resources.NewJob("job-2", "second-state", "second-state", nil, `{"c": 3}`)
+	secondJob.Status = models.JobStatusFailed
+	endJob := resources.NewJob("job-3", "end-state", "end-state", nil, "")
+	endJob.Status = models.JobStatusAbortedDepsFailed
+	workflow.Jobs = []*models.Job{startJob, secondJob, endJob}
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	t.Log("Infers the input from the original job")
+	mockWFM.EXPECT().
+		RetryWorkflow(gomock.Any(), gomock.Any(), *workflowDefinition, "second-state", `{"c": 3}`).
+		Return(&models.Workflow{}, nil)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "second-state"},
+	})
+	assert.NoError(t, err)
+
+	t.Log("Fails to infer the input of a job that never started")
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "end-state"},
+	})
+	assert.Error(t, err)
+
+	t.Log("Uses an explicit input for a job that never started")
+	mockWFM.EXPECT().
+		RetryWorkflow(gomock.Any(), gomock.Any(), gomock.Any(), "end-state", `{"d": 4}`).
+		Return(&models.Workflow{}, nil)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "end-state", Input: `{"d": 4}`},
+	})
+	assert.NoError(t, err)
+
+	t.Log("Applies an input patch on a different definition version, keeping the RetryFor lineage")
+	mockWFM.EXPECT().
+		RetryWorkflow(gomock.Any(), gomock.Any(), fixedDefinition, "start-state", `{"a":1,"e":5}`).
+		Do(func(ctx context.Context, ogWorkflow models.Workflow, def models.WorkflowDefinition, startAt, input string) {
+			assert.Equal(t, workflow.ID, ogWorkflow.ID)
+			assert.Equal(t, workflowDefinition.Version, ogWorkflow.WorkflowDefinition.Version)
+		}).
+		Return(&models.Workflow{}, nil)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides: &models.WorkflowDefinitionOverrides{
+			StartAt:                   "start-state",
+			InputPatch:                `{"b": null, "e": 5}`,
+			WorkflowDefinitionVersion: &fixedDefinition.Version,
+		},
+	})
+	assert.NoError(t, err)
+
+	t.Log("Rejects Input together with InputPatch")
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides: &models.WorkflowDefinitionOverrides{
+			StartAt:    "start-state",
+			Input:      "{}",
+			InputPatch: "{}",
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Fails for an unknown definition version")
+	unknownVersion := int64(100)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides: &models.WorkflowDefinitionOverrides{
+			StartAt:                   "start-state",
+			WorkflowDefinitionVersion: &unknownVersion,
+		},
+	})
+	assert.IsType(t, models.NotFound{}, err)
+
+	t.Log("Validates the input against the inputSchema when resuming at the first state")
+	schemaDefinition := fixedDefinition
+	schemaDefinition.InputSchema = map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"id"},
+	}
+	schemaDefinition, err = store.UpdateWorkflowDefinition(ctx, schemaDefinition)
+	require.NoError(t, err)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides: &models.WorkflowDefinitionOverrides{
+			StartAt:                   "start-state",
+			WorkflowDefinitionVersion: &schemaDefinition.Version,
+		},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Replaces a scheduled automatic retry")
+	retryAt := strfmt.DateTime(time.Now().Add(time.Minute))
+	workflow.AutoRetryAt = &retryAt
+	require.NoError(t, store.UpdateWorkflow(ctx, *workflow))
+	mockWFM.EXPECT().
+		RetryWorkflow(gomock.Any(), gomock.Any(), *workflowDefinition, "second-state", `{"c": 3}`).
+		Do(func(ctx context.Context, ogWorkflow models.Workflow, def models.WorkflowDefinition, startAt, input string) {
+			assert.Nil(t, ogWorkflow.AutoRetryAt)
+		}).
+		Return(&models.Workflow{}, nil)
+	_, err = h.ResumeWorkflowByID(ctx, &models.ResumeWorkflowByIDInput{
+		WorkflowID: workflow.ID,
+		Overrides:  &models.WorkflowDefinitionOverrides{StartAt: "second-state"},
+	})
+	require.NoError(t, err)
+	savedWorkflow, err := store.GetWorkflowByID(ctx, workflow.ID)
+	require.NoError(t, err)
+	assert.Nil(t, savedWorkflow.AutoRetryAt)
+}
+
+func TestResolveWorkflowByID(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	h := Handler{store: store}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	workflow := resources.NewWorkflow(workflowDefinition, "{}", "namespace", "queue", map[string]interface{}{})
+	workflow.Status = models.WorkflowStatusFailed
+	retryAt := strfmt.DateTime(time.Now().Add(time.Minute))
+	workflow.AutoRetryAt = &retryAt
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	t.Log("Resolves the workflow and cancels its scheduled automatic retry")
+	require.NoError(t, h.ResolveWorkflowByID(ctx, workflow.ID))
+	savedWorkflow, err := store.GetWorkflowByID(ctx, workflow.ID)
+	require.NoError(t, err)
+	assert.True(t, savedWorkflow.ResolvedByUser)
+	assert.Nil(t, savedWorkflow.AutoRetryAt)
+
+	t.Log("Conflicts for a workflow that is already resolved")
+	assert.IsType(t, models.Conflict{}, h.ResolveWorkflowByID(ctx, workflow.ID))
+}
+
+func TestSignalWorkflowState(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	state := workflowDefinition.StateMachine.States["second-state"]
+	state.Resource = "callback:approval"
+	workflowDefinition.StateMachine.States["second-state"] = state
+	workflow := resources.NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	workflow.Status = models.WorkflowStatusRunning
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	t.Log("Sends the signal to the workflow manager")
+	signal := &models.SignalRequest{Output: `{"approved": true}`}
+	mockWFM.EXPECT().
+		SignalWorkflow(gomock.Any(), gomock.Any(), "second-state", *signal).
+		Return(nil)
+	assert.NoError(t, h.SignalWorkflowState(ctx, &models.SignalWorkflowStateInput{
+		WorkflowID: workflow.ID,
+		State:      "second-state",
+		Signal:     signal,
+	}))
+
+	t.Log("Rejects states that are not callback states")
+	err := h.SignalWorkflowState(ctx, &models.SignalWorkflowStateInput{
+		WorkflowID: workflow.ID,
+		State:      "start-state",
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Rejects outputs that are not JSON")
+	err = h.SignalWorkflowState(ctx, &models.SignalWorkflowStateInput{
+		WorkflowID: workflow.ID,
+		State:      "second-state",
+		Signal:     &models.SignalRequest{Output: "approved"},
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Rejects signals to workflows that are done")
+	workflow.Status = models.WorkflowStatusSucceeded
+	require.NoError(t, store.UpdateWorkflow(ctx, *workflow))
+	err = h.SignalWorkflowState(ctx, &models.SignalWorkflowStateInput{
+		WorkflowID: workflow.ID,
+		State:      "second-state",
+		Signal:     signal,
+	})
+	assert.IsType(t, models.Conflict{}, err)
+}
+
+func TestEvaluatePath(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	workflowDefinition.StateMachine.States["second-state"] = models.SLState{
+		Type: models.SLStateTypeChoice,
+		Choices: []*models.SLChoice{
+			{Variable: "$.skip", BooleanEquals: swag.Bool(true), Next: "end-state"},
+		},
+		Default: "start-state",
+	}
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+
+	t.Log("Evaluates definitions by ref")
+	evaluation, err := h.EvaluatePath(ctx, &models.PathEvaluationRequest{
+		WorkflowDefinition: &models.WorkflowDefinitionRef{Name: workflowDefinition.Name, Version: -1},
+		Input:              `{"skip": true}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusSucceeded, evaluation.Status)
+	assert.Len(t, evaluation.Steps, 3)
+
+	t.Log("Evaluates inline state machines")
+	evaluation, err = h.EvaluatePath(ctx, &models.PathEvaluationRequest{
+		StateMachine: workflowDefinition.StateMachine,
+		Input:        `{"skip": false}`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusIncomplete, evaluation.Status)
+
+	t.Log("Rejects requests without a definition or with invalid input")
+	_, err = h.EvaluatePath(ctx, &models.PathEvaluationRequest{Input: `{}`})
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.EvaluatePath(ctx, &models.PathEvaluationRequest{
+		StateMachine: workflowDefinition.StateMachine,
+		Input:        `{`,
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Explains the choices of a workflow from its recorded jobs")
+	workflow := resources.NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+	mockWFM.EXPECT().
+		UpdateWorkflowHistory(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, workflow *models.Workflow) {
+			workflow.Jobs = []*models.Job{
+				{ID: "job-1", State: "start-state", Input: `{}`},
+				{ID: "job-2", State: "second-state", Input: `{"skip": false}`},
+				{ID: "job-3", State: "start-state", Input: `{"skip": false}`},
+				{ID: "job-4", State: "second-state", Input: `{"skip": true}`},
+			}
+		}).
+		Return(nil)
+	choices, err := h.GetWorkflowChoices(ctx, workflow.ID)
+	require.NoError(t, err)
+	require.Len(t, choices, 2)
+	assert.Equal(t, "job-2", choices[0].JobID)
+	assert.Equal(t, "start-state", choices[0].Next)
+	assert.Nil(t, choices[0].MatchedRule)
+	assert.Equal(t, "job-4", choices[1].JobID)
+	assert.Equal(t, "end-state", choices[1].Next)
+	assert.Equal(t, `$.skip (true) BooleanEquals true = true`, choices[1].Rules[0].Explanation)
+}
+
+func TestWorkflowGraphs(t *testing.T) {
+	mockController := gomock.NewController(t)
+	defer mockController.Finish()
+
+	ctx := context.Background()
+	store := memory.New()
+	mockWFM := mocks.NewMockWorkflowManager(mockController)
+	h := Handler{
+		manager: mockWFM,
+		store:   store,
+	}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+
+	t.Log("Renders definitions as DOT by default")
+	graph, err := h.GetWorkflowDefinitionGraph(ctx, &models.GetWorkflowDefinitionGraphInput{
+		Name:    workflowDefinition.Name,
+		Version: 0,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.GraphFormatDOT, graph.Format)
+	assert.Contains(t, graph.Graph, "digraph")
+
+	t.Log("Rejects unknown formats")
+	_, err = h.GetWorkflowDefinitionGraph(ctx, &models.GetWorkflowDefinitionGraphInput{
+		Name:    workflowDefinition.Name,
+		Version: 0,
+		Format:  swag.String("png"),
+	})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	t.Log("Colors the states of workflows by the status of their jobs")
+	workflow := resources.NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+	mockWFM.EXPECT().
+		UpdateWorkflowHistory(gomock.Any(), gomock.Any()).
+		Do(func(ctx context.Context, workflow *models.Workflow) {
+			workflow.Jobs = []*models.Job{{State: "start-state", Status: models.JobStatusFailed}}
+		}).
+		Return(nil)
+	graph, err = h.GetWorkflowGraph(ctx, &models.GetWorkflowGraphInput{
+		WorkflowID: workflow.ID,
+		Format:     swag.String("mermaid"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.GraphFormatMermaid, graph.Format)
+	assert.Contains(t, graph.Graph, "class s2 failed")
 }
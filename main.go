@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/kardianos/osext"
@@ -32,6 +33,9 @@ type Config struct {
 	DynamoPrefixStateResources      string
 	DynamoPrefixWorkflowDefinitions string
 	DynamoPrefixWorkflows           string
+	DynamoPrefixBulkOperations      string
+	DynamoPrefixTaskTokens          string
+	DynamoPrefixWorkers             string
 	DynamoRegion                    string
 	SFNRegion                       string
 	SFNAccountID                    string
@@ -66,6 +70,9 @@ func main() {
 		PrefixStateResources:      c.DynamoPrefixStateResources,
 		PrefixWorkflowDefinitions: c.DynamoPrefixWorkflowDefinitions,
 		PrefixWorkflows:           c.DynamoPrefixWorkflows,
+		PrefixBulkOperations:      c.DynamoPrefixBulkOperations,
+		PrefixTaskTokens:          c.DynamoPrefixTaskTokens,
+		PrefixWorkers:             c.DynamoPrefixWorkers,
 	})
 	var err error
 	db.Future, err = dynamodbgen.New(dynamodbgen.Config{
@@ -87,34 +94,48 @@ func main() {
 	}
 
 	sqsapi := sqs.New(session.New(), aws.NewConfig().WithRegion(c.SQSRegion))
-	wfmSFN := executor.NewSFNWorkflowManager(cachedSFNAPI, sqsapi, db, c.SFNRoleARN, c.SFNRegion, c.SFNAccountID, c.SQSQueueURL)
+	lambdaapi := lambda.New(session.New(), aws.NewConfig().WithRegion(c.SFNRegion))
+	wfmSFN := executor.NewSFNWorkflowManager(cachedSFNAPI, sqsapi, lambdaapi, db, c.SFNRoleARN, c.SFNRegion, c.SFNAccountID, c.SQSQueueURL)
+	events := newWorkflowEvents(db, 5*time.Second)
 	h := Handler{
 		store:   db,
 		manager: wfmSFN,
+		events:  events,
 	}
+	s := newServer(h, *addr)
+
+	go executor.PollForPendingWorkflowsAndUpdateStore(context.Background(), wfmSFN, db, sqsapi, c.SQSQueueURL, events)
+	go wfmSFN.PollForCallbackTasks(context.Background())
+	go logSFNCounts(countedSFNAPI)
+	go h.ResumeBulkOperations(logger.NewContext(context.Background(), logger.New("workflow-manager")))
+
+	if err := s.Serve(); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("workflow-manager exited without error")
+}
+
+// newServer returns the server of a handler, with requests other than event streams timed out.
+func newServer(h Handler, addr string) *server.Server {
 	timeout := 5 * time.Second
-	s := server.NewWithMiddleware(h, *addr, []func(http.Handler) http.Handler{
-		func(handler http.Handler) http.Handler {
+	s := server.NewWithMiddleware(h, addr, []func(http.Handler) http.Handler{
+		exceptEventStreams(func(handler http.Handler) http.Handler {
 			return http.TimeoutHandler(handler, timeout, "Request timed out")
-		},
-		func(handler http.Handler) http.Handler {
+		}),
+		exceptEventStreams(func(handler http.Handler) http.Handler {
 			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				newCtx, cancel := context.WithTimeout(r.Context(), timeout)
 				defer cancel()
 				r = r.WithContext(newCtx)
 				handler.ServeHTTP(w, r)
 			})
-		},
+		}),
+		yamlWorkflowDefinitions,
+		writeEventStreams,
 	})
-
-	go executor.PollForPendingWorkflowsAndUpdateStore(context.Background(), wfmSFN, db, sqsapi, c.SQSQueueURL)
-	go logSFNCounts(countedSFNAPI)
-
-	if err := s.Serve(); err != nil {
-		log.Fatal(err)
-	}
-
-	log.Println("workflow-manager exited without error")
+	s.Handler = flushEventStreams(s.Handler)
+	return s
 }
 
 func awsSession(c Config) *session.Session {
@@ -140,6 +161,18 @@ func loadConfig() Config {
 			"AWS_DYNAMO_PREFIX_WORKFLOWS",
 			"workflow-manager-test",
 		),
+		DynamoPrefixBulkOperations: getEnvVarOrDefault(
+			"AWS_DYNAMO_PREFIX_BULK_OPERATIONS",
+			"workflow-manager-test",
+		),
+		DynamoPrefixTaskTokens: getEnvVarOrDefault(
+			"AWS_DYNAMO_PREFIX_TASK_TOKENS",
+			"workflow-manager-test",
+		),
+		DynamoPrefixWorkers: getEnvVarOrDefault(
+			"AWS_DYNAMO_PREFIX_WORKERS",
+			"workflow-manager-test",
+		),
 		DynamoRegion: os.Getenv("AWS_DYNAMO_REGION"),
 		SFNRegion:    os.Getenv("AWS_SFN_REGION"),
 		SFNAccountID: os.Getenv("AWS_SFN_ACCOUNT_ID"),
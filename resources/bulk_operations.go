@@ -0,0 +1,21 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+	uuid "github.com/satori/go.uuid"
+)
+
+// NewBulkOperation creates a new, running BulkOperation for a BulkOperationRequest
+func NewBulkOperation(req *models.BulkOperationRequest) *models.BulkOperation {
+	return &models.BulkOperation{
+		ID:          uuid.NewV4().String(),
+		CreatedAt:   strfmt.DateTime(time.Now()),
+		LastUpdated: strfmt.DateTime(time.Now()),
+		Request:     req,
+		Status:      models.BulkOperationStatusRunning,
+		Results:     []*models.BulkOperationResult{},
+	}
+}
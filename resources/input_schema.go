@@ -0,0 +1,52 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// ValidateInputSchema checks that a workflow definition's inputSchema is a valid JSON Schema.
+func ValidateInputSchema(schema interface{}) error {
+	if schema == nil {
+		return nil
+	}
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(schema)); err != nil {
+		return fmt.Errorf("invalid inputSchema: %s", err)
+	}
+	return nil
+}
+
+// ValidateInput checks a workflow's input against its definition's inputSchema. The error lists
+// every violation.
+func ValidateInput(def models.WorkflowDefinition, input string) error {
+	if def.InputSchema == nil {
+		return nil
+	}
+	violations, err := schemaViolations(def.InputSchema, input)
+	if err != nil {
+		return fmt.Errorf("input could not be validated against the inputSchema: %s", err)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf("input does not match the inputSchema of %s: %s", def.Name, strings.Join(violations, "; "))
+}
+
+// schemaViolations validates a JSON document against a JSON Schema, returning every violation.
+func schemaViolations(schema interface{}, document string) ([]string, error) {
+	result, err := gojsonschema.Validate(
+		gojsonschema.NewGoLoader(schema),
+		gojsonschema.NewStringLoader(document),
+	)
+	if err != nil {
+		return nil, err
+	}
+	violations := []string{}
+	for _, violation := range result.Errors() {
+		violations = append(violations, violation.String())
+	}
+	return violations, nil
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateInput(t *testing.T) {
+	def := models.WorkflowDefinition{
+		Name: "test-workflow",
+		InputSchema: map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"id", "count"},
+			"properties": map[string]interface{}{
+				"id":    map[string]interface{}{"type": "string"},
+				"count": map[string]interface{}{"type": "integer", "minimum": 1},
+			},
+		},
+	}
+	require.NoError(t, ValidateInputSchema(def.InputSchema))
+
+	t.Log("Valid input passes")
+	assert.NoError(t, ValidateInput(def, `{"id": "a", "count": 2}`))
+
+	t.Log("Every violation is listed")
+	err := ValidateInput(def, `{"count": 0}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "id")
+	assert.Contains(t, err.Error(), "count")
+
+	t.Log("Definitions without a schema accept any input")
+	assert.NoError(t, ValidateInput(models.WorkflowDefinition{}, `{"anything": true}`))
+
+	t.Log("Invalid schemas are rejected")
+	assert.Error(t, ValidateInputSchema(map[string]interface{}{"type": 1}))
+}
@@ -46,4 +46,8 @@ const (
 
 	// StatusReasonWorkflowTimedOut contains extra information for status reasons for workflows & jobs
 	StatusReasonWorkflowTimedOut = "Workflow timed out"
+
+	// StatusReasonWorkflowMaxAgeExceeded is the reason given when cancelling workflows that are
+	// still active after their WorkflowDeadlines' MaxAgeSeconds
+	StatusReasonWorkflowMaxAgeExceeded = "Workflow exceeded its max age"
 )
@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// ValidateOutputSchemas checks that a workflow definition's outputSchemas are valid JSON Schemas
+// for Task states of its state machine.
+func ValidateOutputSchemas(stateMachine *models.SLStateMachine, schemas map[string]interface{}) error {
+	for stateName, schema := range schemas {
+		state, ok := stateMachine.States[stateName]
+		if !ok {
+			return fmt.Errorf("outputSchemas: unknown state %s", stateName)
+		}
+		if state.Type != models.SLStateTypeTask {
+			return fmt.Errorf("outputSchemas: state %s is a %s state, not a Task state", stateName, state.Type)
+		}
+		if err := ValidateInputSchema(schema); err != nil {
+			return fmt.Errorf("outputSchemas: state %s: %s", stateName, err)
+		}
+	}
+	return nil
+}
+
+// OutputContractViolation checks the output of a Task state against the state's output schema,
+// returning why it doesn't match, or "" if it does or the state has no output schema.
+func OutputContractViolation(def models.WorkflowDefinition, stateName, output string) string {
+	schema, ok := def.OutputSchemas[stateName]
+	if !ok || schema == nil {
+		return ""
+	}
+	violations, err := schemaViolations(schema, output)
+	if err != nil {
+		return fmt.Sprintf("output could not be validated against the output schema: %s", err)
+	}
+	return strings.Join(violations, "; ")
+}
@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputSchemas(t *testing.T) {
+	def := models.WorkflowDefinition{
+		StateMachine: &models.SLStateMachine{
+			States: map[string]models.SLState{
+				"task":   {Type: models.SLStateTypeTask, Resource: "worker"},
+				"choice": {Type: models.SLStateTypeChoice},
+			},
+		},
+		OutputSchemas: map[string]interface{}{
+			"task": map[string]interface{}{"type": "object", "required": []interface{}{"id"}},
+		},
+	}
+	assert.NoError(t, ValidateOutputSchemas(def.StateMachine, def.OutputSchemas))
+
+	t.Log("Schemas must be for Task states")
+	assert.Error(t, ValidateOutputSchemas(def.StateMachine, map[string]interface{}{"unknown": map[string]interface{}{}}))
+	assert.Error(t, ValidateOutputSchemas(def.StateMachine, map[string]interface{}{"choice": map[string]interface{}{}}))
+
+	t.Log("Output is checked against the state's schema")
+	assert.Empty(t, OutputContractViolation(def, "task", `{"id": "a"}`))
+	assert.Contains(t, OutputContractViolation(def, "task", `{}`), "id is required")
+	assert.Empty(t, OutputContractViolation(def, "choice", `{}`))
+}
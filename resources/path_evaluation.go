@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// maxPathSteps bounds the evaluation of state machines whose path loops forever for an input.
+const maxPathSteps = 1000
+
+const (
+	errorStatesRuntime         = "States.Runtime"
+	errorStatesNoChoiceMatched = "States.NoChoiceMatched"
+)
+
+// EvaluatePath evaluates the path that an input takes through a state machine, including the
+// InputPath, ResultPath and OutputPath of each state. Task states output their input, or their
+// stub output if there is one. Errors in the state machine or its paths fail the evaluation the
+// way they would fail an execution, while an invalid input or stub output is returned as an error.
+func EvaluatePath(stateMachine *models.SLStateMachine, input string, stubOutputs map[string]string) (*models.PathEvaluation, error) {
+	data, err := decodeJSON(input, "input")
+	if err != nil {
+		return nil, err
+	}
+	stubs := map[string]interface{}{}
+	for stateName, stub := range stubOutputs {
+		if stubs[stateName], err = decodeJSON(stub, "stub output of "+stateName); err != nil {
+			return nil, err
+		}
+	}
+
+	evaluation := &models.PathEvaluation{Steps: []*models.PathStep{}}
+	fail := func(errorName, cause string) (*models.PathEvaluation, error) {
+		evaluation.Status = models.PathEvaluationStatusFailed
+		evaluation.Error = errorName
+		evaluation.Cause = cause
+		return evaluation, nil
+	}
+
+	stateName := stateMachine.StartAt
+	for len(evaluation.Steps) < maxPathSteps {
+		state, ok := stateMachine.States[stateName]
+		if !ok {
+			return fail(errorStatesRuntime, fmt.Sprintf("state %s does not exist", stateName))
+		}
+		step := &models.PathStep{State: stateName, Type: state.Type, Input: encodeJSON(data)}
+		evaluation.Steps = append(evaluation.Steps, step)
+
+		effectiveInput, err := getReferencePath(data, state.InputPath)
+		if err != nil {
+			return fail(errorStatesRuntime, fmt.Sprintf("InputPath of %s: %s", stateName, err))
+		}
+
+		var output interface{}
+		switch state.Type {
+		case models.SLStateTypeTask, models.SLStateTypePass:
+			var result interface{} = effectiveInput
+			if stub, ok := stubs[stateName]; ok && state.Type == models.SLStateTypeTask {
+				result = stub
+			} else if state.Result != "" && state.Type == models.SLStateTypePass {
+				result = state.Result
+			}
+			if output, err = setReferencePath(data, state.ResultPath, result); err != nil {
+				return fail(errorStatesRuntime, fmt.Sprintf("ResultPath of %s: %s", stateName, err))
+			}
+		case models.SLStateTypeChoice:
+			choice, err := EvaluateChoiceState(stateName, state, effectiveInput)
+			step.Choice = choice
+			if err != nil {
+				return fail(errorStatesRuntime, fmt.Sprintf("Choice %s: %s", stateName, err))
+			}
+			if choice.Next == "" {
+				return fail(errorStatesNoChoiceMatched, fmt.Sprintf("no rule of %s matched and it has no Default", stateName))
+			}
+			output = effectiveInput
+		case models.SLStateTypeWait, models.SLStateTypeSucceed:
+			output = effectiveInput
+		case models.SLStateTypeFail:
+			return fail(state.Error, state.Cause)
+		default:
+			evaluation.Status = models.PathEvaluationStatusIncomplete
+			evaluation.Cause = fmt.Sprintf("%s states can't be evaluated", state.Type)
+			return evaluation, nil
+		}
+
+		if output, err = getReferencePath(output, state.OutputPath); err != nil {
+			return fail(errorStatesRuntime, fmt.Sprintf("OutputPath of %s: %s", stateName, err))
+		}
+		step.Output = encodeJSON(output)
+		data = output
+
+		if state.Type == models.SLStateTypeSucceed || state.End {
+			evaluation.Status = models.PathEvaluationStatusSucceeded
+			evaluation.Output = step.Output
+			return evaluation, nil
+		}
+		if state.Type == models.SLStateTypeChoice {
+			step.Next = step.Choice.Next
+		} else {
+			step.Next = state.Next
+		}
+		stateName = step.Next
+	}
+
+	evaluation.Status = models.PathEvaluationStatusIncomplete
+	evaluation.Cause = fmt.Sprintf("the path is longer than %d states", maxPathSteps)
+	return evaluation, nil
+}
+
+// ChoiceRuleError is returned along with the evaluation of a Choice state when one of its rules
+// can't be evaluated, e.g. because its Variable doesn't exist. The rule's explanation describes it.
+type ChoiceRuleError struct {
+	Rule int
+	err  error
+}
+
+// Error implements the error interface.
+func (e ChoiceRuleError) Error() string {
+	return e.err.Error()
+}
+
+// EvaluateChoiceInput evaluates a Choice state against the raw JSON input it was entered with,
+// e.g. the recorded input of a job. The evaluation is nil if the input can't be decoded or the
+// state's InputPath can't be applied to it.
+func EvaluateChoiceInput(stateName string, state models.SLState, input string) (*models.ChoiceEvaluation, error) {
+	data, err := decodeJSON(input, "input")
+	if err != nil {
+		return nil, err
+	}
+	effectiveInput, err := getReferencePath(data, state.InputPath)
+	if err != nil {
+		return nil, fmt.Errorf("InputPath of %s: %s", stateName, err)
+	}
+	return EvaluateChoiceState(stateName, state, effectiveInput)
+}
+
+// EvaluateChoiceState evaluates the rules of a Choice state in order against its input, after the
+// state's InputPath, until one matches. If none match, the state's Default is the next state. A rule
+// that can't be evaluated stops the evaluation with a ChoiceRuleError.
+func EvaluateChoiceState(stateName string, state models.SLState, input interface{}) (*models.ChoiceEvaluation, error) {
+	evaluation := &models.ChoiceEvaluation{
+		State: stateName,
+		Input: encodeJSON(input),
+		Rules: []*models.ChoiceRuleEvaluation{},
+	}
+	for i, rule := range state.Choices {
+		matched, explanation, err := evaluateChoiceRule(rule, input)
+		if err != nil {
+			explanation = err.Error()
+		}
+		evaluation.Rules = append(evaluation.Rules, &models.ChoiceRuleEvaluation{
+			Matched:     matched,
+			Explanation: explanation,
+			Next:        rule.Next,
+		})
+		if err != nil {
+			return evaluation, ChoiceRuleError{Rule: i, err: err}
+		}
+		if matched {
+			index := int64(i)
+			evaluation.MatchedRule = &index
+			evaluation.Next = rule.Next
+			return evaluation, nil
+		}
+	}
+	evaluation.Next = state.Default
+	return evaluation, nil
+}
+
+// evaluateChoiceRule evaluates a Choice rule, explaining the result, e.g.
+// "$.count (5) NumericGreaterThan 3 = true". Like SFN, a comparison of values of different types
+// doesn't match, but a Variable that doesn't exist is an error.
+func evaluateChoiceRule(rule *models.SLChoice, input interface{}) (bool, string, error) {
+	switch {
+	case len(rule.And) > 0 || len(rule.Or) > 0:
+		rules, operator := rule.And, "And"
+		if len(rule.Or) > 0 {
+			rules, operator = rule.Or, "Or"
+		}
+		explanations := []string{}
+		matched := operator == "And"
+		for _, r := range rules {
+			m, explanation, err := evaluateChoiceRule(r, input)
+			if err != nil {
+				return false, "", err
+			}
+			explanations = append(explanations, explanation)
+			// stop at the first rule that decides the result, like SFN
+			if m != matched {
+				matched = m
+				break
+			}
+		}
+		return matched, fmt.Sprintf("%s(%s) = %t", operator, strings.Join(explanations, ", "), matched), nil
+	case rule.Not != nil:
+		m, explanation, err := evaluateChoiceRule(rule.Not, input)
+		if err != nil {
+			return false, "", err
+		}
+		return !m, fmt.Sprintf("Not(%s) = %t", explanation, !m), nil
+	}
+
+	value, err := getReferencePath(input, rule.Variable)
+	if err != nil {
+		return false, "", fmt.Errorf("invalid Variable %s: %s", rule.Variable, err)
+	}
+	comparator, expected, matched, err := compareChoiceValue(rule, value)
+	if err != nil {
+		return false, "", err
+	}
+	return matched, fmt.Sprintf("%s (%s) %s %s = %t",
+		rule.Variable, encodeJSON(value), comparator, encodeJSON(expected), matched), nil
+}
+
+// compareChoiceValue applies the comparator of a Choice rule to a value.
+func compareChoiceValue(rule *models.SLChoice, value interface{}) (string, interface{}, bool, error) {
+	s, isString := value.(string)
+	n, isNumber := value.(float64)
+	b, isBool := value.(bool)
+	t, isTimestamp := time.Time{}, false
+	if isString {
+		if parsed, err := time.Parse(time.RFC3339, s); err == nil {
+			t, isTimestamp = parsed, true
+		}
+	}
+
+	switch {
+	case rule.StringEquals != nil:
+		return "StringEquals", *rule.StringEquals, isString && s == *rule.StringEquals, nil
+	case rule.StringLessThan != nil:
+		return "StringLessThan", *rule.StringLessThan, isString && s < *rule.StringLessThan, nil
+	case rule.StringGreaterThan != nil:
+		return "StringGreaterThan", *rule.StringGreaterThan, isString && s > *rule.StringGreaterThan, nil
+	case rule.StringLessThanEquals != nil:
+		return "StringLessThanEquals", *rule.StringLessThanEquals, isString && s <= *rule.StringLessThanEquals, nil
+	case rule.StringGreaterThanEquals != nil:
+		return "StringGreaterThanEquals", *rule.StringGreaterThanEquals, isString && s >= *rule.StringGreaterThanEquals, nil
+	case rule.NumericEquals != nil:
+		return "NumericEquals", *rule.NumericEquals, isNumber && n == float64(*rule.NumericEquals), nil
+	case rule.NumericLessThan != nil:
+		return "NumericLessThan", *rule.NumericLessThan, isNumber && n < *rule.NumericLessThan, nil
+	case rule.NumericGreaterThan != nil:
+		return "NumericGreaterThan", *rule.NumericGreaterThan, isNumber && n > *rule.NumericGreaterThan, nil
+	case rule.NumericLessThanEquals != nil:
+		return "NumericLessThanEquals", *rule.NumericLessThanEquals, isNumber && n <= float64(*rule.NumericLessThanEquals), nil
+	case rule.NumericGreaterThanEquals != nil:
+		return "NumericGreaterThanEquals", *rule.NumericGreaterThanEquals, isNumber && n >= float64(*rule.NumericGreaterThanEquals), nil
+	case rule.BooleanEquals != nil:
+		return "BooleanEquals", *rule.BooleanEquals, isBool && b == *rule.BooleanEquals, nil
+	case rule.TimestampEquals != nil:
+		expected := time.Time(*rule.TimestampEquals)
+		return "TimestampEquals", rule.TimestampEquals, isTimestamp && t.Equal(expected), nil
+	case rule.TimestampLessThan != nil:
+		expected := time.Time(*rule.TimestampLessThan)
+		return "TimestampLessThan", rule.TimestampLessThan, isTimestamp && t.Before(expected), nil
+	case rule.TimestampGreaterThan != nil:
+		expected := time.Time(*rule.TimestampGreaterThan)
+		return "TimestampGreaterThan", rule.TimestampGreaterThan, isTimestamp && t.After(expected), nil
+	case rule.TimestampLessThanEquals != nil:
+		expected := time.Time(*rule.TimestampLessThanEquals)
+		return "TimestampLessThanEquals", rule.TimestampLessThanEquals, isTimestamp && !t.After(expected), nil
+	case rule.TimestampGreaterThanEquals != nil:
+		expected := time.Time(*rule.TimestampGreaterThanEquals)
+		return "TimestampGreaterThanEquals", rule.TimestampGreaterThanEquals, isTimestamp && !t.Before(expected), nil
+	}
+	return "", nil, false, fmt.Errorf("rule for %s has no comparison", rule.Variable)
+}
+
+func decodeJSON(doc, name string) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(doc), &value); err != nil {
+		return nil, fmt.Errorf("%s is not valid JSON: %s", name, err)
+	}
+	return value, nil
+}
+
+func encodeJSON(value interface{}) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(encoded)
+}
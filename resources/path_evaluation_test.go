@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func choicePathStateMachine() *models.SLStateMachine {
+	return &models.SLStateMachine{
+		StartAt: "fetch",
+		States: map[string]models.SLState{
+			"fetch": {
+				Type:       models.SLStateTypeTask,
+				Resource:   "fetcher",
+				ResultPath: "$.fetched",
+				Next:       "route",
+			},
+			"route": {
+				Type: models.SLStateTypeChoice,
+				Choices: []*models.SLChoice{
+					{
+						And: []*models.SLChoice{
+							{Variable: "$.fetched.count", NumericGreaterThan: swag.Float64(3)},
+							{Variable: "$.kind", StringEquals: swag.String("bulk")},
+						},
+						Next: "bulk",
+					},
+					{Variable: "$.fetched.count", NumericEquals: swag.Int64(0), Next: "empty"},
+				},
+				Default: "single",
+			},
+			"bulk":   {Type: models.SLStateTypeSucceed},
+			"single": {Type: models.SLStateTypePass, Result: "single", ResultPath: "$.result", OutputPath: "$.result", End: true},
+			"empty":  {Type: models.SLStateTypeFail, Error: "Empty", Cause: "nothing fetched"},
+		},
+	}
+}
+
+func TestEvaluatePath(t *testing.T) {
+	sm := choicePathStateMachine()
+
+	t.Log("Task states output their input unless they have a stub output")
+	evaluation, err := EvaluatePath(sm, `{"kind": "bulk"}`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusFailed, evaluation.Status)
+	assert.Equal(t, "States.Runtime", evaluation.Error)
+	assert.Contains(t, evaluation.Cause, "$.fetched.count not found")
+	require.Len(t, evaluation.Steps, 2)
+	assert.Equal(t, `{"fetched":{"kind":"bulk"},"kind":"bulk"}`, evaluation.Steps[0].Output)
+
+	t.Log("Choice rules are evaluated in order with their explanations")
+	evaluation, err = EvaluatePath(sm, `{"kind": "bulk"}`, map[string]string{"fetch": `{"count": 5}`})
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusSucceeded, evaluation.Status)
+	require.Len(t, evaluation.Steps, 3)
+	choice := evaluation.Steps[1].Choice
+	require.NotNil(t, choice)
+	assert.Equal(t, int64(0), *choice.MatchedRule)
+	assert.Equal(t, "bulk", choice.Next)
+	assert.Equal(t,
+		`And($.fetched.count (5) NumericGreaterThan 3 = true, $.kind ("bulk") StringEquals "bulk" = true) = true`,
+		choice.Rules[0].Explanation)
+	assert.Equal(t, "bulk", evaluation.Steps[2].State)
+
+	t.Log("Fail states fail the evaluation")
+	evaluation, err = EvaluatePath(sm, `{"kind": "bulk"}`, map[string]string{"fetch": `{"count": 0}`})
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusFailed, evaluation.Status)
+	assert.Equal(t, "Empty", evaluation.Error)
+	assert.Len(t, evaluation.Steps[1].Choice.Rules, 2)
+
+	t.Log("The Default is taken if no rule matches, and paths shape the output")
+	evaluation, err = EvaluatePath(sm, `{"kind": "single"}`, map[string]string{"fetch": `{"count": 5}`})
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusSucceeded, evaluation.Status)
+	assert.Nil(t, evaluation.Steps[1].Choice.MatchedRule)
+	assert.Equal(t, "single", evaluation.Steps[1].Next)
+	assert.Equal(t, `"single"`, evaluation.Output)
+
+	t.Log("Without a Default, no matching rule fails the evaluation")
+	route := sm.States["route"]
+	route.Default = ""
+	sm.States["route"] = route
+	evaluation, err = EvaluatePath(sm, `{"kind": "single"}`, map[string]string{"fetch": `{"count": 5}`})
+	require.NoError(t, err)
+	assert.Equal(t, "States.NoChoiceMatched", evaluation.Error)
+
+	t.Log("Invalid input or stub outputs are errors")
+	_, err = EvaluatePath(sm, `{`, nil)
+	assert.Error(t, err)
+	_, err = EvaluatePath(sm, `{}`, map[string]string{"fetch": `{`})
+	assert.Error(t, err)
+
+	t.Log("Paths that loop forever are incomplete")
+	loop := &models.SLStateMachine{
+		StartAt: "wait",
+		States: map[string]models.SLState{
+			"wait": {Type: models.SLStateTypeWait, Seconds: 1, Next: "wait"},
+		},
+	}
+	evaluation, err = EvaluatePath(loop, `{}`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, models.PathEvaluationStatusIncomplete, evaluation.Status)
+	assert.Len(t, evaluation.Steps, maxPathSteps)
+}
+
+func TestEvaluateChoiceRule(t *testing.T) {
+	at := strfmt.DateTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	input := map[string]interface{}{
+		"name":    "b",
+		"count":   float64(2),
+		"enabled": true,
+		"at":      "2020-06-01T00:00:00Z",
+	}
+	for _, test := range []struct {
+		rule    models.SLChoice
+		matched bool
+	}{
+		{models.SLChoice{Variable: "$.name", StringLessThan: swag.String("c")}, true},
+		{models.SLChoice{Variable: "$.name", StringGreaterThanEquals: swag.String("c")}, false},
+		{models.SLChoice{Variable: "$.count", NumericLessThanEquals: swag.Int64(2)}, true},
+		{models.SLChoice{Variable: "$.count", NumericLessThan: swag.Float64(1.5)}, false},
+		{models.SLChoice{Variable: "$.enabled", BooleanEquals: swag.Bool(true)}, true},
+		{models.SLChoice{Variable: "$.at", TimestampGreaterThan: &at}, true},
+		{models.SLChoice{Variable: "$.at", TimestampLessThanEquals: &at}, false},
+		// values of another type never match
+		{models.SLChoice{Variable: "$.name", NumericEquals: swag.Int64(0)}, false},
+		{models.SLChoice{Not: &models.SLChoice{Variable: "$.enabled", BooleanEquals: swag.Bool(false)}}, true},
+		{models.SLChoice{Or: []*models.SLChoice{
+			{Variable: "$.enabled", BooleanEquals: swag.Bool(false)},
+			{Variable: "$.count", NumericGreaterThanEquals: swag.Int64(2)},
+		}}, true},
+	} {
+		matched, explanation, err := evaluateChoiceRule(&test.rule, input)
+		require.NoError(t, err)
+		assert.Equal(t, test.matched, matched, explanation)
+	}
+
+	_, _, err := evaluateChoiceRule(&models.SLChoice{Variable: "$.missing", BooleanEquals: swag.Bool(true)}, input)
+	assert.Error(t, err)
+
+	t.Log("Choice states report rules that can't be evaluated apart from invalid input")
+	evaluation, err := EvaluateChoiceState("choice", models.SLState{
+		Choices: []*models.SLChoice{{Variable: "$.missing", BooleanEquals: swag.Bool(true), Next: "next"}},
+	}, input)
+	require.IsType(t, ChoiceRuleError{}, err)
+	assert.Equal(t, 0, err.(ChoiceRuleError).Rule)
+	assert.Equal(t, err.Error(), evaluation.Rules[0].Explanation)
+	_, err = EvaluateChoiceInput("choice", models.SLState{}, "not json")
+	require.Error(t, err)
+	_, ok := err.(ChoiceRuleError)
+	assert.False(t, ok)
+}
+
+func TestReferencePaths(t *testing.T) {
+	doc := map[string]interface{}{
+		"a": map[string]interface{}{"b c": []interface{}{"x", "y"}},
+	}
+	value, err := getReferencePath(doc, "$.a['b c'][1]")
+	require.NoError(t, err)
+	assert.Equal(t, "y", value)
+	_, err = getReferencePath(doc, "$.a.d")
+	assert.Error(t, err)
+	_, err = getReferencePath(doc, "a")
+	assert.Error(t, err)
+
+	updated, err := setReferencePath(doc, "$.x.y", "z")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"y": "z"}, updated.(map[string]interface{})["x"])
+	assert.NotContains(t, doc, "x", "the document is copied")
+}
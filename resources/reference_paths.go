@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseReferencePath splits a States Language reference path like "$.a.b[0]['c d']" into its
+// object keys (strings) and array indexes (ints). The empty path is the same as "$".
+func parseReferencePath(path string) ([]interface{}, error) {
+	if path == "" || path == "$" {
+		return []interface{}{}, nil
+	}
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid path %s, paths must start with $", path)
+	}
+
+	segments := []interface{}{}
+	rest := path[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %s, unterminated ['", path)
+			}
+			segments = append(segments, rest[2:end])
+			rest = rest[end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %s, unterminated [", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid path %s, %s is not an array index", path, rest[1:end])
+			}
+			segments = append(segments, index)
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key := rest[1 : end+1]
+			if key == "" {
+				return nil, fmt.Errorf("invalid path %s, empty key", path)
+			}
+			segments = append(segments, key)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid path %s at %s", path, rest)
+		}
+	}
+	return segments, nil
+}
+
+// getReferencePath gets the value at a reference path in a JSON document.
+func getReferencePath(doc interface{}, path string) (interface{}, error) {
+	segments, err := parseReferencePath(path)
+	if err != nil {
+		return nil, err
+	}
+	value := doc
+	for _, segment := range segments {
+		switch s := segment.(type) {
+		case string:
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("path %s not found", path)
+			}
+			if value, ok = obj[s]; !ok {
+				return nil, fmt.Errorf("path %s not found", path)
+			}
+		case int:
+			arr, ok := value.([]interface{})
+			if !ok || s >= len(arr) {
+				return nil, fmt.Errorf("path %s not found", path)
+			}
+			value = arr[s]
+		}
+	}
+	return value, nil
+}
+
+// setReferencePath returns a JSON document with the value at a reference path replaced, like a
+// ResultPath. Missing objects along the path are created.
+func setReferencePath(doc interface{}, path string, value interface{}) (interface{}, error) {
+	segments, err := parseReferencePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return setSegments(doc, segments, value, path)
+}
+
+func setSegments(doc interface{}, segments []interface{}, value interface{}, path string) (interface{}, error) {
+	if len(segments) == 0 {
+		return value, nil
+	}
+	switch s := segments[0].(type) {
+	case string:
+		obj, ok := doc.(map[string]interface{})
+		if doc == nil {
+			obj, ok = map[string]interface{}{}, true
+		}
+		if !ok {
+			return nil, fmt.Errorf("can't set path %s, %s is not an object", path, s)
+		}
+		copied := map[string]interface{}{}
+		for k, v := range obj {
+			copied[k] = v
+		}
+		child, err := setSegments(obj[s], segments[1:], value, path)
+		if err != nil {
+			return nil, err
+		}
+		copied[s] = child
+		return copied, nil
+	default:
+		index := s.(int)
+		arr, ok := doc.([]interface{})
+		if !ok || index >= len(arr) {
+			return nil, fmt.Errorf("can't set path %s, index %d is out of range", path, index)
+		}
+		copied := append([]interface{}{}, arr...)
+		child, err := setSegments(arr[index], segments[1:], value, path)
+		if err != nil {
+			return nil, err
+		}
+		copied[index] = child
+		return copied, nil
+	}
+}
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -13,12 +16,59 @@ import (
 // when creating a new Workflow. StateResource allows for a dynamic lookup of the
 // URI by the `executor` package.
 
-func NewStateResource(name, namespace, arn string) *models.StateResource {
+// NewStateResource creates a StateResource. When resourceType isn't set, it's inferred from the
+// ARN, defaulting to an activity.
+func NewStateResource(name, namespace, arn string, resourceType models.StateResourceType) *models.StateResource {
+	if resourceType == "" {
+		resourceType = StateResourceTypeFromARN(arn)
+	}
 	return &models.StateResource{
 		Name:        name,
 		Namespace:   namespace,
 		URI:         arn,
-		Type:        models.StateResourceTypeActivityARN,
+		Type:        resourceType,
 		LastUpdated: strfmt.DateTime(time.Now()),
 	}
 }
+
+// StateResourceTypeFromARN returns the type of resource an ARN refers to, e.g.
+// "arn:aws:lambda:us-west-2:589690932525:function:name" is a LambdaFunctionARN.
+func StateResourceTypeFromARN(arn string) models.StateResourceType {
+	switch {
+	case strings.HasPrefix(arn, "arn:aws:lambda:"):
+		return models.StateResourceTypeLambdaFunctionARN
+	case strings.HasPrefix(arn, "arn:aws:batch:"):
+		return models.StateResourceTypeJobDefinitionARN
+	default:
+		return models.StateResourceTypeActivityARN
+	}
+}
+
+// IsCallbackResource checks whether a Task state's Resource (e.g. "callback:approval") is a
+// callback, i.e. the state waits until it is completed through the signalWorkflowState API.
+func IsCallbackResource(resource string) bool {
+	return strings.HasPrefix(resource, "callback:")
+}
+
+// IsChildWorkflowResource checks whether a Task state's Resource (e.g. "workflow:name" or
+// "workflow:name:2") starts a child workflow and waits for its output.
+func IsChildWorkflowResource(resource string) bool {
+	return strings.HasPrefix(resource, "workflow:")
+}
+
+// ParseChildWorkflowResource returns the workflow definition name and version of a child workflow
+// state's Resource. The version is -1 when the Resource doesn't pin one, i.e. the latest version.
+func ParseChildWorkflowResource(resource string) (string, int, error) {
+	parts := strings.Split(strings.TrimPrefix(resource, "workflow:"), ":")
+	if parts[0] == "" || len(parts) > 2 {
+		return "", 0, fmt.Errorf("invalid child workflow resource %s, expected workflow:<name>[:version]", resource)
+	}
+	if len(parts) == 1 {
+		return parts[0], -1, nil
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil || version < 0 {
+		return "", 0, fmt.Errorf("invalid child workflow resource %s, version must be a number", resource)
+	}
+	return parts[0], version, nil
+}
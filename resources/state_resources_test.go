@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChildWorkflowResource(t *testing.T) {
+	for _, test := range []struct {
+		resource string
+		name     string
+		version  int
+		err      bool
+	}{
+		{resource: "workflow:child", name: "child", version: -1},
+		{resource: "workflow:child:3", name: "child", version: 3},
+		{resource: "workflow:", err: true},
+		{resource: "workflow:child:latest", err: true},
+		{resource: "workflow:child:1:2", err: true},
+	} {
+		t.Run(test.resource, func(t *testing.T) {
+			name, version, err := ParseChildWorkflowResource(test.resource)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.name, name)
+			assert.Equal(t, test.version, version)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package resources
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewWorker creates a Worker from its latest heartbeat.
+func NewWorker(heartbeat *models.WorkerHeartbeat, at time.Time) models.Worker {
+	return models.Worker{
+		Name:          heartbeat.Name,
+		Namespace:     heartbeat.Namespace,
+		Resource:      heartbeat.Resource,
+		Version:       heartbeat.Version,
+		LastHeartbeat: strfmt.DateTime(at),
+	}
+}
+
+// WorkerIsLive checks whether a worker heartbeated within liveness of now.
+func WorkerIsLive(worker models.Worker, now time.Time, liveness time.Duration) bool {
+	return now.Sub(time.Time(worker.LastHeartbeat)) < liveness
+}
+
+// IsActivityResource checks whether a Task state's Resource is an activity polled by workers,
+// i.e. not a lambda function, callback or child workflow.
+func IsActivityResource(resource string) bool {
+	return resource != "" &&
+		!strings.HasPrefix(resource, "lambda:") &&
+		!IsCallbackResource(resource) &&
+		!IsChildWorkflowResource(resource)
+}
+
+// HasQueuedActivityJob checks whether a workflow has a job queued for the workers of an activity.
+func HasQueuedActivityJob(workflow *models.Workflow) bool {
+	if workflow.WorkflowDefinition == nil || workflow.WorkflowDefinition.StateMachine == nil {
+		return false
+	}
+	for _, job := range workflow.Jobs {
+		state, ok := workflow.WorkflowDefinition.StateMachine.States[job.State]
+		if ok && state.Type == models.SLStateTypeTask && IsActivityResource(state.Resource) &&
+			job.Status == models.JobStatusQueued {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceWorkers groups the live workers and the activity jobs of workflows by namespace and
+// resource. Jobs that are queued wait for a worker to poll them, and running jobs are matched to
+// the worker that started them by their Container. Resources are sorted by namespace and resource.
+func ResourceWorkers(workers []models.Worker, workflows []models.Workflow, now time.Time, liveness time.Duration) []models.ResourceWorkers {
+	byResource := map[string]*models.ResourceWorkers{}
+	get := func(namespace, resource string) *models.ResourceWorkers {
+		key := namespace + "--" + resource
+		if _, ok := byResource[key]; !ok {
+			byResource[key] = &models.ResourceWorkers{
+				Namespace:   namespace,
+				Resource:    resource,
+				Workers:     []*models.Worker{},
+				QueuedJobs:  []*models.ResourceJob{},
+				RunningJobs: []*models.ResourceJob{},
+			}
+		}
+		return byResource[key]
+	}
+
+	live := map[string]bool{}
+	for i := range workers {
+		worker := workers[i]
+		if !WorkerIsLive(worker, now, liveness) {
+			continue
+		}
+		live[worker.Name] = true
+		rw := get(worker.Namespace, worker.Resource)
+		rw.Workers = append(rw.Workers, &worker)
+	}
+
+	for _, workflow := range workflows {
+		if workflow.WorkflowDefinition == nil || workflow.WorkflowDefinition.StateMachine == nil {
+			continue
+		}
+		for _, job := range workflow.Jobs {
+			state, ok := workflow.WorkflowDefinition.StateMachine.States[job.State]
+			if !ok || state.Type != models.SLStateTypeTask || !IsActivityResource(state.Resource) {
+				continue
+			}
+			switch job.Status {
+			case models.JobStatusQueued:
+				rw := get(workflow.Namespace, state.Resource)
+				rw.QueuedJobs = append(rw.QueuedJobs, &models.ResourceJob{WorkflowID: workflow.ID, Job: job})
+			case models.JobStatusRunning:
+				rw := get(workflow.Namespace, state.Resource)
+				rw.RunningJobs = append(rw.RunningJobs, &models.ResourceJob{
+					WorkflowID: workflow.ID,
+					Job:        job,
+					WorkerLive: live[job.Container],
+				})
+			}
+		}
+	}
+
+	resourceWorkers := []models.ResourceWorkers{}
+	for _, rw := range byResource {
+		rw.Unpolled = len(rw.QueuedJobs) > 0 && len(rw.Workers) == 0
+		resourceWorkers = append(resourceWorkers, *rw)
+	}
+	sort.Slice(resourceWorkers, func(i, j int) bool {
+		if resourceWorkers[i].Namespace != resourceWorkers[j].Namespace {
+			return resourceWorkers[i].Namespace < resourceWorkers[j].Namespace
+		}
+		return resourceWorkers[i].Resource < resourceWorkers[j].Resource
+	})
+	return resourceWorkers
+}
@@ -0,0 +1,60 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceWorkers(t *testing.T) {
+	now := time.Now()
+	live := models.Worker{
+		Name: "live-worker", Namespace: "namespace", Resource: "fake-resource-2",
+		LastHeartbeat: strfmt.DateTime(now.Add(-time.Minute)),
+	}
+	dead := models.Worker{
+		Name: "dead-worker", Namespace: "namespace", Resource: "fake-resource-2",
+		LastHeartbeat: strfmt.DateTime(now.Add(-time.Hour)),
+	}
+
+	workflowDefinition := KitchenSinkWorkflowDefinition(t)
+	workflowDefinition.StateMachine.States["lambda-state"] = models.SLState{Type: models.SLStateTypeTask, Resource: "lambda:function"}
+	workflow := NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	workflow.Jobs = []*models.Job{
+		{ID: "1", State: "start-state", Status: models.JobStatusSucceeded, Container: "dead-worker"},
+		{ID: "2", State: "second-state", Status: models.JobStatusRunning, Container: "live-worker"},
+		{ID: "3", State: "second-state", Status: models.JobStatusRunning, Container: "dead-worker"},
+		{ID: "4", State: "end-state", Status: models.JobStatusQueued},
+		{ID: "5", State: "lambda-state", Status: models.JobStatusQueued},
+	}
+
+	resourceWorkers := ResourceWorkers([]models.Worker{live, dead}, []models.Workflow{*workflow}, now, 2*time.Minute)
+	require.Len(t, resourceWorkers, 2)
+
+	t.Log("Running jobs are matched to the live worker that started them")
+	assert.Equal(t, "fake-resource-2", resourceWorkers[0].Resource)
+	assert.Equal(t, []*models.Worker{&live}, resourceWorkers[0].Workers)
+	require.Len(t, resourceWorkers[0].RunningJobs, 2)
+	assert.True(t, resourceWorkers[0].RunningJobs[0].WorkerLive)
+	assert.False(t, resourceWorkers[0].RunningJobs[1].WorkerLive)
+	assert.False(t, resourceWorkers[0].Unpolled)
+
+	t.Log("Resources with queued jobs and no live workers are unpolled, lambda functions aren't polled")
+	assert.Equal(t, "fake-resource-3", resourceWorkers[1].Resource)
+	assert.Empty(t, resourceWorkers[1].Workers)
+	require.Len(t, resourceWorkers[1].QueuedJobs, 1)
+	assert.Equal(t, workflow.ID, resourceWorkers[1].QueuedJobs[0].WorkflowID)
+	assert.Equal(t, "4", resourceWorkers[1].QueuedJobs[0].Job.ID)
+	assert.True(t, resourceWorkers[1].Unpolled)
+	assert.True(t, HasQueuedActivityJob(workflow))
+
+	t.Log("Jobs queued for lambda functions don't wait for workers")
+	workflow.Jobs = workflow.Jobs[:3]
+	assert.False(t, HasQueuedActivityJob(workflow))
+	workflow.Jobs = append(workflow.Jobs, &models.Job{ID: "5", State: "lambda-state", Status: models.JobStatusQueued})
+	assert.False(t, HasQueuedActivityJob(workflow))
+}
@@ -0,0 +1,176 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// DiffWorkflowDefinitions lists the changes between two versions of a workflow definition.
+// A state that was removed and re-added under another name with the same definition is
+// reported as renamed, rather than as removed and added.
+func DiffWorkflowDefinitions(from, to models.WorkflowDefinition) (*models.WorkflowDefinitionDiff, error) {
+	diff := &models.WorkflowDefinitionDiff{
+		Name:          to.Name,
+		FromVersion:   from.Version,
+		ToVersion:     to.Version,
+		AddedStates:   []string{},
+		RemovedStates: []string{},
+		RenamedStates: []*models.StateRename{},
+		ChangedStates: []*models.StateDiff{},
+		Changes:       []*models.FieldChange{},
+	}
+
+	fromStates := map[string]models.SLState{}
+	toStates := map[string]models.SLState{}
+	if from.StateMachine != nil {
+		fromStates = from.StateMachine.States
+	}
+	if to.StateMachine != nil {
+		toStates = to.StateMachine.States
+	}
+
+	added := map[string]bool{}
+	for _, name := range sortedStateNames(toStates) {
+		if _, ok := fromStates[name]; !ok {
+			added[name] = true
+		}
+	}
+	for _, name := range sortedStateNames(fromStates) {
+		toState, ok := toStates[name]
+		if !ok {
+			renamedTo, err := findRenamedState(fromStates[name], toStates, added)
+			if err != nil {
+				return nil, err
+			}
+			if renamedTo == "" {
+				diff.RemovedStates = append(diff.RemovedStates, name)
+				continue
+			}
+			delete(added, renamedTo)
+			diff.RenamedStates = append(diff.RenamedStates, &models.StateRename{From: name, To: renamedTo})
+			continue
+		}
+
+		changes, err := fieldChanges(fromStates[name], toState)
+		if err != nil {
+			return nil, err
+		}
+		if len(changes) > 0 {
+			diff.ChangedStates = append(diff.ChangedStates, &models.StateDiff{State: name, Changes: changes})
+		}
+	}
+	for _, name := range sortedStateNames(toStates) {
+		if added[name] {
+			diff.AddedStates = append(diff.AddedStates, name)
+		}
+	}
+
+	// compare the fields of the state machines other than their states, which are compared above
+	var fromStateMachine, toStateMachine models.SLStateMachine
+	if from.StateMachine != nil {
+		fromStateMachine = *from.StateMachine
+	}
+	if to.StateMachine != nil {
+		toStateMachine = *to.StateMachine
+	}
+	fromStateMachine.States = nil
+	toStateMachine.States = nil
+	changes, err := fieldChanges(fromStateMachine, toStateMachine)
+	if err != nil {
+		return nil, err
+	}
+	diff.Changes = append(diff.Changes, changes...)
+
+	tagChanges, err := fieldChanges(from.DefaultTags, to.DefaultTags)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range tagChanges {
+		change.Field = "defaultTags." + change.Field
+	}
+	diff.Changes = append(diff.Changes, tagChanges...)
+
+	return diff, nil
+}
+
+// findRenamedState finds the added state with the same definition as a removed state.
+func findRenamedState(removed models.SLState, toStates map[string]models.SLState, added map[string]bool) (string, error) {
+	for _, name := range sortedStateNames(toStates) {
+		if !added[name] {
+			continue
+		}
+		changes, err := fieldChanges(removed, toStates[name])
+		if err != nil {
+			return "", err
+		}
+		if len(changes) == 0 {
+			return name, nil
+		}
+	}
+	return "", nil
+}
+
+// fieldChanges compares the top-level fields of two values by their JSON encoding.
+func fieldChanges(from, to interface{}) ([]*models.FieldChange, error) {
+	fromFields, err := jsonFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := jsonFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []string{}
+	for field := range fromFields {
+		fields = append(fields, field)
+	}
+	for field := range toFields {
+		if _, ok := fromFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := []*models.FieldChange{}
+	for _, field := range fields {
+		if bytes.Equal(fromFields[field], toFields[field]) {
+			continue
+		}
+		changes = append(changes, &models.FieldChange{
+			Field: field,
+			From:  string(fromFields[field]),
+			To:    string(toFields[field]),
+		})
+	}
+	return changes, nil
+}
+
+func jsonFields(value interface{}) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode %T: %s", value, err)
+	}
+	// a nil map encodes as null
+	if string(encoded) == "null" {
+		return fields, nil
+	}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, fmt.Errorf("could not decode %T: %s", value, err)
+	}
+	return fields, nil
+}
+
+func sortedStateNames(states map[string]models.SLState) []string {
+	names := []string{}
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
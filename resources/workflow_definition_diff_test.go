@@ -0,0 +1,74 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffWorkflowDefinitions(t *testing.T) {
+	from := models.WorkflowDefinition{
+		Name:    "diff",
+		Version: 1,
+		StateMachine: &models.SLStateMachine{
+			StartAt: "start",
+			States: map[string]models.SLState{
+				"start":   {Type: models.SLStateTypeTask, Resource: "worker-1", Next: "process"},
+				"process": {Type: models.SLStateTypeTask, Resource: "worker-2", Next: "cleanup"},
+				"cleanup": {Type: models.SLStateTypeTask, Resource: "worker-3", End: true},
+			},
+		},
+		DefaultTags: map[string]interface{}{"team": "a", "env": "prod"},
+	}
+	to := models.WorkflowDefinition{
+		Name:    "diff",
+		Version: 2,
+		StateMachine: &models.SLStateMachine{
+			StartAt: "begin",
+			States: map[string]models.SLState{
+				"begin": {Type: models.SLStateTypeTask, Resource: "worker-1", Next: "process"},
+				"process": {
+					Type:     models.SLStateTypeTask,
+					Resource: "worker-2-v2",
+					Next:     "notify",
+					Retry:    []*models.SLRetrier{{ErrorEquals: []models.SLErrorEquals{"States.ALL"}}},
+				},
+				"notify": {Type: models.SLStateTypeTask, Resource: "notifier", End: true},
+			},
+		},
+		DefaultTags: map[string]interface{}{"team": "b", "owner": "me"},
+	}
+
+	diff, err := DiffWorkflowDefinitions(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), diff.FromVersion)
+	assert.Equal(t, int64(2), diff.ToVersion)
+	assert.Equal(t, []string{"notify"}, diff.AddedStates)
+	assert.Equal(t, []string{"cleanup"}, diff.RemovedStates)
+	assert.Equal(t, []*models.StateRename{{From: "start", To: "begin"}}, diff.RenamedStates)
+	assert.Equal(t, []*models.StateDiff{{
+		State: "process",
+		Changes: []*models.FieldChange{
+			{Field: "Next", From: `"cleanup"`, To: `"notify"`},
+			{Field: "Resource", From: `"worker-2"`, To: `"worker-2-v2"`},
+			{Field: "Retry", From: "", To: `[{"ErrorEquals":["States.ALL"]}]`},
+		},
+	}}, diff.ChangedStates)
+	assert.Equal(t, []*models.FieldChange{
+		{Field: "StartAt", From: `"start"`, To: `"begin"`},
+		{Field: "defaultTags.env", From: `"prod"`, To: ""},
+		{Field: "defaultTags.owner", From: "", To: `"me"`},
+		{Field: "defaultTags.team", From: `"a"`, To: `"b"`},
+	}, diff.Changes)
+
+	t.Log("Identical versions have no changes")
+	diff, err = DiffWorkflowDefinitions(from, from)
+	require.NoError(t, err)
+	assert.Empty(t, diff.AddedStates)
+	assert.Empty(t, diff.RemovedStates)
+	assert.Empty(t, diff.RenamedStates)
+	assert.Empty(t, diff.ChangedStates)
+	assert.Empty(t, diff.Changes)
+}
@@ -10,6 +10,12 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
+// The arms of a workflow definition rollout that workflows are routed to.
+const (
+	RolloutArmBaseline = "baseline"
+	RolloutArmCanary   = "canary"
+)
+
 // NewWorkflowDefinition creates a new Workflow
 func NewWorkflowDefinition(
 	name string, manager models.Manager,
@@ -28,13 +34,18 @@ func NewWorkflowDefinition(
 
 func NewWorkflowDefinitionVersion(def *models.WorkflowDefinition, version int) *models.WorkflowDefinition {
 	return &models.WorkflowDefinition{
-		ID:           uuid.NewV4().String(),
-		Name:         def.Name,
-		Version:      int64(version),
-		CreatedAt:    strfmt.DateTime(time.Now()),
-		Manager:      def.Manager,
-		StateMachine: def.StateMachine,
-		DefaultTags:  def.DefaultTags,
+		ID:            uuid.NewV4().String(),
+		Name:          def.Name,
+		Version:       int64(version),
+		CreatedAt:     strfmt.DateTime(time.Now()),
+		Manager:       def.Manager,
+		StateMachine:  def.StateMachine,
+		DefaultTags:   def.DefaultTags,
+		AutoRetry:     def.AutoRetry,
+		Deadlines:     def.Deadlines,
+		Triggers:      def.Triggers,
+		InputSchema:   def.InputSchema,
+		OutputSchemas: def.OutputSchemas,
 	}
 }
 
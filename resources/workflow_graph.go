@@ -0,0 +1,374 @@
+package resources
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// graphColors are the fill colors of states by the status of their latest job.
+var graphColors = map[models.JobStatus]string{
+	models.JobStatusCreated:           "#fce8b2",
+	models.JobStatusQueued:            "#fce8b2",
+	models.JobStatusWaitingForDeps:    "#fce8b2",
+	models.JobStatusRunning:           "#fce8b2",
+	models.JobStatusSucceeded:         "#b7e1cd",
+	models.JobStatusFailed:            "#f4c7c3",
+	models.JobStatusAbortedDepsFailed: "#d9d9d9",
+	models.JobStatusAbortedByUser:     "#d9d9d9",
+}
+
+type graphNode struct {
+	name      string
+	stateType models.SLStateType
+	// details are extra lines of the node's label, e.g. its resource and retries
+	details []string
+	status  models.JobStatus
+}
+
+type graphEdge struct {
+	from, to, label string
+	// catch edges are drawn dashed
+	catch bool
+}
+
+// stateGraph is a state machine as nodes and edges. The start and end of the state machine are
+// pseudo-nodes that aren't in nodes.
+type stateGraph struct {
+	title string
+	nodes []*graphNode
+	edges []graphEdge
+}
+
+const (
+	graphStart = "__start__"
+	graphEnd   = "__end__"
+)
+
+// WorkflowDefinitionGraph renders the state machine of a workflow definition as a graph.
+func WorkflowDefinitionGraph(def models.WorkflowDefinition, format models.GraphFormat) (string, error) {
+	graph := newStateGraph(def, fmt.Sprintf("%s v%d", def.Name, def.Version))
+	return graph.render(format)
+}
+
+// WorkflowGraph renders the state machine of a workflow as a graph, with each state colored by
+// the status of its latest job. Task states show the number of attempts of their latest job.
+func WorkflowGraph(workflow models.Workflow, format models.GraphFormat) (string, error) {
+	def := *workflow.WorkflowDefinition
+	graph := newStateGraph(def, fmt.Sprintf("%s v%d (%s): %s", def.Name, def.Version, workflow.Namespace, workflow.Status))
+
+	latestJobs := map[string]*models.Job{}
+	for _, job := range workflow.Jobs {
+		latestJobs[job.State] = job
+	}
+	for _, node := range graph.nodes {
+		job, ok := latestJobs[node.name]
+		if !ok {
+			continue
+		}
+		node.status = job.Status
+		if len(job.Attempts) > 0 {
+			node.details = append(node.details, fmt.Sprintf("attempts: %d", len(job.Attempts)+1))
+		}
+	}
+	return graph.render(format)
+}
+
+func newStateGraph(def models.WorkflowDefinition, title string) *stateGraph {
+	graph := &stateGraph{title: title}
+	if def.StateMachine == nil {
+		return graph
+	}
+	states := def.StateMachine.States
+	graph.edges = append(graph.edges, graphEdge{from: graphStart, to: def.StateMachine.StartAt})
+	for _, name := range sortedStateNames(states) {
+		state := states[name]
+		node := &graphNode{name: name, stateType: state.Type}
+		if state.Resource != "" {
+			node.details = append(node.details, state.Resource)
+		}
+		for _, retrier := range state.Retry {
+			if retrier.MaxAttempts != nil {
+				node.details = append(node.details, fmt.Sprintf("retry %s x%d", errorNames(retrier.ErrorEquals), *retrier.MaxAttempts))
+			}
+		}
+		graph.nodes = append(graph.nodes, node)
+
+		if state.Next != "" {
+			graph.edges = append(graph.edges, graphEdge{from: name, to: state.Next})
+		}
+		for i, choice := range state.Choices {
+			graph.edges = append(graph.edges, graphEdge{from: name, to: choice.Next, label: fmt.Sprintf("rule %d", i)})
+		}
+		if state.Default != "" {
+			graph.edges = append(graph.edges, graphEdge{from: name, to: state.Default, label: "default"})
+		}
+		for _, catcher := range state.Catch {
+			graph.edges = append(graph.edges, graphEdge{from: name, to: catcher.Next, label: errorNames(catcher.ErrorEquals), catch: true})
+		}
+		if state.End || state.Type == models.SLStateTypeSucceed || state.Type == models.SLStateTypeFail {
+			graph.edges = append(graph.edges, graphEdge{from: name, to: graphEnd})
+		}
+	}
+	return graph
+}
+
+func errorNames(errorEquals []models.SLErrorEquals) string {
+	names := []string{}
+	for _, e := range errorEquals {
+		names = append(names, string(e))
+	}
+	return strings.Join(names, ",")
+}
+
+func (g *stateGraph) render(format models.GraphFormat) (string, error) {
+	switch format {
+	case models.GraphFormatDOT:
+		return g.dot(), nil
+	case models.GraphFormatMermaid:
+		return g.mermaid(), nil
+	case models.GraphFormatSVG:
+		return g.svg(), nil
+	}
+	return "", fmt.Errorf("unknown graph format %s", format)
+}
+
+func (g *stateGraph) dot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", g.title)
+	fmt.Fprintf(&b, "  label=%q;\n", g.title)
+	b.WriteString("  labelloc=t;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fillcolor=white];\n")
+	fmt.Fprintf(&b, "  %q [shape=circle, label=\"\", fillcolor=black, width=0.2];\n", graphStart)
+	fmt.Fprintf(&b, "  %q [shape=doublecircle, label=\"\", fillcolor=black, width=0.15];\n", graphEnd)
+	for _, node := range g.nodes {
+		shape := "box"
+		if node.stateType == models.SLStateTypeChoice {
+			shape = "diamond"
+		}
+		fillColor := "white"
+		if color, ok := graphColors[node.status]; ok {
+			fillColor = color
+		}
+		fmt.Fprintf(&b, "  %q [shape=%s, label=%q, fillcolor=%q];\n",
+			node.name, shape, strings.Join(node.label(), "\n"), fillColor)
+	}
+	for _, edge := range g.edges {
+		attrs := []string{}
+		if edge.label != "" {
+			attrs = append(attrs, fmt.Sprintf("label=%q", edge.label))
+		}
+		if edge.catch {
+			attrs = append(attrs, "style=dashed")
+		}
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, "  %q -> %q [%s];\n", edge.from, edge.to, strings.Join(attrs, ", "))
+		} else {
+			fmt.Fprintf(&b, "  %q -> %q;\n", edge.from, edge.to)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func (g *stateGraph) mermaid() string {
+	// state names can contain characters that aren't valid in mermaid ids, so nodes are numbered
+	ids := map[string]string{graphStart: "start", graphEnd: "end_"}
+	for i, node := range g.nodes {
+		ids[node.name] = fmt.Sprintf("s%d", i)
+	}
+	id := func(name string) string {
+		if id, ok := ids[name]; ok {
+			return id
+		}
+		// transitions to states that don't exist are drawn to a node of their own
+		ids[name] = fmt.Sprintf("s%d", len(ids))
+		return ids[name]
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\ntitle: %s\n---\nflowchart TD\n", mermaidText(g.title))
+	b.WriteString("  start(( ))\n  end_((( )))\n")
+	statuses := map[models.JobStatus][]string{}
+	for _, node := range g.nodes {
+		label := mermaidText(strings.Join(node.label(), "<br/>"))
+		if node.stateType == models.SLStateTypeChoice {
+			fmt.Fprintf(&b, "  %s{\"%s\"}\n", id(node.name), label)
+		} else {
+			fmt.Fprintf(&b, "  %s(\"%s\")\n", id(node.name), label)
+		}
+		if node.status != "" {
+			statuses[node.status] = append(statuses[node.status], id(node.name))
+		}
+	}
+	for _, edge := range g.edges {
+		arrow := "-->"
+		if edge.catch {
+			arrow = "-.->"
+		}
+		if edge.label != "" {
+			fmt.Fprintf(&b, "  %s %s|\"%s\"| %s\n", id(edge.from), arrow, mermaidText(edge.label), id(edge.to))
+		} else {
+			fmt.Fprintf(&b, "  %s %s %s\n", id(edge.from), arrow, id(edge.to))
+		}
+	}
+
+	sortedStatuses := []string{}
+	for status := range statuses {
+		sortedStatuses = append(sortedStatuses, string(status))
+	}
+	sort.Strings(sortedStatuses)
+	for _, status := range sortedStatuses {
+		class := strings.Replace(status, "_", "", -1)
+		fmt.Fprintf(&b, "  classDef %s fill:%s\n", class, graphColors[models.JobStatus(status)])
+		fmt.Fprintf(&b, "  class %s %s\n", strings.Join(statuses[models.JobStatus(status)], ","), class)
+	}
+	return b.String()
+}
+
+func mermaidText(s string) string {
+	return strings.Replace(s, `"`, "#quot;", -1)
+}
+
+const (
+	svgNodeWidth  = 200
+	svgLineHeight = 16
+	svgRowGap     = 60
+	svgColumnGap  = 30
+	svgMargin     = 20
+)
+
+type svgBox struct {
+	x, y, width, height int
+}
+
+// svg lays the graph out in rows by the distance of each state from the start, since SVG has no
+// layout of its own.
+func (g *stateGraph) svg() string {
+	ranks := g.ranks()
+	rows := [][]*graphNode{}
+	for _, node := range g.nodes {
+		rank := ranks[node.name]
+		for len(rows) <= rank {
+			rows = append(rows, nil)
+		}
+		rows[rank] = append(rows[rank], node)
+	}
+
+	boxes := map[string]svgBox{}
+	width := svgNodeWidth + 2*svgMargin
+	y := svgMargin + 2*svgLineHeight
+	boxes[graphStart] = svgBox{x: svgMargin, y: y, width: svgNodeWidth, height: svgLineHeight}
+	y += svgLineHeight + svgRowGap
+	for _, row := range rows {
+		rowHeight := 0
+		for i, node := range row {
+			height := (len(node.label()) + 1) * svgLineHeight
+			boxes[node.name] = svgBox{
+				x: svgMargin + i*(svgNodeWidth+svgColumnGap), y: y, width: svgNodeWidth, height: height,
+			}
+			if height > rowHeight {
+				rowHeight = height
+			}
+		}
+		if rowWidth := 2*svgMargin + len(row)*(svgNodeWidth+svgColumnGap) - svgColumnGap; rowWidth > width {
+			width = rowWidth
+		}
+		y += rowHeight + svgRowGap
+	}
+	boxes[graphEnd] = svgBox{x: svgMargin, y: y, width: svgNodeWidth, height: svgLineHeight}
+	height := y + svgLineHeight + svgMargin
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="sans-serif" font-size="12">`+"\n", width, height)
+	b.WriteString(`  <defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="6" markerHeight="6" orient="auto"><path d="M0,0 L10,5 L0,10 z"/></marker></defs>` + "\n")
+	fmt.Fprintf(&b, `  <text x="%d" y="%d" font-weight="bold">%s</text>`+"\n", svgMargin, svgMargin+svgLineHeight, html.EscapeString(g.title))
+	for _, name := range []string{graphStart, graphEnd} {
+		box := boxes[name]
+		fmt.Fprintf(&b, `  <circle cx="%d" cy="%d" r="%d"/>`+"\n", box.x+box.width/2, box.y+box.height/2, svgLineHeight/2)
+	}
+	for _, edge := range g.edges {
+		from, ok := boxes[edge.from]
+		to, toOK := boxes[edge.to]
+		if !ok || !toOK {
+			continue
+		}
+		x1, y1 := from.x+from.width/2, from.y+from.height
+		x2, y2 := to.x+to.width/2, to.y
+		// edges back up the graph, e.g. loops, run from the side of the state
+		if to.y <= from.y {
+			x1, y1 = from.x+from.width, from.y+from.height/2
+			x2, y2 = to.x+to.width, to.y+to.height/2
+		}
+		dash := ""
+		if edge.catch {
+			dash = ` stroke-dasharray="4"`
+		}
+		fmt.Fprintf(&b, `  <line x1="%d" y1="%d" x2="%d" y2="%d" stroke="black"%s marker-end="url(#arrow)"/>`+"\n", x1, y1, x2, y2, dash)
+		if edge.label != "" {
+			fmt.Fprintf(&b, `  <text x="%d" y="%d" font-size="10">%s</text>`+"\n", (x1+x2)/2+4, (y1+y2)/2, html.EscapeString(edge.label))
+		}
+	}
+	for _, node := range g.nodes {
+		box := boxes[node.name]
+		fillColor := "white"
+		if color, ok := graphColors[node.status]; ok {
+			fillColor = color
+		}
+		rx := 8
+		if node.stateType == models.SLStateTypeChoice {
+			rx = box.height / 2
+		}
+		fmt.Fprintf(&b, `  <rect x="%d" y="%d" width="%d" height="%d" rx="%d" fill="%s" stroke="black"/>`+"\n",
+			box.x, box.y, box.width, box.height, rx, fillColor)
+		for i, line := range node.label() {
+			fmt.Fprintf(&b, `  <text x="%d" y="%d" text-anchor="middle">%s</text>`+"\n",
+				box.x+box.width/2, box.y+(i+1)*svgLineHeight, html.EscapeString(line))
+		}
+	}
+	b.WriteString("</svg>\n")
+	return b.String()
+}
+
+// ranks numbers the states by their distance from the start. States that can't be reached are
+// ranked after the others.
+func (g *stateGraph) ranks() map[string]int {
+	next := map[string][]string{}
+	for _, edge := range g.edges {
+		next[edge.from] = append(next[edge.from], edge.to)
+	}
+	ranks := map[string]int{}
+	queue := []string{}
+	for _, to := range next[graphStart] {
+		ranks[to] = 0
+		queue = append(queue, to)
+	}
+	maxRank := 0
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, to := range next[name] {
+			if _, ok := ranks[to]; ok || to == graphEnd {
+				continue
+			}
+			ranks[to] = ranks[name] + 1
+			if ranks[to] > maxRank {
+				maxRank = ranks[to]
+			}
+			queue = append(queue, to)
+		}
+	}
+	for _, node := range g.nodes {
+		if _, ok := ranks[node.name]; !ok {
+			ranks[node.name] = maxRank + 1
+		}
+	}
+	return ranks
+}
+
+func (n *graphNode) label() []string {
+	return append([]string{fmt.Sprintf("%s (%s)", n.name, n.stateType)}, n.details...)
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowDefinitionGraph(t *testing.T) {
+	def := *KitchenSinkWorkflowDefinition(t)
+
+	graph, err := WorkflowDefinitionGraph(def, models.GraphFormatDOT)
+	require.NoError(t, err)
+	assert.Contains(t, graph, `"__start__" -> "start-state";`)
+	assert.Contains(t, graph, `"start-state" -> "second-state";`)
+	assert.Contains(t, graph, `"end-state" -> "__end__";`)
+	assert.Contains(t, graph, `label="start-state (Task)\nfake-resource-1\nretry States.ALL x2"`)
+
+	graph, err = WorkflowDefinitionGraph(def, models.GraphFormatMermaid)
+	require.NoError(t, err)
+	assert.Contains(t, graph, "flowchart TD")
+	assert.Contains(t, graph, "start --> s2")
+
+	graph, err = WorkflowDefinitionGraph(def, models.GraphFormatSVG)
+	require.NoError(t, err)
+	assert.Contains(t, graph, "<svg")
+	assert.Contains(t, graph, ">second-state (Task)</text>")
+
+	_, err = WorkflowDefinitionGraph(def, models.GraphFormat("png"))
+	assert.Error(t, err)
+}
+
+func TestWorkflowGraph(t *testing.T) {
+	def := KitchenSinkWorkflowDefinition(t)
+	workflow := NewWorkflow(def, "{}", "namespace", "queue", map[string]interface{}{})
+	workflow.Jobs = []*models.Job{
+		{State: "start-state", Status: models.JobStatusSucceeded, Attempts: []*models.JobAttempt{{}}},
+		{State: "second-state", Status: models.JobStatusRunning},
+	}
+
+	graph, err := WorkflowGraph(*workflow, models.GraphFormatDOT)
+	require.NoError(t, err)
+	assert.Contains(t, graph, `fake-resource-1\nretry States.ALL x2\nattempts: 2", fillcolor="#b7e1cd"`)
+	assert.Contains(t, graph, `"second-state" [shape=box, label="second-state (Task)\nfake-resource-2", fillcolor="#fce8b2"]`)
+	assert.Contains(t, graph, `"end-state" [shape=box, label="end-state (Task)\nfake-resource-3", fillcolor="white"]`)
+
+	graph, err = WorkflowGraph(*workflow, models.GraphFormatMermaid)
+	require.NoError(t, err)
+	assert.Contains(t, graph, "class s1 running")
+	assert.Contains(t, graph, "class s2 succeeded")
+}
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -108,3 +111,110 @@ func SFNStatusToWorkflowStatus(sfnStatus string) models.WorkflowStatus {
 		return models.WorkflowStatusQueued // this should never happen, since all cases are covered above
 	}
 }
+
+// MergePatchInput applies a JSON merge patch (RFC 7386) to a workflow or job input.
+// Keys set to null in the patch are removed from the input.
+func MergePatchInput(input, patch string) (string, error) {
+	var inputDoc interface{}
+	if input != "" {
+		if err := json.Unmarshal([]byte(input), &inputDoc); err != nil {
+			return "", fmt.Errorf("input is not valid JSON: %s", err)
+		}
+	}
+	var patchDoc interface{}
+	if err := json.Unmarshal([]byte(patch), &patchDoc); err != nil {
+		return "", fmt.Errorf("patch is not valid JSON: %s", err)
+	}
+
+	merged, err := json.Marshal(mergePatch(inputDoc, patchDoc))
+	if err != nil {
+		return "", err
+	}
+	return string(merged), nil
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = map[string]interface{}{}
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+		} else {
+			targetObj[key] = mergePatch(targetObj[key], value)
+		}
+	}
+	return targetObj
+}
+
+// TriggerInput builds the input of a workflow started by a WorkflowTrigger of workflow.
+// Each key in mapping is set to the value at a path like "$.output.key" in workflow, where "$" is
+// an object with the workflow's id, status, input and output. Without a mapping, the input is
+// workflow's output.
+func TriggerInput(workflow models.Workflow, mapping map[string]string) (string, error) {
+	if len(mapping) == 0 {
+		if workflow.Output == "" {
+			return "{}", nil
+		}
+		return workflow.Output, nil
+	}
+
+	root := map[string]interface{}{
+		"id":     workflow.ID,
+		"status": string(workflow.Status),
+	}
+	for key, doc := range map[string]string{"input": workflow.Input, "output": workflow.Output} {
+		if doc == "" {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(doc), &value); err != nil {
+			return "", fmt.Errorf("%s is not valid JSON: %s", key, err)
+		}
+		root[key] = value
+	}
+
+	input := map[string]interface{}{}
+	for key, path := range mapping {
+		value, err := lookupTriggerPath(root, path)
+		if err != nil {
+			return "", err
+		}
+		input[key] = value
+	}
+	marshaled, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+	return string(marshaled), nil
+}
+
+// ValidateTriggerPath checks that a WorkflowTrigger inputMapping path has the form "$.key.key".
+func ValidateTriggerPath(path string) error {
+	if path != "$" && !strings.HasPrefix(path, "$.") {
+		return fmt.Errorf("invalid path %s, paths must start with \"$.\"", path)
+	}
+	return nil
+}
+
+func lookupTriggerPath(root interface{}, path string) (interface{}, error) {
+	if err := ValidateTriggerPath(path); err != nil {
+		return nil, err
+	}
+	value := root
+	for _, key := range strings.Split(path, ".")[1:] {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("path %s not found", path)
+		}
+		if value, ok = obj[key]; !ok {
+			return nil, fmt.Errorf("path %s not found", path)
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergePatchInput(t *testing.T) {
+	for _, test := range []struct {
+		desc     string
+		input    string
+		patch    string
+		expected string
+	}{
+		{
+			desc:     "adds and replaces keys",
+			input:    `{"a": 1, "b": {"c": 2}}`,
+			patch:    `{"b": {"c": 3}, "d": "e"}`,
+			expected: `{"a": 1, "b": {"c": 3}, "d": "e"}`,
+		},
+		{
+			desc:     "null removes keys",
+			input:    `{"a": 1, "b": {"c": 2, "d": 3}}`,
+			patch:    `{"a": null, "b": {"d": null}}`,
+			expected: `{"b": {"c": 2}}`,
+		},
+		{
+			desc:     "arrays are replaced rather than merged",
+			input:    `{"a": [1, 2]}`,
+			patch:    `{"a": [3]}`,
+			expected: `{"a": [3]}`,
+		},
+		{
+			desc:     "empty input",
+			input:    "",
+			patch:    `{"a": 1}`,
+			expected: `{"a": 1}`,
+		},
+	} {
+		t.Run(test.desc, func(t *testing.T) {
+			merged, err := MergePatchInput(test.input, test.patch)
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, merged)
+		})
+	}
+
+	t.Log("Fails on invalid JSON")
+	_, err := MergePatchInput(`{"a": 1}`, `{`)
+	assert.Error(t, err)
+	_, err = MergePatchInput(`{`, `{"a": 1}`)
+	assert.Error(t, err)
+}
+
+func TestTriggerInput(t *testing.T) {
+	workflow := models.Workflow{
+		WorkflowSummary: models.WorkflowSummary{
+			ID:     "workflow-id",
+			Status: models.WorkflowStatusSucceeded,
+			Input:  `{"a": {"b": 1}}`,
+		},
+		Output: `{"c": [2]}`,
+	}
+
+	t.Log("Defaults to the workflow's output")
+	input, err := TriggerInput(workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"c": [2]}`, input)
+
+	t.Log("Maps paths in the workflow")
+	input, err = TriggerInput(workflow, map[string]string{
+		"b":      "$.input.a.b",
+		"c":      "$.output.c",
+		"source": "$.id",
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"b": 1, "c": [2], "source": "workflow-id"}`, input)
+
+	t.Log("Fails on missing and invalid paths")
+	_, err = TriggerInput(workflow, map[string]string{"d": "$.output.d"})
+	assert.Error(t, err)
+	_, err = TriggerInput(workflow, map[string]string{"d": "output.c"})
+	assert.Error(t, err)
+}
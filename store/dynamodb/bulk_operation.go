@@ -0,0 +1,127 @@
+package dynamodb
+
+import (
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/go-openapi/strfmt"
+)
+
+// ddbBulkOperationPrimaryKey represents the primary key of the bulk operations table.
+// Use this to make GetItem queries.
+type ddbBulkOperationPrimaryKey struct {
+	ID string `dynamodbav:"id"`
+}
+
+func (pk ddbBulkOperationPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("id"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbBulkOperationPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("id"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
+
+// ddbBulkOperationProgress holds the status and last update of a bulk operation as top-level
+// attributes, so that operations left running by a stopped process can be found and claimed.
+type ddbBulkOperationProgress struct {
+	Status      models.BulkOperationStatus `dynamodbav:"status"`
+	LastUpdated strfmt.DateTime            `dynamodbav:"lastUpdated,unixtime"`
+}
+
+// ddbBulkOperation represents the bulk operation as stored in dynamo. Its results are stored
+// separately, see ddbBulkOperationResult, since a thousand of them with long errors don't fit in
+// a single item.
+// Use this to make PutItem queries.
+type ddbBulkOperation struct {
+	ddbBulkOperationPrimaryKey
+	ddbBulkOperationProgress
+	BulkOperation models.BulkOperation
+}
+
+// EncodeBulkOperation encodes a BulkOperation, without its results, as a dynamo attribute map.
+func EncodeBulkOperation(op models.BulkOperation) (map[string]*dynamodb.AttributeValue, error) {
+	op.Results = nil
+	return dynamodbattribute.MarshalMap(ddbBulkOperation{
+		ddbBulkOperationPrimaryKey: ddbBulkOperationPrimaryKey{
+			ID: op.ID,
+		},
+		ddbBulkOperationProgress: ddbBulkOperationProgress{
+			Status:      op.Status,
+			LastUpdated: op.LastUpdated,
+		},
+		BulkOperation: op,
+	})
+}
+
+// DecodeBulkOperation translates a BulkOperation stored in dynamodb to a BulkOperation object,
+// without its results.
+func DecodeBulkOperation(m map[string]*dynamodb.AttributeValue) (models.BulkOperation, error) {
+	var res ddbBulkOperation
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.BulkOperation{}, err
+	}
+	return res.BulkOperation, nil
+}
+
+// ddbBulkOperationResultPrimaryKey represents the primary key of the bulk operation results table.
+type ddbBulkOperationResultPrimaryKey struct {
+	OperationID string `dynamodbav:"operationID"`
+	WorkflowID  string `dynamodbav:"workflowID"`
+}
+
+func (pk ddbBulkOperationResultPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("operationID"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+		{
+			AttributeName: aws.String("workflowID"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbBulkOperationResultPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("operationID"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("workflowID"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// ddbBulkOperationResult represents the result of a bulk operation for one workflow as stored in
+// dynamo. Index is the result's position in the operation's results.
+type ddbBulkOperationResult struct {
+	ddbBulkOperationResultPrimaryKey
+	Index  int                        `dynamodbav:"index"`
+	Result models.BulkOperationResult `dynamodbav:"result"`
+}
+
+// EncodeBulkOperationResult encodes the result of a bulk operation as a dynamo attribute map.
+func EncodeBulkOperationResult(operationID string, index int, result models.BulkOperationResult) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbBulkOperationResult{
+		ddbBulkOperationResultPrimaryKey: ddbBulkOperationResultPrimaryKey{
+			OperationID: operationID,
+			WorkflowID:  result.WorkflowID,
+		},
+		Index:  index,
+		Result: result,
+	})
+}
@@ -3,6 +3,8 @@ package dynamodb
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -34,6 +36,9 @@ type TableConfig struct {
 	PrefixStateResources      string
 	PrefixWorkflowDefinitions string
 	PrefixWorkflows           string
+	PrefixBulkOperations      string
+	PrefixTaskTokens          string
+	PrefixWorkers             string
 }
 
 var log = logger.New("workflow-manager")
@@ -56,6 +61,16 @@ func (d DynamoDB) workflowDefinitionsTable() string {
 	return fmt.Sprintf("%s-workflow-definitions", d.tableConfig.PrefixWorkflowDefinitions)
 }
 
+// workflowDefinitionAliasesTable returns the name of the table that stores the aliases of WorkflowDefinitions
+func (d DynamoDB) workflowDefinitionAliasesTable() string {
+	return fmt.Sprintf("%s-workflow-definition-aliases", d.tableConfig.PrefixWorkflowDefinitions)
+}
+
+// workflowDefinitionRolloutsTable returns the name of the table that stores the rollouts of WorkflowDefinitions
+func (d DynamoDB) workflowDefinitionRolloutsTable() string {
+	return fmt.Sprintf("%s-workflow-definition-rollouts", d.tableConfig.PrefixWorkflowDefinitions)
+}
+
 // workflowsTable returns the name of the table that stores workflows.
 func (d DynamoDB) workflowsTable() string {
 	return fmt.Sprintf("%s-workflows", d.tableConfig.PrefixWorkflows)
@@ -66,6 +81,27 @@ func (d DynamoDB) stateResourcesTable() string {
 	return fmt.Sprintf("%s-state-resources", d.tableConfig.PrefixStateResources)
 }
 
+// bulkOperationsTable returns the name of the table that stores bulk operations.
+func (d DynamoDB) bulkOperationsTable() string {
+	return fmt.Sprintf("%s-bulk-operations", d.tableConfig.PrefixBulkOperations)
+}
+
+// bulkOperationResultsTable returns the name of the table that stores the per-workflow results of
+// bulk operations.
+func (d DynamoDB) bulkOperationResultsTable() string {
+	return fmt.Sprintf("%s-bulk-operation-results", d.tableConfig.PrefixBulkOperations)
+}
+
+// taskTokensTable returns the name of the table that stores task tokens of callback states.
+func (d DynamoDB) taskTokensTable() string {
+	return fmt.Sprintf("%s-task-tokens", d.tableConfig.PrefixTaskTokens)
+}
+
+// workersTable returns the name of the table that stores the heartbeats of activity workers.
+func (d DynamoDB) workersTable() string {
+	return fmt.Sprintf("%s-workers", d.tableConfig.PrefixWorkers)
+}
+
 // dynamoItemsToWorkflowDefinitions takes the Items from a Query or Scan result and decodes it into an array of workflow definitions
 func (d DynamoDB) dynamoItemsToWorkflowDefinitions(items []map[string]*dynamodb.AttributeValue) ([]models.WorkflowDefinition, error) {
 	workflowDefinitions := []models.WorkflowDefinition{}
@@ -218,6 +254,106 @@ func (d DynamoDB) InitTables(ctx context.Context, setupWorkflowsTTL bool) error
 		return err
 	}
 
+	// create bulk-operations table from bulk operation ID -> bulk operation object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbBulkOperationPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbBulkOperationPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.bulkOperationsTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create bulk-operation-results table from {bulk operation ID, workflow ID} -> result
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbBulkOperationResultPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbBulkOperationResultPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.bulkOperationResultsTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create task-tokens table from worker name -> task token
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbTaskTokenPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbTaskTokenPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.taskTokensTable()),
+	}); err != nil {
+		return err
+	}
+	if setupWorkflowsTTL {
+		if _, err := d.ddb.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(d.taskTokensTable()),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: ddbTaskTokenTTL{}.AttributeDefinition().AttributeName,
+				Enabled:       aws.Bool(true),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// create workflow-definition-aliases table from (name, alias) -> alias object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkflowDefinitionAliasPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkflowDefinitionAliasPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create workflow-definition-rollouts table from name -> rollout object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkflowDefinitionRolloutPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkflowDefinitionRolloutPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workflowDefinitionRolloutsTable()),
+	}); err != nil {
+		return err
+	}
+
+	// create workers table from (namespace--resource, name) -> worker object
+	if _, err := d.ddb.CreateTableWithContext(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: ddbWorkerPrimaryKey{}.AttributeDefinitions(),
+		KeySchema:            ddbWorkerPrimaryKey{}.KeySchema(),
+		ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+			ReadCapacityUnits:  aws.Int64(1),
+			WriteCapacityUnits: aws.Int64(1),
+		},
+		TableName: aws.String(d.workersTable()),
+	}); err != nil {
+		return err
+	}
+	if setupWorkflowsTTL {
+		if _, err := d.ddb.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(d.workersTable()),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: ddbWorkerTTL{}.AttributeDefinition().AttributeName,
+				Enabled:       aws.Bool(true),
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -479,24 +615,48 @@ func (d DynamoDB) SaveWorkflow(ctx context.Context, workflow models.Workflow) er
 }
 
 func (d DynamoDB) UpdateWorkflow(ctx context.Context, workflow models.Workflow) error {
+	return d.updateWorkflow(ctx, workflow, nil)
+}
+
+// UpdateWorkflowFromStatus updates a workflow if its stored status is still status.
+func (d DynamoDB) UpdateWorkflowFromStatus(ctx context.Context, workflow models.Workflow, status models.WorkflowStatus) error {
+	return d.updateWorkflow(ctx, workflow, &status)
+}
+
+// updateWorkflow updates a workflow, if its stored status is still fromStatus when it's set.
+func (d DynamoDB) updateWorkflow(ctx context.Context, workflow models.Workflow, fromStatus *models.WorkflowStatus) error {
 	workflow.LastUpdated = strfmt.DateTime(time.Now())
 
 	data, err := EncodeWorkflow(workflow)
 	if err != nil {
 		return err
 	}
-	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+	input := &dynamodb.PutItemInput{
 		TableName: aws.String(d.workflowsTable()),
 		Item:      data,
 		ExpressionAttributeNames: map[string]*string{
 			"#I": aws.String("id"),
 		},
 		ConditionExpression: aws.String("attribute_exists(#I)"),
-	})
+	}
+	if fromStatus != nil {
+		input.ExpressionAttributeNames["#S"] = aws.String("status")
+		input.ExpressionAttributeValues = map[string]*dynamodb.AttributeValue{
+			":status": {S: aws.String(string(*fromStatus))},
+		}
+		input.ConditionExpression = aws.String("attribute_exists(#I) AND Workflow.#S = :status")
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, input)
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
 			switch awsErr.Code() {
 			case dynamodb.ErrCodeConditionalCheckFailedException:
+				if fromStatus != nil {
+					if _, err := d.GetWorkflowByID(ctx, workflow.ID); err != nil {
+						return err
+					}
+					return store.NewConflict(workflow.ID)
+				}
 				return store.NewNotFound(workflow.ID)
 			case "ValidationException":
 				if awsErr.Message() == errMessageItemTooLarge {
@@ -509,7 +669,7 @@ func (d DynamoDB) UpdateWorkflow(ctx context.Context, workflow models.Workflow)
 					// try again without jobs
 					wfCopy := resources.CopyWorkflow(workflow)
 					wfCopy.Jobs = nil
-					return d.UpdateWorkflow(ctx, wfCopy)
+					return d.updateWorkflow(ctx, wfCopy, fromStatus)
 				}
 			}
 		}
@@ -542,6 +702,93 @@ func (d DynamoDB) DeleteWorkflowByID(ctx context.Context, workflowID string) err
 	return err
 }
 
+// AppendChildWorkflowID adds a child workflow to the ChildWorkflowIDs of its parent, without
+// reading and writing the whole parent.
+func (d DynamoDB) AppendChildWorkflowID(ctx context.Context, parentID, childID string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowPrimaryKey{
+		ID: parentID,
+	})
+	if err != nil {
+		return err
+	}
+	names := map[string]*string{
+		"#I": aws.String("id"),
+		"#C": aws.String("childWorkflowIDs"),
+	}
+	values := map[string]*dynamodb.AttributeValue{
+		":child": {L: []*dynamodb.AttributeValue{{S: aws.String(childID)}}},
+		":list":  {S: aws.String("L")},
+	}
+	// workflows without children store a NULL ChildWorkflowIDs, which list_append can't extend.
+	// Each update is conditional on the attribute's type, so retry if another append changed it.
+	for {
+		_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(d.workflowsTable()),
+			Key:                       key,
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+			ConditionExpression:       aws.String("attribute_exists(#I) AND attribute_type(Workflow.#C, :list)"),
+			UpdateExpression:          aws.String("SET Workflow.#C = list_append(Workflow.#C, :child)"),
+		})
+		if !isConditionalCheckFailed(err) {
+			return err
+		}
+		_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+			TableName:                 aws.String(d.workflowsTable()),
+			Key:                       key,
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: values,
+			ConditionExpression:       aws.String("attribute_exists(#I) AND NOT attribute_type(Workflow.#C, :list)"),
+			UpdateExpression:          aws.String("SET Workflow.#C = :child"),
+		})
+		if !isConditionalCheckFailed(err) {
+			return err
+		}
+		if _, err := d.GetWorkflowByID(ctx, parentID); err != nil {
+			return err
+		}
+	}
+}
+
+// isConditionalCheckFailed returns whether a write failed its condition expression.
+func isConditionalCheckFailed(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+// ClaimAutoRetry clears a workflow's AutoRetryAt if it's still retryAt, so that only one caller
+// starts the automatic retry.
+func (d DynamoDB) ClaimAutoRetry(ctx context.Context, workflowID string, retryAt strfmt.DateTime) error {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowPrimaryKey{
+		ID: workflowID,
+	})
+	if err != nil {
+		return err
+	}
+	at, err := dynamodbattribute.Marshal(retryAt)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(d.workflowsTable()),
+		Key:       key,
+		ExpressionAttributeNames: map[string]*string{
+			"#A": aws.String("autoRetryAt"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":at": at,
+		},
+		ConditionExpression: aws.String("Workflow.#A = :at"),
+		UpdateExpression:    aws.String("REMOVE Workflow.#A"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return store.NewConflict(workflowID)
+		}
+	}
+	return err
+}
+
 // GetWorkflowByID
 func (d DynamoDB) GetWorkflowByID(ctx context.Context, id string) (models.Workflow, error) {
 	key, err := dynamodbattribute.MarshalMap(ddbWorkflowPrimaryKey{
@@ -647,6 +894,548 @@ func (d DynamoDB) GetWorkflows(ctx context.Context, query *models.WorkflowQuery)
 	return workflows, nextPageToken, nil
 }
 
+// SaveBulkOperation saves a bulk operation to dynamo.
+// If the bulk operation already exists, it will return a store.ConflictError.
+func (d DynamoDB) SaveBulkOperation(ctx context.Context, op models.BulkOperation) error {
+	op.CreatedAt = strfmt.DateTime(time.Now())
+	op.LastUpdated = op.CreatedAt
+
+	data, err := EncodeBulkOperation(op)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.bulkOperationsTable()),
+		Item:      data,
+		ExpressionAttributeNames: map[string]*string{
+			"#I": aws.String("id"),
+		},
+		ConditionExpression: aws.String("attribute_not_exists(#I)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewConflict(op.ID)
+			}
+		}
+		return err
+	}
+	return d.saveBulkOperationResults(ctx, op)
+}
+
+// UpdateBulkOperation overwrites an existing bulk operation.
+func (d DynamoDB) UpdateBulkOperation(ctx context.Context, op models.BulkOperation) error {
+	op.LastUpdated = strfmt.DateTime(time.Now())
+
+	data, err := EncodeBulkOperation(op)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.bulkOperationsTable()),
+		Item:      data,
+		ExpressionAttributeNames: map[string]*string{
+			"#I": aws.String("id"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#I)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(op.ID)
+			}
+		}
+		return err
+	}
+	return d.saveBulkOperationResults(ctx, op)
+}
+
+// saveBulkOperationResults writes every result of a bulk operation, 25 at a time.
+func (d DynamoDB) saveBulkOperationResults(ctx context.Context, op models.BulkOperation) error {
+	requests := []*dynamodb.WriteRequest{}
+	for i, result := range op.Results {
+		data, err := EncodeBulkOperationResult(op.ID, i, *result)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: data}})
+	}
+	for len(requests) > 0 {
+		batch := requests
+		if len(batch) > 25 {
+			batch = batch[:25]
+		}
+		requests = requests[len(batch):]
+		out, err := d.ddb.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{d.bulkOperationResultsTable(): batch},
+		})
+		if err != nil {
+			return err
+		}
+		// throttled writes are returned as unprocessed, so retry them with the next batch
+		requests = append(out.UnprocessedItems[d.bulkOperationResultsTable()], requests...)
+	}
+	return nil
+}
+
+// UpdateBulkOperationResult overwrites the result of a bulk operation for one workflow, and bumps
+// the operation's lastUpdated.
+func (d DynamoDB) UpdateBulkOperationResult(ctx context.Context, id string, result models.BulkOperationResult) error {
+	key, err := dynamodbattribute.MarshalMap(ddbBulkOperationResultPrimaryKey{
+		OperationID: id,
+		WorkflowID:  result.WorkflowID,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := dynamodbattribute.Marshal(result)
+	if err != nil {
+		return err
+	}
+	if _, err := d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(d.bulkOperationResultsTable()),
+		Key:       key,
+		ExpressionAttributeNames: map[string]*string{
+			"#O": aws.String("operationID"),
+			"#R": aws.String("result"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":result": data,
+		},
+		ConditionExpression: aws.String("attribute_exists(#O)"),
+		UpdateExpression:    aws.String("SET #R = :result"),
+	}); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return store.NewNotFound(result.WorkflowID)
+		}
+		return err
+	}
+
+	opKey, err := dynamodbattribute.MarshalMap(ddbBulkOperationPrimaryKey{ID: id})
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	nowDateTime, err := dynamodbattribute.Marshal(strfmt.DateTime(now))
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName: aws.String(d.bulkOperationsTable()),
+		Key:       opKey,
+		ExpressionAttributeNames: map[string]*string{
+			"#I":  aws.String("id"),
+			"#U":  aws.String("lastUpdated"),
+			"#B":  aws.String("BulkOperation"),
+			"#BU": aws.String("lastUpdated"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":now":         {N: aws.String(strconv.FormatInt(now.Unix(), 10))},
+			":nowDateTime": nowDateTime,
+		},
+		ConditionExpression: aws.String("attribute_exists(#I)"),
+		UpdateExpression:    aws.String("SET #U = :now, #B.#BU = :nowDateTime"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+			return store.NewNotFound(id)
+		}
+	}
+	return err
+}
+
+// getBulkOperationResults returns the results of a bulk operation, in the order they were saved.
+func (d DynamoDB) getBulkOperationResults(ctx context.Context, id string) ([]*models.BulkOperationResult, error) {
+	items := []ddbBulkOperationResult{}
+	var decodeErr error
+	err := d.ddb.QueryPagesWithContext(ctx, &dynamodb.QueryInput{
+		TableName:      aws.String(d.bulkOperationResultsTable()),
+		ConsistentRead: aws.Bool(true),
+		ExpressionAttributeNames: map[string]*string{
+			"#O": aws.String("operationID"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":id": {S: aws.String(id)},
+		},
+		KeyConditionExpression: aws.String("#O = :id"),
+	}, func(page *dynamodb.QueryOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			var result ddbBulkOperationResult
+			if err := dynamodbattribute.UnmarshalMap(item, &result); err != nil {
+				decodeErr = err
+				return false
+			}
+			items = append(items, result)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Index < items[j].Index })
+	results := []*models.BulkOperationResult{}
+	for i := range items {
+		results = append(results, &items[i].Result)
+	}
+	return results, nil
+}
+
+// GetBulkOperationByID gets the bulk operation with the given ID.
+func (d DynamoDB) GetBulkOperationByID(ctx context.Context, id string) (models.BulkOperation, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbBulkOperationPrimaryKey{
+		ID: id,
+	})
+	if err != nil {
+		return models.BulkOperation{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.bulkOperationsTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.BulkOperation{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.BulkOperation{}, store.NewNotFound(id)
+	}
+
+	op, err := DecodeBulkOperation(res.Item)
+	if err != nil {
+		return models.BulkOperation{}, err
+	}
+	if op.Results, err = d.getBulkOperationResults(ctx, id); err != nil {
+		return models.BulkOperation{}, err
+	}
+	return op, nil
+}
+
+// ClaimStaleBulkOperations returns the running bulk operations that haven't been updated since
+// staleBefore. Each one is claimed with a conditional update of its lastUpdated attribute, so that
+// only one caller resumes it.
+func (d DynamoDB) ClaimStaleBulkOperations(ctx context.Context, staleBefore time.Time) ([]models.BulkOperation, error) {
+	stale := []ddbBulkOperation{}
+	var decodeErr error
+	// Scan returns the entire table, which only holds one item per bulk operation
+	err := d.ddb.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(d.bulkOperationsTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#S": aws.String("status"),
+			"#U": aws.String("lastUpdated"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":running":     {S: aws.String(string(models.BulkOperationStatusRunning))},
+			":staleBefore": {N: aws.String(strconv.FormatInt(staleBefore.Unix(), 10))},
+		},
+		FilterExpression: aws.String("#S = :running AND #U < :staleBefore"),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			var op ddbBulkOperation
+			if err := dynamodbattribute.UnmarshalMap(item, &op); err != nil {
+				decodeErr = err
+				return false
+			}
+			stale = append(stale, op)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	claimed := []models.BulkOperation{}
+	for _, item := range stale {
+		key, err := dynamodbattribute.MarshalMap(item.ddbBulkOperationPrimaryKey)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now()
+		_, err = d.ddb.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+			TableName: aws.String(d.bulkOperationsTable()),
+			Key:       key,
+			ExpressionAttributeNames: map[string]*string{
+				"#U": aws.String("lastUpdated"),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":read": {N: aws.String(strconv.FormatInt(time.Time(item.LastUpdated).Unix(), 10))},
+				":now":  {N: aws.String(strconv.FormatInt(now.Unix(), 10))},
+			},
+			ConditionExpression: aws.String("#U = :read"),
+			UpdateExpression:    aws.String("SET #U = :now"),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				// another process claimed it first, or the operation made progress
+				continue
+			}
+			return nil, err
+		}
+		op := item.BulkOperation
+		op.LastUpdated = strfmt.DateTime(now)
+		if op.Results, err = d.getBulkOperationResults(ctx, op.ID); err != nil {
+			return nil, err
+		}
+		claimed = append(claimed, op)
+	}
+	return claimed, nil
+}
+
+// SaveTaskToken saves the task token that was handed to a callback state's worker.
+func (d DynamoDB) SaveTaskToken(ctx context.Context, workerName, token string) error {
+	data, err := EncodeTaskToken(workerName, token)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.taskTokensTable()),
+		Item:      data,
+	})
+	return err
+}
+
+// GetTaskToken gets the task token that was handed to the given callback state worker.
+func (d DynamoDB) GetTaskToken(ctx context.Context, workerName string) (string, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbTaskTokenPrimaryKey{
+		WorkerName: workerName,
+	})
+	if err != nil {
+		return "", err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.taskTokensTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(res.Item) == 0 {
+		return "", store.NewNotFound(workerName)
+	}
+
+	return DecodeTaskToken(res.Item)
+}
+
+// SaveWorker saves the latest heartbeat of an activity worker.
+func (d DynamoDB) SaveWorker(ctx context.Context, worker models.Worker) error {
+	data, err := EncodeWorker(worker)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workersTable()),
+		Item:      data,
+	})
+	return err
+}
+
+// GetWorkers gets all workers that heartbeated within the WorkerTTL.
+func (d DynamoDB) GetWorkers(ctx context.Context) ([]models.Worker, error) {
+	workers := []models.Worker{}
+	var decodeErr error
+	// Scan returns the entire table, which stays small since workers are TTL'd
+	err := d.ddb.ScanPagesWithContext(ctx, &dynamodb.ScanInput{
+		ConsistentRead: aws.Bool(true),
+		TableName:      aws.String(d.workersTable()),
+	}, func(page *dynamodb.ScanOutput, lastPage bool) bool {
+		for _, item := range page.Items {
+			worker, err := DecodeWorker(item)
+			if err != nil {
+				decodeErr = err
+				return false
+			}
+			// dynamo can take a while to delete expired items
+			if time.Since(time.Time(worker.LastHeartbeat)) < WorkerTTL {
+				workers = append(workers, worker)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return []models.Worker{}, err
+	}
+	if decodeErr != nil {
+		return []models.Worker{}, decodeErr
+	}
+	return workers, nil
+}
+
+// SaveWorkflowDefinitionAlias creates or moves an alias of a workflow definition.
+func (d DynamoDB) SaveWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error {
+	alias.UpdatedAt = strfmt.DateTime(time.Now())
+	data, err := EncodeWorkflowDefinitionAlias(alias)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		Item:      data,
+	})
+	return err
+}
+
+// GetWorkflowDefinitionAlias gets an alias of a workflow definition.
+func (d DynamoDB) GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionAliasPrimaryKey{
+		Name:  name,
+		Alias: alias,
+	})
+	if err != nil {
+		return models.WorkflowDefinitionAlias{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.workflowDefinitionAliasesTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.WorkflowDefinitionAlias{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.WorkflowDefinitionAlias{}, store.NewNotFound(fmt.Sprintf("%s@%s", name, alias))
+	}
+
+	return DecodeWorkflowDefinitionAlias(res.Item)
+}
+
+// GetWorkflowDefinitionAliases gets the aliases of a workflow definition.
+func (d DynamoDB) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	results, err := d.ddb.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#N": aws.String("name"),
+		},
+		ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+			":name": &dynamodb.AttributeValue{
+				S: aws.String(name),
+			},
+		},
+		KeyConditionExpression: aws.String("#N = :name"),
+		ConsistentRead:         aws.Bool(true),
+	})
+	if err != nil {
+		return []models.WorkflowDefinitionAlias{}, err
+	}
+
+	aliases := []models.WorkflowDefinitionAlias{}
+	for _, item := range results.Items {
+		alias, err := DecodeWorkflowDefinitionAlias(item)
+		if err != nil {
+			return []models.WorkflowDefinitionAlias{}, err
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+// DeleteWorkflowDefinitionAlias deletes an alias of a workflow definition.
+func (d DynamoDB) DeleteWorkflowDefinitionAlias(ctx context.Context, name, alias string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionAliasPrimaryKey{
+		Name:  name,
+		Alias: alias,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		Key:       key,
+		TableName: aws.String(d.workflowDefinitionAliasesTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#N": aws.String("name"),
+			"#A": aws.String("alias"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#N) AND attribute_exists(#A)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(fmt.Sprintf("%s@%s", name, alias))
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// SaveWorkflowDefinitionRollout creates or updates the rollout of a workflow definition.
+func (d DynamoDB) SaveWorkflowDefinitionRollout(ctx context.Context, rollout models.WorkflowDefinitionRollout) error {
+	rollout.UpdatedAt = strfmt.DateTime(time.Now())
+	data, err := EncodeWorkflowDefinitionRollout(rollout)
+	if err != nil {
+		return err
+	}
+	_, err = d.ddb.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(d.workflowDefinitionRolloutsTable()),
+		Item:      data,
+	})
+	return err
+}
+
+// GetWorkflowDefinitionRollout gets the rollout of a workflow definition.
+func (d DynamoDB) GetWorkflowDefinitionRollout(ctx context.Context, name string) (models.WorkflowDefinitionRollout, error) {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionRolloutPrimaryKey{
+		Name: name,
+	})
+	if err != nil {
+		return models.WorkflowDefinitionRollout{}, err
+	}
+	res, err := d.ddb.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		Key:            key,
+		TableName:      aws.String(d.workflowDefinitionRolloutsTable()),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return models.WorkflowDefinitionRollout{}, err
+	}
+
+	if len(res.Item) == 0 {
+		return models.WorkflowDefinitionRollout{}, store.NewNotFound(name)
+	}
+
+	return DecodeWorkflowDefinitionRollout(res.Item)
+}
+
+// DeleteWorkflowDefinitionRollout deletes the rollout of a workflow definition.
+func (d DynamoDB) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	key, err := dynamodbattribute.MarshalMap(ddbWorkflowDefinitionRolloutPrimaryKey{
+		Name: name,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.ddb.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		Key:       key,
+		TableName: aws.String(d.workflowDefinitionRolloutsTable()),
+		ExpressionAttributeNames: map[string]*string{
+			"#N": aws.String("name"),
+		},
+		ConditionExpression: aws.String("attribute_exists(#N)"),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			if awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException {
+				return store.NewNotFound(name)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
 type byLastUpdatedTime []models.Workflow
 
 func (b byLastUpdatedTime) Len() int      { return len(b) }
@@ -41,6 +41,9 @@ func TestDynamoDBStore(t *testing.T) {
 			PrefixStateResources:      prefix,
 			PrefixWorkflowDefinitions: prefix,
 			PrefixWorkflows:           prefix,
+			PrefixBulkOperations:      prefix,
+			PrefixTaskTokens:          prefix,
+			PrefixWorkers:             prefix,
 		})
 		if s.Future, err = dynamodbgen.New(dynamodbgen.Config{
 			DynamoDBAPI:   svc,
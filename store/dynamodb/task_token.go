@@ -0,0 +1,80 @@
+package dynamodb
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/go-openapi/strfmt"
+)
+
+// TaskTokenTTL is how long a task token is kept after it's saved. Step Functions executions, and
+// so the callback states waiting on their task tokens, run for at most a year.
+const TaskTokenTTL = 365 * 24 * time.Hour
+
+// ddbTaskTokenPrimaryKey represents the primary key of the task tokens table.
+// Use this to make GetItem queries.
+type ddbTaskTokenPrimaryKey struct {
+	WorkerName string `dynamodbav:"workerName"`
+}
+
+func (pk ddbTaskTokenPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("workerName"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbTaskTokenPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("workerName"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
+
+// ddbTaskTokenTTL is the time at which the task token will get TTL'd by dynamo.
+type ddbTaskTokenTTL struct {
+	TTL strfmt.DateTime `dynamodbav:"_ttl,unixtime"` // must be unix time to work with dynamodb builtin TTL support
+}
+
+func (ttl ddbTaskTokenTTL) AttributeDefinition() *dynamodb.AttributeDefinition {
+	return &dynamodb.AttributeDefinition{
+		AttributeName: aws.String("_ttl"),
+		AttributeType: aws.String(dynamodb.ScalarAttributeTypeN),
+	}
+}
+
+// ddbTaskToken represents the task token of a callback state as stored in dynamo.
+// Use this to make PutItem queries.
+type ddbTaskToken struct {
+	ddbTaskTokenPrimaryKey
+	ddbTaskTokenTTL
+	TaskToken string `dynamodbav:"taskToken"`
+}
+
+// EncodeTaskToken encodes a task token as a dynamo attribute map.
+func EncodeTaskToken(workerName, token string) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbTaskToken{
+		ddbTaskTokenPrimaryKey: ddbTaskTokenPrimaryKey{
+			WorkerName: workerName,
+		},
+		ddbTaskTokenTTL: ddbTaskTokenTTL{
+			TTL: strfmt.DateTime(time.Now().Add(TaskTokenTTL)),
+		},
+		TaskToken: token,
+	})
+}
+
+// DecodeTaskToken translates a task token stored in dynamodb to a string.
+func DecodeTaskToken(m map[string]*dynamodb.AttributeValue) (string, error) {
+	var res ddbTaskToken
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return "", err
+	}
+	return res.TaskToken, nil
+}
@@ -0,0 +1,92 @@
+package dynamodb
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/go-openapi/strfmt"
+)
+
+// WorkerTTL is how long a worker is kept after its last heartbeat.
+const WorkerTTL = 24 * time.Hour
+
+// ddbWorkerPrimaryKey represents the primary key of the workers table.
+// Workers are keyed by the resource they poll, since one worker can poll many resources.
+type ddbWorkerPrimaryKey struct {
+	// Resource is the namespace and resource of the worker, e.g. "production--my-worker"
+	Resource string `dynamodbav:"resource"`
+	Name     string `dynamodbav:"name"`
+}
+
+func (pk ddbWorkerPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("resource"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbWorkerPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("resource"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// ddbWorkerTTL is the time at which the worker will get TTL'd by dynamo.
+type ddbWorkerTTL struct {
+	TTL strfmt.DateTime `dynamodbav:"_ttl,unixtime"` // must be unix time to work with dynamodb builtin TTL support
+}
+
+func (ttl ddbWorkerTTL) AttributeDefinition() *dynamodb.AttributeDefinition {
+	return &dynamodb.AttributeDefinition{
+		AttributeName: aws.String("_ttl"),
+		AttributeType: aws.String(dynamodb.ScalarAttributeTypeN),
+	}
+}
+
+// ddbWorker represents a worker as stored in dynamo.
+// Use this to make PutItem queries.
+type ddbWorker struct {
+	ddbWorkerPrimaryKey
+	ddbWorkerTTL
+	Worker models.Worker
+}
+
+// EncodeWorker encodes a Worker as a dynamo attribute map.
+func EncodeWorker(worker models.Worker) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbWorker{
+		ddbWorkerPrimaryKey: ddbWorkerPrimaryKey{
+			Resource: fmt.Sprintf("%s--%s", worker.Namespace, worker.Resource),
+			Name:     worker.Name,
+		},
+		ddbWorkerTTL: ddbWorkerTTL{
+			TTL: strfmt.DateTime(time.Time(worker.LastHeartbeat).Add(WorkerTTL)),
+		},
+		Worker: worker,
+	})
+}
+
+// DecodeWorker translates a worker stored in dynamodb to a Worker object.
+func DecodeWorker(m map[string]*dynamodb.AttributeValue) (models.Worker, error) {
+	var dw ddbWorker
+	if err := dynamodbattribute.UnmarshalMap(m, &dw); err != nil {
+		return models.Worker{}, err
+	}
+	return dw.Worker, nil
+}
@@ -25,6 +25,14 @@ var SummaryKeys = []string{
 	"Workflow.resolvedByUser",
 	"Workflow.retries",
 	"Workflow.retryFor",
+	"Workflow.autoRetryAttempt",
+	"Workflow.autoRetryAt",
+	"Workflow.softDeadlineBreached",
+	"Workflow.parentWorkflowID",
+	"Workflow.childWorkflowIDs",
+	"Workflow.triggeredBy",
+	"Workflow.triggerDepth",
+	"Workflow.rolloutArm",
 	"Workflow.#S", // status
 	"Workflow.tags",
 
@@ -0,0 +1,68 @@
+package dynamodb
+
+import (
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ddbWorkflowDefinitionAliasPrimaryKey represents the primary key of the workflow definition aliases table.
+// Use this to make GetItem and DeleteItem queries.
+type ddbWorkflowDefinitionAliasPrimaryKey struct {
+	Name  string `dynamodbav:"name"`
+	Alias string `dynamodbav:"alias"`
+}
+
+func (pk ddbWorkflowDefinitionAliasPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+		{
+			AttributeName: aws.String("alias"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbWorkflowDefinitionAliasPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+		{
+			AttributeName: aws.String("alias"),
+			KeyType:       aws.String(dynamodb.KeyTypeRange),
+		},
+	}
+}
+
+// ddbWorkflowDefinitionAlias represents a workflow definition alias as stored in dynamo.
+// Use this to make PutItem queries.
+type ddbWorkflowDefinitionAlias struct {
+	ddbWorkflowDefinitionAliasPrimaryKey
+	WorkflowDefinitionAlias models.WorkflowDefinitionAlias
+}
+
+// EncodeWorkflowDefinitionAlias encodes a WorkflowDefinitionAlias as a dynamo attribute map.
+func EncodeWorkflowDefinitionAlias(alias models.WorkflowDefinitionAlias) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbWorkflowDefinitionAlias{
+		ddbWorkflowDefinitionAliasPrimaryKey: ddbWorkflowDefinitionAliasPrimaryKey{
+			Name:  alias.Name,
+			Alias: alias.Alias,
+		},
+		WorkflowDefinitionAlias: alias,
+	})
+}
+
+// DecodeWorkflowDefinitionAlias translates a WorkflowDefinitionAlias stored in dynamodb to a WorkflowDefinitionAlias object.
+func DecodeWorkflowDefinitionAlias(m map[string]*dynamodb.AttributeValue) (models.WorkflowDefinitionAlias, error) {
+	var res ddbWorkflowDefinitionAlias
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.WorkflowDefinitionAlias{}, err
+	}
+	return res.WorkflowDefinitionAlias, nil
+}
@@ -0,0 +1,58 @@
+package dynamodb
+
+import (
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// ddbWorkflowDefinitionRolloutPrimaryKey represents the primary key of the workflow definition rollouts table.
+// Use this to make GetItem and DeleteItem queries.
+type ddbWorkflowDefinitionRolloutPrimaryKey struct {
+	Name string `dynamodbav:"name"`
+}
+
+func (pk ddbWorkflowDefinitionRolloutPrimaryKey) AttributeDefinitions() []*dynamodb.AttributeDefinition {
+	return []*dynamodb.AttributeDefinition{
+		{
+			AttributeName: aws.String("name"),
+			AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+		},
+	}
+}
+
+func (pk ddbWorkflowDefinitionRolloutPrimaryKey) KeySchema() []*dynamodb.KeySchemaElement {
+	return []*dynamodb.KeySchemaElement{
+		{
+			AttributeName: aws.String("name"),
+			KeyType:       aws.String(dynamodb.KeyTypeHash),
+		},
+	}
+}
+
+// ddbWorkflowDefinitionRollout represents a workflow definition rollout as stored in dynamo.
+// Use this to make PutItem queries.
+type ddbWorkflowDefinitionRollout struct {
+	ddbWorkflowDefinitionRolloutPrimaryKey
+	WorkflowDefinitionRollout models.WorkflowDefinitionRollout
+}
+
+// EncodeWorkflowDefinitionRollout encodes a WorkflowDefinitionRollout as a dynamo attribute map.
+func EncodeWorkflowDefinitionRollout(rollout models.WorkflowDefinitionRollout) (map[string]*dynamodb.AttributeValue, error) {
+	return dynamodbattribute.MarshalMap(ddbWorkflowDefinitionRollout{
+		ddbWorkflowDefinitionRolloutPrimaryKey: ddbWorkflowDefinitionRolloutPrimaryKey{
+			Name: rollout.Name,
+		},
+		WorkflowDefinitionRollout: rollout,
+	})
+}
+
+// DecodeWorkflowDefinitionRollout translates a WorkflowDefinitionRollout stored in dynamodb to a WorkflowDefinitionRollout object.
+func DecodeWorkflowDefinitionRollout(m map[string]*dynamodb.AttributeValue) (models.WorkflowDefinitionRollout, error) {
+	var res ddbWorkflowDefinitionRollout
+	if err := dynamodbattribute.UnmarshalMap(m, &res); err != nil {
+		return models.WorkflowDefinitionRollout{}, err
+	}
+	return res.WorkflowDefinitionRollout, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,11 +16,18 @@ import (
 	"github.com/Clever/workflow-manager/store"
 )
 
+// MemoryStore is a Store that keeps everything in memory. It's safe for concurrent use.
 type MemoryStore struct {
+	mu                  *sync.Mutex
 	workflowDefinitions map[string][]models.WorkflowDefinition
 	workflows           map[string]models.Workflow
 	workflowsLocked     map[string]struct{}
 	stateResources      map[string]models.StateResource
+	bulkOperations      map[string]models.BulkOperation
+	taskTokens          map[string]string
+	workers             map[string]models.Worker
+	aliases             map[string]map[string]models.WorkflowDefinitionAlias
+	rollouts            map[string]models.WorkflowDefinitionRollout
 }
 
 type ByCreatedAt []models.Workflow
@@ -32,15 +40,22 @@ func (a ByCreatedAt) Less(i, j int) bool {
 
 func New() MemoryStore {
 	return MemoryStore{
+		mu:                  &sync.Mutex{},
 		workflowDefinitions: map[string][]models.WorkflowDefinition{},
 		workflows:           map[string]models.Workflow{},
 		workflowsLocked:     map[string]struct{}{},
 		stateResources:      map[string]models.StateResource{},
+		bulkOperations:      map[string]models.BulkOperation{},
+		taskTokens:          map[string]string{},
+		workers:             map[string]models.Worker{},
+		aliases:             map[string]map[string]models.WorkflowDefinitionAlias{},
+		rollouts:            map[string]models.WorkflowDefinitionRollout{},
 	}
 }
 
 func (s MemoryStore) SaveWorkflowDefinition(ctx context.Context, def models.WorkflowDefinition) error {
-
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if _, ok := s.workflowDefinitions[def.Name]; ok {
 		return store.NewConflict(def.Name)
 	}
@@ -50,7 +65,9 @@ func (s MemoryStore) SaveWorkflowDefinition(ctx context.Context, def models.Work
 }
 
 func (s MemoryStore) UpdateWorkflowDefinition(ctx context.Context, def models.WorkflowDefinition) (models.WorkflowDefinition, error) {
-	last, err := s.LatestWorkflowDefinition(ctx, def.Name)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	last, err := s.latestWorkflowDefinition(def.Name)
 	if err != nil {
 		return def, err
 	}
@@ -64,6 +81,8 @@ func (s MemoryStore) UpdateWorkflowDefinition(ctx context.Context, def models.Wo
 
 // GetWorkflowDefinitions returns the latest version of all stored workflow definitions
 func (s MemoryStore) GetWorkflowDefinitions(ctx context.Context) ([]models.WorkflowDefinition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	workflowDefinitions := []models.WorkflowDefinition{}
 	// for each workflow definition
 	for _, versionedWorkflowDefinitions := range s.workflowDefinitions {
@@ -78,6 +97,8 @@ func (s MemoryStore) GetWorkflowDefinitions(ctx context.Context) ([]models.Workf
 
 // GetWorkflowDefinitionVersions gets all versions of a workflow definition
 func (s MemoryStore) GetWorkflowDefinitionVersions(ctx context.Context, name string) ([]models.WorkflowDefinition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	workflowDefinitions, ok := s.workflowDefinitions[name]
 	if !ok {
 		return []models.WorkflowDefinition{}, store.NewNotFound(name)
@@ -87,11 +108,17 @@ func (s MemoryStore) GetWorkflowDefinitionVersions(ctx context.Context, name str
 }
 
 func (s MemoryStore) GetWorkflowDefinition(ctx context.Context, name string, version int) (models.WorkflowDefinition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getWorkflowDefinition(name, version)
+}
+
+func (s MemoryStore) getWorkflowDefinition(name string, version int) (models.WorkflowDefinition, error) {
 	if _, ok := s.workflowDefinitions[name]; !ok {
 		return models.WorkflowDefinition{}, store.NewNotFound(fmt.Sprintf("%s@%d", name, version))
 	}
 
-	if len(s.workflowDefinitions[name]) < version {
+	if version < 0 || len(s.workflowDefinitions[name]) <= version {
 		return models.WorkflowDefinition{}, store.NewNotFound(fmt.Sprintf("%s@%d", name, version))
 	}
 
@@ -99,14 +126,92 @@ func (s MemoryStore) GetWorkflowDefinition(ctx context.Context, name string, ver
 }
 
 func (s MemoryStore) LatestWorkflowDefinition(ctx context.Context, name string) (models.WorkflowDefinition, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.latestWorkflowDefinition(name)
+}
+
+func (s MemoryStore) latestWorkflowDefinition(name string) (models.WorkflowDefinition, error) {
 	if _, ok := s.workflowDefinitions[name]; !ok {
 		return models.WorkflowDefinition{}, store.NewNotFound(name)
 	}
 
-	return s.GetWorkflowDefinition(ctx, name, len(s.workflowDefinitions[name])-1)
+	return s.getWorkflowDefinition(name, len(s.workflowDefinitions[name])-1)
+}
+
+func (s MemoryStore) SaveWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.aliases[alias.Name]; !ok {
+		s.aliases[alias.Name] = map[string]models.WorkflowDefinitionAlias{}
+	}
+	alias.UpdatedAt = strfmt.DateTime(time.Now())
+	s.aliases[alias.Name][alias.Alias] = alias
+	return nil
+}
+
+func (s MemoryStore) GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.aliases[name][alias]
+	if !ok {
+		return models.WorkflowDefinitionAlias{}, store.NewNotFound(fmt.Sprintf("%s@%s", name, alias))
+	}
+	return a, nil
+}
+
+func (s MemoryStore) GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	aliases := []models.WorkflowDefinitionAlias{}
+	for _, alias := range s.aliases[name] {
+		aliases = append(aliases, alias)
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Alias < aliases[j].Alias })
+	return aliases, nil
+}
+
+func (s MemoryStore) DeleteWorkflowDefinitionAlias(ctx context.Context, name, alias string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.aliases[name][alias]; !ok {
+		return store.NewNotFound(fmt.Sprintf("%s@%s", name, alias))
+	}
+	delete(s.aliases[name], alias)
+	return nil
+}
+
+func (s MemoryStore) SaveWorkflowDefinitionRollout(ctx context.Context, rollout models.WorkflowDefinitionRollout) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rollout.UpdatedAt = strfmt.DateTime(time.Now())
+	s.rollouts[rollout.Name] = rollout
+	return nil
+}
+
+func (s MemoryStore) GetWorkflowDefinitionRollout(ctx context.Context, name string) (models.WorkflowDefinitionRollout, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rollout, ok := s.rollouts[name]
+	if !ok {
+		return models.WorkflowDefinitionRollout{}, store.NewNotFound(name)
+	}
+	return rollout, nil
+}
+
+func (s MemoryStore) DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.rollouts[name]; !ok {
+		return store.NewNotFound(name)
+	}
+	delete(s.rollouts, name)
+	return nil
 }
 
 func (s MemoryStore) SaveStateResource(ctx context.Context, res models.StateResource) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	resourceName := res.Name
 	if res.Namespace != "" {
 		resourceName = fmt.Sprintf("%s--%s", res.Namespace, res.Name)
@@ -117,6 +222,8 @@ func (s MemoryStore) SaveStateResource(ctx context.Context, res models.StateReso
 }
 
 func (s MemoryStore) GetStateResource(ctx context.Context, name, namespace string) (models.StateResource, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	resourceName := name
 	if namespace != "" {
 		resourceName = fmt.Sprintf("%s--%s", namespace, name)
@@ -130,6 +237,8 @@ func (s MemoryStore) GetStateResource(ctx context.Context, name, namespace strin
 }
 
 func (s MemoryStore) DeleteStateResource(ctx context.Context, name, namespace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	resourceName := name
 	if namespace != "" {
 		resourceName = fmt.Sprintf("%s--%s", namespace, name)
@@ -144,6 +253,8 @@ func (s MemoryStore) DeleteStateResource(ctx context.Context, name, namespace st
 }
 
 func (s MemoryStore) SaveWorkflow(ctx context.Context, workflow models.Workflow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if _, ok := s.workflows[workflow.ID]; ok {
 		return store.NewConflict(workflow.ID)
 	}
@@ -154,6 +265,8 @@ func (s MemoryStore) SaveWorkflow(ctx context.Context, workflow models.Workflow)
 }
 
 func (s MemoryStore) UpdateWorkflow(ctx context.Context, workflow models.Workflow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if _, ok := s.workflows[workflow.ID]; !ok {
 		return store.NewNotFound(workflow.ID)
 	}
@@ -162,7 +275,51 @@ func (s MemoryStore) UpdateWorkflow(ctx context.Context, workflow models.Workflo
 	return nil
 }
 
+func (s MemoryStore) AppendChildWorkflowID(ctx context.Context, parentID, childID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parent, ok := s.workflows[parentID]
+	if !ok {
+		return store.NewNotFound(parentID)
+	}
+	parent.ChildWorkflowIDs = append(append([]string{}, parent.ChildWorkflowIDs...), childID)
+	s.workflows[parentID] = parent
+	return nil
+}
+
+func (s MemoryStore) ClaimAutoRetry(ctx context.Context, workflowID string, retryAt strfmt.DateTime) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	workflow, ok := s.workflows[workflowID]
+	if !ok {
+		return store.NewNotFound(workflowID)
+	}
+	if workflow.AutoRetryAt == nil || !time.Time(*workflow.AutoRetryAt).Equal(time.Time(retryAt)) {
+		return store.NewConflict(workflowID)
+	}
+	workflow.AutoRetryAt = nil
+	s.workflows[workflowID] = workflow
+	return nil
+}
+
+func (s MemoryStore) UpdateWorkflowFromStatus(ctx context.Context, workflow models.Workflow, status models.WorkflowStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.workflows[workflow.ID]
+	if !ok {
+		return store.NewNotFound(workflow.ID)
+	}
+	if stored.Status != status {
+		return store.NewConflict(workflow.ID)
+	}
+	workflow.LastUpdated = strfmt.DateTime(time.Now())
+	s.workflows[workflow.ID] = workflow
+	return nil
+}
+
 func (s MemoryStore) DeleteWorkflowByID(ctx context.Context, workflowID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if _, ok := s.workflows[workflowID]; !ok {
 		return store.NewNotFound(workflowID)
 	}
@@ -173,6 +330,8 @@ func (s MemoryStore) DeleteWorkflowByID(ctx context.Context, workflowID string)
 func (s MemoryStore) GetWorkflows(ctx context.Context,
 	query *models.WorkflowQuery,
 ) ([]models.Workflow, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	workflows := []models.Workflow{}
 
 	for _, workflow := range s.workflows {
@@ -242,6 +401,8 @@ func (s MemoryStore) matchesQuery(workflow models.Workflow, query *models.Workfl
 }
 
 func (s MemoryStore) GetWorkflowByID(ctx context.Context, id string) (models.Workflow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if _, ok := s.workflows[id]; !ok {
 		return models.Workflow{}, store.NewNotFound(id)
 	}
@@ -249,6 +410,131 @@ func (s MemoryStore) GetWorkflowByID(ctx context.Context, id string) (models.Wor
 	return s.workflows[id], nil
 }
 
+func (s MemoryStore) SaveBulkOperation(ctx context.Context, op models.BulkOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.bulkOperations[op.ID]; ok {
+		return store.NewConflict(op.ID)
+	}
+	op.CreatedAt = strfmt.DateTime(time.Now())
+	op.LastUpdated = op.CreatedAt
+	s.bulkOperations[op.ID] = copyBulkOperation(op)
+	return nil
+}
+
+func (s MemoryStore) UpdateBulkOperation(ctx context.Context, op models.BulkOperation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.bulkOperations[op.ID]; !ok {
+		return store.NewNotFound(op.ID)
+	}
+	op.LastUpdated = strfmt.DateTime(time.Now())
+	s.bulkOperations[op.ID] = copyBulkOperation(op)
+	return nil
+}
+
+func (s MemoryStore) UpdateBulkOperationResult(ctx context.Context, id string, result models.BulkOperationResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op, ok := s.bulkOperations[id]
+	if !ok {
+		return store.NewNotFound(id)
+	}
+	op = copyBulkOperation(op)
+	for i := range op.Results {
+		if op.Results[i].WorkflowID == result.WorkflowID {
+			op.Results[i] = &result
+			op.LastUpdated = strfmt.DateTime(time.Now())
+			s.bulkOperations[id] = op
+			return nil
+		}
+	}
+	return store.NewNotFound(result.WorkflowID)
+}
+
+func (s MemoryStore) GetBulkOperationByID(ctx context.Context, id string) (models.BulkOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.bulkOperations[id]; !ok {
+		return models.BulkOperation{}, store.NewNotFound(id)
+	}
+
+	return copyBulkOperation(s.bulkOperations[id]), nil
+}
+
+func (s MemoryStore) ClaimStaleBulkOperations(ctx context.Context, staleBefore time.Time) ([]models.BulkOperation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ops := []models.BulkOperation{}
+	for id, op := range s.bulkOperations {
+		if op.Status != models.BulkOperationStatusRunning || !time.Time(op.LastUpdated).Before(staleBefore) {
+			continue
+		}
+		op.LastUpdated = strfmt.DateTime(time.Now())
+		s.bulkOperations[id] = op
+		ops = append(ops, copyBulkOperation(op))
+	}
+	return ops, nil
+}
+
+// copyBulkOperation copies the results of a bulk operation, so that the stored operation doesn't
+// share them with runners that update them in place.
+func copyBulkOperation(op models.BulkOperation) models.BulkOperation {
+	if op.Results == nil {
+		return op
+	}
+	results := make([]*models.BulkOperationResult, 0, len(op.Results))
+	for _, result := range op.Results {
+		r := *result
+		results = append(results, &r)
+	}
+	op.Results = results
+	return op
+}
+
+func (s MemoryStore) SaveTaskToken(ctx context.Context, workerName, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.taskTokens[workerName] = token
+	return nil
+}
+
+func (s MemoryStore) GetTaskToken(ctx context.Context, workerName string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token, ok := s.taskTokens[workerName]
+	if !ok {
+		return "", store.NewNotFound(workerName)
+	}
+	return token, nil
+}
+
+func (s MemoryStore) SaveWorker(ctx context.Context, worker models.Worker) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workers[fmt.Sprintf("%s--%s--%s", worker.Namespace, worker.Resource, worker.Name)] = worker
+	return nil
+}
+
+func (s MemoryStore) GetWorkers(ctx context.Context) ([]models.Worker, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	workers := []models.Worker{}
+	for _, worker := range s.workers {
+		workers = append(workers, worker)
+	}
+	sort.Slice(workers, func(i, j int) bool {
+		if workers[i].Namespace != workers[j].Namespace {
+			return workers[i].Namespace < workers[j].Namespace
+		}
+		if workers[i].Resource != workers[j].Resource {
+			return workers[i].Resource < workers[j].Resource
+		}
+		return workers[i].Name < workers[j].Name
+	})
+	return workers, nil
+}
+
 type byLastUpdatedTime []models.Workflow
 
 func (b byLastUpdatedTime) Len() int      { return len(b) }
@@ -3,8 +3,10 @@ package store
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/go-openapi/strfmt"
 )
 
 // Store defines the interface for persistence of Workflow Manager resources.
@@ -16,6 +18,15 @@ type Store interface {
 	GetWorkflowDefinition(ctx context.Context, name string, version int) (models.WorkflowDefinition, error)
 	LatestWorkflowDefinition(ctx context.Context, name string) (models.WorkflowDefinition, error)
 
+	SaveWorkflowDefinitionAlias(ctx context.Context, alias models.WorkflowDefinitionAlias) error
+	GetWorkflowDefinitionAlias(ctx context.Context, name, alias string) (models.WorkflowDefinitionAlias, error)
+	GetWorkflowDefinitionAliases(ctx context.Context, name string) ([]models.WorkflowDefinitionAlias, error)
+	DeleteWorkflowDefinitionAlias(ctx context.Context, name, alias string) error
+
+	SaveWorkflowDefinitionRollout(ctx context.Context, rollout models.WorkflowDefinitionRollout) error
+	GetWorkflowDefinitionRollout(ctx context.Context, name string) (models.WorkflowDefinitionRollout, error)
+	DeleteWorkflowDefinitionRollout(ctx context.Context, name string) error
+
 	SaveStateResource(ctx context.Context, res models.StateResource) error
 	GetStateResource(ctx context.Context, name, namespace string) (models.StateResource, error)
 	DeleteStateResource(ctx context.Context, name, namespace string) error
@@ -23,8 +34,34 @@ type Store interface {
 	SaveWorkflow(ctx context.Context, workflow models.Workflow) error
 	DeleteWorkflowByID(ctx context.Context, workflowID string) error
 	UpdateWorkflow(ctx context.Context, workflow models.Workflow) error
+	// UpdateWorkflowFromStatus updates a workflow if its stored status is still status, so that only
+	// one caller records a change of status. It returns a ConflictError if the status changed.
+	UpdateWorkflowFromStatus(ctx context.Context, workflow models.Workflow, status models.WorkflowStatus) error
 	GetWorkflowByID(ctx context.Context, id string) (models.Workflow, error)
 	GetWorkflows(ctx context.Context, query *models.WorkflowQuery) ([]models.Workflow, string, error)
+	// AppendChildWorkflowID adds a child workflow to the ChildWorkflowIDs of its parent. It only
+	// writes ChildWorkflowIDs, so children started at the same time don't drop each other.
+	AppendChildWorkflowID(ctx context.Context, parentID, childID string) error
+	// ClaimAutoRetry clears a workflow's AutoRetryAt if it's still retryAt, so that only one caller
+	// starts the automatic retry. It returns a ConflictError if another caller claimed it first.
+	ClaimAutoRetry(ctx context.Context, workflowID string, retryAt strfmt.DateTime) error
+
+	SaveBulkOperation(ctx context.Context, op models.BulkOperation) error
+	UpdateBulkOperation(ctx context.Context, op models.BulkOperation) error
+	// UpdateBulkOperationResult overwrites the result of a bulk operation for one workflow, without
+	// rewriting the rest of the operation, and bumps the operation's LastUpdated.
+	UpdateBulkOperationResult(ctx context.Context, id string, result models.BulkOperationResult) error
+	GetBulkOperationByID(ctx context.Context, id string) (models.BulkOperation, error)
+	// ClaimStaleBulkOperations returns the running bulk operations that haven't been updated since
+	// staleBefore, e.g. because the process running them stopped. Each one is claimed by bumping its
+	// LastUpdated, so that only one caller resumes it.
+	ClaimStaleBulkOperations(ctx context.Context, staleBefore time.Time) ([]models.BulkOperation, error)
+
+	SaveTaskToken(ctx context.Context, workerName, token string) error
+	GetTaskToken(ctx context.Context, workerName string) (string, error)
+
+	SaveWorker(ctx context.Context, worker models.Worker) error
+	GetWorkers(ctx context.Context) ([]models.Worker, error)
 }
 
 type ConflictError struct {
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/Clever/workflow-manager/gen-go/models"
@@ -21,17 +23,28 @@ func RunStoreTests(t *testing.T, storeFactory func() store.Store) {
 	t.Run("UpdateWorkflowDefinition", UpdateWorkflowDefinition(storeFactory(), t))
 	t.Run("GetWorkflowDefinition", GetWorkflowDefinition(storeFactory(), t))
 	t.Run("SaveWorkflowDefinition", SaveWorkflowDefinition(storeFactory(), t))
+	t.Run("WorkflowDefinitionAliases", WorkflowDefinitionAliases(storeFactory(), t))
+	t.Run("WorkflowDefinitionRollout", WorkflowDefinitionRollout(storeFactory(), t))
 	t.Run("SaveStateResource", SaveStateResource(storeFactory(), t))
 	t.Run("GetStateResource", GetStateResource(storeFactory(), t))
 	t.Run("DeleteStateResource", DeleteStateResource(storeFactory(), t))
 	t.Run("SaveWorkflow", SaveWorkflow(storeFactory(), t))
 	t.Run("UpdateWorkflow", UpdateWorkflow(storeFactory(), t))
 	t.Run("UpdateLargeWorkflow", UpdateLargeWorkflow(storeFactory(), t))
+	t.Run("UpdateWorkflowFromStatus", UpdateWorkflowFromStatus(storeFactory(), t))
+	t.Run("ClaimAutoRetry", ClaimAutoRetry(storeFactory(), t))
+	t.Run("AppendChildWorkflowID", AppendChildWorkflowID(storeFactory(), t))
 	t.Run("DeleteWorkflow", DeleteWorkflow(storeFactory(), t))
 	t.Run("GetWorkflowByID", GetWorkflowByID(storeFactory(), t))
 	t.Run("GetWorkflows", GetWorkflows(storeFactory(), t))
 	t.Run("GetWorkflowsSummaryOnly", GetWorkflowsSummaryOnly(storeFactory(), t))
 	t.Run("GetWorkflowsPagination", GetWorkflowsPagination(storeFactory(), t))
+	t.Run("SaveBulkOperation", SaveBulkOperation(storeFactory(), t))
+	t.Run("UpdateBulkOperation", UpdateBulkOperation(storeFactory(), t))
+	t.Run("UpdateBulkOperationResult", UpdateBulkOperationResult(storeFactory(), t))
+	t.Run("ClaimStaleBulkOperations", ClaimStaleBulkOperations(storeFactory(), t))
+	t.Run("SaveTaskToken", SaveTaskToken(storeFactory(), t))
+	t.Run("SaveWorker", SaveWorker(storeFactory(), t))
 }
 
 func UpdateWorkflowDefinition(s store.Store, t *testing.T) func(t *testing.T) {
@@ -219,6 +232,70 @@ func UpdateWorkflow(s store.Store, t *testing.T) func(t *testing.T) {
 	}
 }
 
+func UpdateWorkflowFromStatus(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		require.Nil(t, s.SaveWorkflowDefinition(ctx, *wf))
+		workflow := resources.NewWorkflow(wf, `["input"]`, "namespace", "queue", map[string]interface{}{})
+		workflow.Status = models.WorkflowStatusRunning
+		require.Nil(t, s.SaveWorkflow(ctx, *workflow))
+
+		workflow.Status = models.WorkflowStatusSucceeded
+		require.Nil(t, s.UpdateWorkflowFromStatus(ctx, *workflow, models.WorkflowStatusRunning))
+		workflow.Status = models.WorkflowStatusFailed
+		require.IsType(t, store.ConflictError{}, s.UpdateWorkflowFromStatus(ctx, *workflow, models.WorkflowStatusRunning))
+		unknown := *workflow
+		unknown.ID = "unknown"
+		require.IsType(t, models.NotFound{}, s.UpdateWorkflowFromStatus(ctx, unknown, models.WorkflowStatusRunning))
+
+		savedWorkflow, err := s.GetWorkflowByID(ctx, workflow.ID)
+		require.Nil(t, err)
+		require.Equal(t, models.WorkflowStatusSucceeded, savedWorkflow.Status)
+	}
+}
+
+func ClaimAutoRetry(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		require.Nil(t, s.SaveWorkflowDefinition(ctx, *wf))
+		workflow := resources.NewWorkflow(wf, `["input"]`, "namespace", "queue", map[string]interface{}{})
+		retryAt := strfmt.DateTime(time.Now())
+		workflow.AutoRetryAt = &retryAt
+		require.Nil(t, s.SaveWorkflow(ctx, *workflow))
+
+		require.IsType(t, store.ConflictError{}, s.ClaimAutoRetry(ctx, workflow.ID, strfmt.DateTime(time.Now().Add(time.Minute))))
+		require.Nil(t, s.ClaimAutoRetry(ctx, workflow.ID, retryAt))
+		require.IsType(t, store.ConflictError{}, s.ClaimAutoRetry(ctx, workflow.ID, retryAt))
+
+		savedWorkflow, err := s.GetWorkflowByID(ctx, workflow.ID)
+		require.Nil(t, err)
+		require.Nil(t, savedWorkflow.AutoRetryAt)
+	}
+}
+
+func AppendChildWorkflowID(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		require.Nil(t, s.SaveWorkflowDefinition(ctx, *wf))
+		workflow := resources.NewWorkflow(wf, `["input"]`, "namespace", "queue", map[string]interface{}{})
+		require.Nil(t, s.SaveWorkflow(ctx, *workflow))
+
+		require.Nil(t, s.AppendChildWorkflowID(ctx, workflow.ID, "first"))
+		require.Nil(t, s.AppendChildWorkflowID(ctx, workflow.ID, "second"))
+		require.IsType(t, models.NotFound{}, s.AppendChildWorkflowID(ctx, "unknown", "third"))
+
+		savedWorkflow, err := s.GetWorkflowByID(ctx, workflow.ID)
+		require.Nil(t, err)
+		require.Equal(t, []string{"first", "second"}, savedWorkflow.ChildWorkflowIDs)
+	}
+}
+
 func UpdateLargeWorkflow(s store.Store, t *testing.T) func(t *testing.T) {
 	return func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -455,6 +532,10 @@ func GetWorkflowsSummaryOnly(s store.Store, t *testing.T) func(t *testing.T) {
 		}}
 		workflow.Retries = []string{"x"}
 		workflow.RetryFor = "y"
+		workflow.ParentWorkflowID = "z"
+		workflow.ChildWorkflowIDs = []string{"w"}
+		workflow.TriggeredBy = "v"
+		workflow.RolloutArm = "u"
 		workflow.StatusReason = "test reason"
 		require.NoError(t, s.SaveWorkflow(ctx, *workflow))
 
@@ -577,3 +658,261 @@ func GetWorkflowsPagination(s store.Store, t *testing.T) func(t *testing.T) {
 		assert.Len(t, workflows, 0)
 	}
 }
+
+func SaveBulkOperation(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		op := resources.NewBulkOperation(&models.BulkOperationRequest{
+			Action:      models.BulkOperationActionResolve,
+			WorkflowIDs: []string{"workflow-1", "workflow-2"},
+		})
+		require.Nil(t, s.SaveBulkOperation(ctx, *op))
+
+		savedOp, err := s.GetBulkOperationByID(ctx, op.ID)
+		require.Nil(t, err)
+		require.Equal(t, op.ID, savedOp.ID)
+		require.Equal(t, models.BulkOperationStatusRunning, savedOp.Status)
+		require.Equal(t, op.Request.WorkflowIDs, savedOp.Request.WorkflowIDs)
+		require.WithinDuration(t, time.Time(savedOp.CreatedAt), time.Now(), 1*time.Second)
+
+		// saving the same operation twice is a conflict
+		err = s.SaveBulkOperation(ctx, *op)
+		require.Error(t, err)
+		require.IsType(t, store.ConflictError{}, err)
+
+		_, err = s.GetBulkOperationByID(ctx, "not-a-bulk-operation")
+		require.Error(t, err)
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
+func UpdateBulkOperation(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		op := resources.NewBulkOperation(&models.BulkOperationRequest{
+			Action:      models.BulkOperationActionCancel,
+			WorkflowIDs: []string{"workflow-1"},
+			Reason:      "incident",
+		})
+		require.Error(t, s.UpdateBulkOperation(ctx, *op))
+		require.Nil(t, s.SaveBulkOperation(ctx, *op))
+
+		savedOp, err := s.GetBulkOperationByID(ctx, op.ID)
+		require.Nil(t, err)
+		savedOp.Status = models.BulkOperationStatusCompleted
+		savedOp.Results = []*models.BulkOperationResult{
+			{WorkflowID: "workflow-1", Status: models.BulkOperationResultStatusSucceeded},
+		}
+		require.Nil(t, s.UpdateBulkOperation(ctx, savedOp))
+
+		updatedOp, err := s.GetBulkOperationByID(ctx, op.ID)
+		require.Nil(t, err)
+		require.Equal(t, models.BulkOperationStatusCompleted, updatedOp.Status)
+		require.Len(t, updatedOp.Results, 1)
+		require.Equal(t, models.BulkOperationResultStatusSucceeded, updatedOp.Results[0].Status)
+		require.True(t, time.Time(updatedOp.LastUpdated).After(time.Time(updatedOp.CreatedAt)))
+	}
+}
+
+func UpdateBulkOperationResult(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		op := resources.NewBulkOperation(&models.BulkOperationRequest{
+			Action: models.BulkOperationActionResolve,
+		})
+		// a thousand results with long errors are larger than a single dynamo item can be
+		longError := strings.Repeat("States.TaskFailed: connection reset by peer. ", 20)
+		for i := 0; i < 1000; i++ {
+			op.Results = append(op.Results, &models.BulkOperationResult{
+				WorkflowID: fmt.Sprintf("workflow-%04d", i),
+				Status:     models.BulkOperationResultStatusFailed,
+				Error:      longError,
+			})
+		}
+		op.Results[999].Status = models.BulkOperationResultStatusPending
+		op.Results[999].Error = ""
+		require.Nil(t, s.SaveBulkOperation(ctx, *op))
+		require.Nil(t, s.UpdateBulkOperation(ctx, *op))
+
+		savedOp, err := s.GetBulkOperationByID(ctx, op.ID)
+		require.Nil(t, err)
+		require.Len(t, savedOp.Results, 1000)
+		require.Equal(t, "workflow-0000", savedOp.Results[0].WorkflowID)
+		require.Equal(t, longError, savedOp.Results[0].Error)
+
+		require.Nil(t, s.UpdateBulkOperationResult(ctx, op.ID, models.BulkOperationResult{
+			WorkflowID: "workflow-0999",
+			Status:     models.BulkOperationResultStatusFailed,
+			Error:      longError,
+		}))
+		updatedOp, err := s.GetBulkOperationByID(ctx, op.ID)
+		require.Nil(t, err)
+		require.Len(t, updatedOp.Results, 1000)
+		require.Equal(t, models.BulkOperationResultStatusFailed, updatedOp.Results[999].Status)
+		require.Equal(t, longError, updatedOp.Results[999].Error)
+		require.False(t, time.Time(updatedOp.LastUpdated).Before(time.Time(savedOp.LastUpdated)))
+
+		err = s.UpdateBulkOperationResult(ctx, op.ID, models.BulkOperationResult{WorkflowID: "not-a-workflow"})
+		require.IsType(t, models.NotFound{}, err)
+		err = s.UpdateBulkOperationResult(ctx, "not-a-bulk-operation", models.BulkOperationResult{WorkflowID: "workflow-0000"})
+		require.IsType(t, models.NotFound{}, err)
+	}
+}
+
+func ClaimStaleBulkOperations(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		running := resources.NewBulkOperation(&models.BulkOperationRequest{
+			Action:      models.BulkOperationActionResolve,
+			WorkflowIDs: []string{"workflow-1"},
+		})
+		require.Nil(t, s.SaveBulkOperation(ctx, *running))
+		completed := resources.NewBulkOperation(&models.BulkOperationRequest{
+			Action:      models.BulkOperationActionResolve,
+			WorkflowIDs: []string{"workflow-1"},
+		})
+		completed.Status = models.BulkOperationStatusCompleted
+		require.Nil(t, s.SaveBulkOperation(ctx, *completed))
+
+		// operations that were updated recently aren't stale
+		claimed, err := s.ClaimStaleBulkOperations(ctx, time.Now().Add(-time.Minute))
+		require.Nil(t, err)
+		require.Len(t, claimed, 0)
+
+		claimed, err = s.ClaimStaleBulkOperations(ctx, time.Now().Add(time.Minute))
+		require.Nil(t, err)
+		require.Len(t, claimed, 1)
+		require.Equal(t, running.ID, claimed[0].ID)
+
+		// a claimed operation isn't stale anymore, so it's only resumed once
+		claimed, err = s.ClaimStaleBulkOperations(ctx, time.Now().Add(-time.Minute))
+		require.Nil(t, err)
+		require.Len(t, claimed, 0)
+	}
+}
+
+func SaveTaskToken(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := s.GetTaskToken(ctx, "workflow-manager-worker")
+		require.Error(t, err)
+		require.IsType(t, models.NotFound{}, err)
+
+		require.Nil(t, s.SaveTaskToken(ctx, "workflow-manager-worker", "token"))
+		token, err := s.GetTaskToken(ctx, "workflow-manager-worker")
+		require.Nil(t, err)
+		require.Equal(t, "token", token)
+	}
+}
+
+func WorkflowDefinitionAliases(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		_, err := s.GetWorkflowDefinitionAlias(ctx, wf.Name, "stable")
+		require.IsType(t, models.NotFound{}, err)
+		aliases, err := s.GetWorkflowDefinitionAliases(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Empty(t, aliases)
+
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, models.WorkflowDefinitionAlias{
+			Name: wf.Name, Alias: "stable", Version: 1,
+		}))
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, models.WorkflowDefinitionAlias{
+			Name: wf.Name, Alias: "canary", Version: 2,
+		}))
+		alias, err := s.GetWorkflowDefinitionAlias(ctx, wf.Name, "stable")
+		require.Nil(t, err)
+		require.Equal(t, int64(1), alias.Version)
+		require.WithinDuration(t, time.Time(alias.UpdatedAt), time.Now(), 1*time.Second)
+
+		// move the alias to another version
+		require.Nil(t, s.SaveWorkflowDefinitionAlias(ctx, models.WorkflowDefinitionAlias{
+			Name: wf.Name, Alias: "stable", Version: 2,
+		}))
+		alias, err = s.GetWorkflowDefinitionAlias(ctx, wf.Name, "stable")
+		require.Nil(t, err)
+		require.Equal(t, int64(2), alias.Version)
+		aliases, err = s.GetWorkflowDefinitionAliases(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Len(t, aliases, 2)
+
+		require.Nil(t, s.DeleteWorkflowDefinitionAlias(ctx, wf.Name, "canary"))
+		_, err = s.GetWorkflowDefinitionAlias(ctx, wf.Name, "canary")
+		require.IsType(t, models.NotFound{}, err)
+		require.IsType(t, models.NotFound{}, s.DeleteWorkflowDefinitionAlias(ctx, wf.Name, "canary"))
+	}
+}
+
+func WorkflowDefinitionRollout(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		wf := resources.KitchenSinkWorkflowDefinition(t)
+		_, err := s.GetWorkflowDefinitionRollout(ctx, wf.Name)
+		require.IsType(t, models.NotFound{}, err)
+
+		require.Nil(t, s.SaveWorkflowDefinitionRollout(ctx, models.WorkflowDefinitionRollout{
+			Name: wf.Name, BaselineVersion: 1, CanaryVersion: 2, CanaryWeight: 10,
+		}))
+		rollout, err := s.GetWorkflowDefinitionRollout(ctx, wf.Name)
+		require.Nil(t, err)
+		require.Equal(t, int64(1), rollout.BaselineVersion)
+		require.Equal(t, int64(2), rollout.CanaryVersion)
+		require.Equal(t, int64(10), rollout.CanaryWeight)
+		require.WithinDuration(t, time.Time(rollout.UpdatedAt), time.Now(), 1*time.Second)
+
+		require.Nil(t, s.DeleteWorkflowDefinitionRollout(ctx, wf.Name))
+		_, err = s.GetWorkflowDefinitionRollout(ctx, wf.Name)
+		require.IsType(t, models.NotFound{}, err)
+		require.IsType(t, models.NotFound{}, s.DeleteWorkflowDefinitionRollout(ctx, wf.Name))
+	}
+}
+
+func SaveWorker(s store.Store, t *testing.T) func(t *testing.T) {
+	return func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		workers, err := s.GetWorkers(ctx)
+		require.Nil(t, err)
+		require.Len(t, workers, 0)
+
+		heartbeat := strfmt.DateTime(time.Now().Round(time.Second).UTC())
+		worker := models.Worker{
+			Name:          "worker-1",
+			Namespace:     "namespace",
+			Resource:      "resource",
+			Version:       "v1",
+			LastHeartbeat: heartbeat,
+		}
+		require.Nil(t, s.SaveWorker(ctx, worker))
+		// one worker can poll many resources
+		other := worker
+		other.Resource = "other-resource"
+		require.Nil(t, s.SaveWorker(ctx, other))
+
+		// heartbeats overwrite the worker
+		worker.Version = "v2"
+		worker.LastHeartbeat = strfmt.DateTime(time.Time(heartbeat).Add(time.Second))
+		require.Nil(t, s.SaveWorker(ctx, worker))
+
+		workers, err = s.GetWorkers(ctx)
+		require.Nil(t, err)
+		require.Len(t, workers, 2)
+		for _, w := range workers {
+			if w.Resource == "resource" {
+				require.Equal(t, "v2", w.Version)
+				require.WithinDuration(t, time.Time(worker.LastHeartbeat), time.Time(w.LastHeartbeat), 0)
+			} else {
+				require.Equal(t, other.Resource, w.Resource)
+				require.Equal(t, "v1", w.Version)
+			}
+		}
+	}
+}
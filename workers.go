@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+)
+
+const (
+	// workerLiveness is how recently a worker must have heartbeated to be considered polling.
+	// Workers heartbeat about every 30 seconds.
+	workerLiveness = 2 * time.Minute
+	// workerJobsPageSize is how many running workflows, across all definitions, are searched for
+	// jobs waiting on or running on workers.
+	workerJobsPageSize = 100
+)
+
+// HeartbeatWorker registers an activity worker, or records that it is still polling.
+func (h Handler) HeartbeatWorker(ctx context.Context, heartbeat *models.WorkerHeartbeat) (*models.Worker, error) {
+	if heartbeat == nil || heartbeat.Name == "" || heartbeat.Resource == "" {
+		return nil, models.BadRequest{Message: "name and resource are required"}
+	}
+	if !resources.IsActivityResource(heartbeat.Resource) {
+		return nil, models.BadRequest{Message: "workers can only poll activity resources"}
+	}
+	worker := resources.NewWorker(heartbeat, time.Now())
+	if err := h.store.SaveWorker(ctx, worker); err != nil {
+		return nil, err
+	}
+	return &worker, nil
+}
+
+// GetWorkers lists the live workers of each activity resource, along with the jobs of running
+// workflows queued for the resource or running on its workers. Resources with queued jobs but no
+// live workers are marked as unpolled. Jobs are reported as stored, which the update loop keeps in
+// sync for workflows with queued jobs.
+func (h Handler) GetWorkers(ctx context.Context, input *models.GetWorkersInput) ([]models.ResourceWorkers, error) {
+	workers, err := h.store.GetWorkers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	workflows, err := h.runningWorkflows(ctx, aws.StringValue(input.Namespace))
+	if err != nil {
+		return nil, err
+	}
+	return filterResourceWorkers(resources.ResourceWorkers(workers, workflows, time.Now(), workerLiveness), input), nil
+}
+
+func filterResourceWorkers(resourceWorkers []models.ResourceWorkers, input *models.GetWorkersInput) []models.ResourceWorkers {
+	filtered := []models.ResourceWorkers{}
+	for _, rw := range resourceWorkers {
+		if input.Namespace != nil && rw.Namespace != *input.Namespace {
+			continue
+		}
+		if input.Resource != nil && rw.Resource != *input.Resource {
+			continue
+		}
+		filtered = append(filtered, rw)
+	}
+	return filtered
+}
+
+// runningWorkflows returns the latest running workflows, up to workerJobsPageSize across all
+// workflow definitions, optionally only those in a namespace.
+func (h Handler) runningWorkflows(ctx context.Context, namespace string) ([]models.Workflow, error) {
+	definitions, err := h.store.GetWorkflowDefinitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	running := []models.Workflow{}
+	remaining := workerJobsPageSize
+	for _, definition := range definitions {
+		if remaining <= 0 {
+			break
+		}
+		name := definition.Name
+		workflows, _, err := h.store.GetWorkflows(ctx, &models.WorkflowQuery{
+			WorkflowDefinitionName: &name,
+			Status:                 models.WorkflowStatusRunning,
+			Limit:                  int64(remaining),
+		})
+		if err != nil {
+			return nil, err
+		}
+		remaining -= len(workflows)
+		for _, workflow := range workflows {
+			if namespace == "" || workflow.Namespace == namespace {
+				running = append(running, workflow)
+			}
+		}
+	}
+	return running, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store/memory"
+)
+
+func TestWorkers(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	h := Handler{store: store}
+
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	workflow := resources.NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	workflow.Status = models.WorkflowStatusRunning
+	workflow.Jobs = []*models.Job{
+		{ID: "1", State: "start-state", Status: models.JobStatusRunning, Container: "worker-1"},
+		{ID: "2", State: "second-state", Status: models.JobStatusQueued},
+	}
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	t.Log("Heartbeats require a worker name and an activity resource")
+	_, err := h.HeartbeatWorker(ctx, &models.WorkerHeartbeat{Resource: "fake-resource-1"})
+	assert.IsType(t, models.BadRequest{}, err)
+	_, err = h.HeartbeatWorker(ctx, &models.WorkerHeartbeat{Name: "worker-1", Resource: "lambda:function"})
+	assert.IsType(t, models.BadRequest{}, err)
+
+	worker, err := h.HeartbeatWorker(ctx, &models.WorkerHeartbeat{
+		Name: "worker-1", Namespace: "namespace", Resource: "fake-resource-1", Version: "v1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "v1", worker.Version)
+
+	t.Log("Running jobs are matched to live workers")
+	resourceWorkers, err := h.GetWorkers(ctx, &models.GetWorkersInput{Namespace: aws.String("namespace")})
+	require.NoError(t, err)
+	require.Len(t, resourceWorkers, 2)
+	assert.Equal(t, "fake-resource-1", resourceWorkers[0].Resource)
+	require.Len(t, resourceWorkers[0].Workers, 1)
+	require.Len(t, resourceWorkers[0].RunningJobs, 1)
+	assert.True(t, resourceWorkers[0].RunningJobs[0].WorkerLive)
+
+	t.Log("Resources with queued jobs and no live workers are unpolled")
+	resourceWorkers, err = h.GetWorkers(ctx, &models.GetWorkersInput{Resource: aws.String("fake-resource-2")})
+	require.NoError(t, err)
+	require.Len(t, resourceWorkers, 1)
+	require.Len(t, resourceWorkers[0].QueuedJobs, 1)
+	assert.Equal(t, workflow.ID, resourceWorkers[0].QueuedJobs[0].WorkflowID)
+	assert.True(t, resourceWorkers[0].Unpolled)
+
+	resourceWorkers, err = h.GetWorkers(ctx, &models.GetWorkersInput{Namespace: aws.String("other-namespace")})
+	require.NoError(t, err)
+	assert.Empty(t, resourceWorkers)
+
+	t.Log("Only workerJobsPageSize running workflows are searched across all definitions")
+	otherDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	otherDefinition.Name = "other-definition"
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *otherDefinition))
+	for _, wd := range []*models.WorkflowDefinition{workflowDefinition, otherDefinition} {
+		for i := 0; i < workerJobsPageSize; i++ {
+			queued := resources.NewWorkflow(wd, `{}`, "paged", "queue", map[string]interface{}{})
+			queued.Status = models.WorkflowStatusRunning
+			queued.Jobs = []*models.Job{{ID: "1", State: "end-state", Status: models.JobStatusQueued}}
+			require.NoError(t, store.SaveWorkflow(ctx, *queued))
+		}
+	}
+	resourceWorkers, err = h.GetWorkers(ctx, &models.GetWorkersInput{Namespace: aws.String("paged")})
+	require.NoError(t, err)
+	require.Len(t, resourceWorkers, 1)
+	assert.Len(t, resourceWorkers[0].QueuedJobs, workerJobsPageSize)
+}
@@ -0,0 +1,470 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"gopkg.in/Clever/kayvee-go.v6/logger"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store"
+)
+
+const (
+	// eventBufferSize is how many events a watcher can fall behind before it is disconnected
+	eventBufferSize = 100
+	// keepAliveInterval is how often a comment is sent on quiet streams, so that they aren't
+	// closed by proxies
+	keepAliveInterval = 15 * time.Second
+)
+
+// endEvent is the last event of a stream that is done.
+const endEvent = "end"
+
+// serverEvent is an event sent to watchers as a Server-Sent Event.
+type serverEvent struct {
+	name string
+	data interface{}
+}
+
+// eventSource produces the events of a stream. A source is only used by its stream's goroutine,
+// however many watchers the stream has.
+type eventSource interface {
+	// poll returns the events since the last poll, and whether the stream is done.
+	poll(ctx context.Context) ([]serverEvent, bool, error)
+	// update returns the events of a workflow synced by the update loop, and whether the stream
+	// is done.
+	update(workflow models.Workflow) ([]serverEvent, bool)
+	// snapshot returns the events that bring a watcher joining the stream up to date.
+	snapshot() []serverEvent
+}
+
+type eventStream struct {
+	source eventSource
+	// snapshot is taken after each poll or update, since only the stream's goroutine uses the source
+	snapshot []serverEvent
+	watchers map[chan serverEvent]bool
+	// updates are the workflows synced by the update loop since the stream's goroutine last ran
+	updates chan models.Workflow
+}
+
+// workflowEvents streams the changes of workflows and of the workflows of a definition as
+// Server-Sent Events, for GetWorkflowEvents and GetWorkflowDefinitionEvents.
+//
+// Watchers of the same workflow or definition share one stream. Workflow streams are driven by
+// the update loop, which tells them about the workflows it syncs, and re-read their workflow from
+// the store in between to pick up syncs made elsewhere, e.g. by other instances. Streams don't
+// call Step Functions themselves.
+type workflowEvents struct {
+	store        store.Store
+	pollInterval time.Duration
+
+	mu      sync.Mutex
+	streams map[string]*eventStream
+}
+
+func newWorkflowEvents(store store.Store, pollInterval time.Duration) *workflowEvents {
+	return &workflowEvents{
+		store:        store,
+		pollInterval: pollInterval,
+		streams:      map[string]*eventStream{},
+	}
+}
+
+func workflowStreamKey(workflowID string) string {
+	return "workflow:" + workflowID
+}
+
+func workflowDefinitionStreamKey(name string) string {
+	return "workflow-definition:" + name
+}
+
+// IsWatched returns whether a workflow has watchers.
+func (e *workflowEvents) IsWatched(workflowID string) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, ok := e.streams[workflowStreamKey(workflowID)]
+	return ok
+}
+
+// WorkflowUpdated passes a workflow synced by the update loop to the stream of its watchers.
+func (e *workflowEvents) WorkflowUpdated(workflow models.Workflow) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	stream, ok := e.streams[workflowStreamKey(workflow.ID)]
+	if !ok {
+		return
+	}
+	select {
+	case stream.updates <- workflow:
+	default:
+		// the stream is behind, and catches up from the store on its next poll
+	}
+}
+
+// GetWorkflowEvents streams the changes of a workflow until it's done.
+func (h Handler) GetWorkflowEvents(ctx context.Context, workflowID string) error {
+	w, err := eventStreamWriterFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := h.store.GetWorkflowByID(ctx, workflowID); err != nil {
+		return err
+	}
+	h.events.serve(ctx, w, workflowStreamKey(workflowID), func() eventSource {
+		return &workflowSource{store: h.store, workflowID: workflowID}
+	})
+	return nil
+}
+
+// GetWorkflowDefinitionEvents streams the submissions and completions of the workflows of a
+// definition.
+func (h Handler) GetWorkflowDefinitionEvents(ctx context.Context, name string) error {
+	w, err := eventStreamWriterFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := h.store.LatestWorkflowDefinition(ctx, name); err != nil {
+		return err
+	}
+	h.events.serve(ctx, w, workflowDefinitionStreamKey(name), func() eventSource {
+		return &workflowDefinitionSource{store: h.store, name: name, since: time.Now()}
+	})
+	return nil
+}
+
+// eventStreamKey is the context key of the writer of an event stream.
+type eventStreamKey struct{}
+
+// eventStreamWriter writes an event stream. The server's middleware wraps the response writer in
+// writers that can't be flushed, so the writer of the connection is kept to flush the stream.
+type eventStreamWriter struct {
+	http.ResponseWriter
+	flusher     http.Flusher
+	wroteHeader bool
+}
+
+func (w *eventStreamWriter) WriteHeader(status int) {
+	// the generated handler writes a status once the stream ends
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *eventStreamWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *eventStreamWriter) Flush() {
+	w.flusher.Flush()
+}
+
+func eventStreamWriterFromContext(ctx context.Context) (*eventStreamWriter, error) {
+	w, ok := ctx.Value(eventStreamKey{}).(*eventStreamWriter)
+	if !ok || w.ResponseWriter == nil {
+		return nil, models.InternalError{Message: "streaming is not supported"}
+	}
+	return w, nil
+}
+
+// isEventStream matches the requests for event streams, i.e. GET /workflows/{workflowID}/events
+// and GET /workflow-definitions/{name}/events.
+func isEventStream(r *http.Request) bool {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	return r.Method == http.MethodGet && len(parts) == 3 && parts[1] != "" && parts[2] == "events" &&
+		(parts[0] == "workflows" || parts[0] == "workflow-definitions")
+}
+
+// flushEventStreams keeps the writer of the connection of event streams, which the server's
+// middleware hides. It wraps the server's handler, so that it runs before that middleware.
+func flushEventStreams(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if flusher, ok := w.(http.Flusher); ok && isEventStream(r) {
+			r = r.WithContext(context.WithValue(r.Context(), eventStreamKey{}, &eventStreamWriter{flusher: flusher}))
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// writeEventStreams passes event streams the response writer of the server's middleware, so that
+// the middleware sees their responses. It must be the last middleware.
+func writeEventStreams(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if stream, ok := r.Context().Value(eventStreamKey{}).(*eventStreamWriter); ok {
+			stream.ResponseWriter = w
+			w = stream
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// exceptEventStreams applies a middleware to requests other than event streams, e.g. to time out
+// requests, since streams are long-lived.
+func exceptEventStreams(middleware func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		wrapped := middleware(handler)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isEventStream(r) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+			wrapped.ServeHTTP(w, r)
+		})
+	}
+}
+
+// serve writes the events of a stream until the stream is done or the watcher goes away.
+func (e *workflowEvents) serve(ctx context.Context, w *eventStreamWriter, key string, newSource func() eventSource) {
+	// subscribe before responding, so that watchers don't miss events once they have a response
+	events, unsubscribe := e.subscribe(key, newSource)
+	defer unsubscribe()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case event, ok := <-events:
+			if !ok {
+				// the watcher fell behind, and can reconnect to catch up from a snapshot
+				return
+			}
+			if err := writeServerEvent(w, event); err != nil {
+				return
+			}
+			if event.name == endEvent {
+				w.Flush()
+				return
+			}
+		}
+		w.Flush()
+	}
+}
+
+func writeServerEvent(w http.ResponseWriter, event serverEvent) error {
+	data, err := json.Marshal(event.data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.name, data)
+	return err
+}
+
+// subscribe adds a watcher to a stream, starting the stream's goroutine if it's the first watcher.
+// The returned channel ends with an end event when the stream is done, and is closed early when
+// the watcher falls too far behind.
+func (e *workflowEvents) subscribe(key string, newSource func() eventSource) (chan serverEvent, func()) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	stream, ok := e.streams[key]
+	if !ok {
+		stream = &eventStream{
+			source:   newSource(),
+			watchers: map[chan serverEvent]bool{},
+			updates:  make(chan models.Workflow, eventBufferSize),
+		}
+		e.streams[key] = stream
+		go e.run(key, stream)
+	}
+	events := make(chan serverEvent, eventBufferSize)
+	for _, event := range stream.snapshot {
+		events <- event
+	}
+	stream.watchers[events] = true
+
+	return events, func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		if stream.watchers[events] {
+			delete(stream.watchers, events)
+			close(events)
+		}
+	}
+}
+
+// run broadcasts the events of a stream's source, from the updates passed to the stream and from
+// polls in between, until the stream is done or has no watchers left.
+func (e *workflowEvents) run(key string, stream *eventStream) {
+	ctx := logger.NewContext(context.Background(), logger.New("workflow-manager"))
+	// the first poll sends the workflow to the first watchers
+	poll := time.NewTimer(0)
+	defer poll.Stop()
+	for {
+		var events []serverEvent
+		var done bool
+		select {
+		case <-poll.C:
+			var err error
+			events, done, err = stream.source.poll(ctx)
+			if err != nil {
+				logger.FromContext(ctx).ErrorD("poll-events", logger.M{"stream": key, "error": err.Error()})
+			}
+			poll.Reset(e.pollInterval)
+		case workflow := <-stream.updates:
+			events, done = stream.source.update(workflow)
+		}
+
+		if done {
+			// tells watchers not to reconnect, which EventSource does when a stream closes
+			events = append(events, serverEvent{name: endEvent, data: struct{}{}})
+		}
+
+		snapshot := stream.source.snapshot()
+
+		e.mu.Lock()
+		stream.snapshot = snapshot
+	watchers:
+		for watcher := range stream.watchers {
+			for _, event := range events {
+				select {
+				case watcher <- event:
+				default:
+					delete(stream.watchers, watcher)
+					close(watcher)
+					continue watchers
+				}
+			}
+		}
+		if done || len(stream.watchers) == 0 {
+			for watcher := range stream.watchers {
+				delete(stream.watchers, watcher)
+				close(watcher)
+			}
+			delete(e.streams, key)
+			e.mu.Unlock()
+			return
+		}
+		e.mu.Unlock()
+	}
+}
+
+// workflowSource produces the events of a workflow, from the syncs of the update loop and the
+// workflow as last saved in the store:
+//
+//	workflow: the workflow and its jobs, sent when a watcher joins
+//	job:      a job that started or changed status
+//	status:   the summary of the workflow, when its status changes
+type workflowSource struct {
+	store      store.Store
+	workflowID string
+
+	latest *models.Workflow
+}
+
+func (s *workflowSource) poll(ctx context.Context) ([]serverEvent, bool, error) {
+	workflow, err := s.store.GetWorkflowByID(ctx, s.workflowID)
+	if err != nil {
+		return nil, false, err
+	}
+	events, done := s.update(workflow)
+	return events, done, nil
+}
+
+func (s *workflowSource) update(workflow models.Workflow) ([]serverEvent, bool) {
+	if s.latest == nil {
+		s.latest = &workflow
+		return []serverEvent{{name: "workflow", data: workflow}}, resources.WorkflowIsDone(&workflow)
+	}
+	// a poll can read the workflow from before an update it follows
+	if time.Time(workflow.LastUpdated).Before(time.Time(s.latest.LastUpdated)) {
+		return nil, false
+	}
+
+	var events []serverEvent
+	previous := map[string]models.JobStatus{}
+	for _, job := range s.latest.Jobs {
+		previous[job.ID] = job.Status
+	}
+	for _, job := range workflow.Jobs {
+		if status, ok := previous[job.ID]; !ok || status != job.Status {
+			events = append(events, serverEvent{name: "job", data: job})
+		}
+	}
+	if workflow.Status != s.latest.Status {
+		events = append(events, serverEvent{name: "status", data: workflow.WorkflowSummary})
+	}
+	s.latest = &workflow
+	return events, resources.WorkflowIsDone(&workflow)
+}
+
+func (s *workflowSource) snapshot() []serverEvent {
+	if s.latest == nil {
+		// the first poll sends the workflow
+		return nil
+	}
+	return []serverEvent{{name: "workflow", data: *s.latest}}
+}
+
+// workflowDefinitionWindow is how many of the latest workflows of a definition are compared
+// between polls.
+const workflowDefinitionWindow = 50
+
+// workflowDefinitionSource produces the events of the workflows of a definition, from their
+// summaries in the store:
+//
+//	submitted: the summary of a workflow started since the stream started
+//	completed: the summary of a workflow that finished since the stream started
+type workflowDefinitionSource struct {
+	store store.Store
+	name  string
+	since time.Time
+
+	statuses map[string]models.WorkflowStatus
+}
+
+func (s *workflowDefinitionSource) poll(ctx context.Context) ([]serverEvent, bool, error) {
+	workflows, _, err := s.store.GetWorkflows(ctx, &models.WorkflowQuery{
+		WorkflowDefinitionName: &s.name,
+		Limit:                  workflowDefinitionWindow,
+		SummaryOnly:            aws.Bool(true),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	var events []serverEvent
+	statuses := map[string]models.WorkflowStatus{}
+	// oldest first, so that events are in the order they happened
+	for i := len(workflows) - 1; i >= 0; i-- {
+		workflow := workflows[i]
+		statuses[workflow.ID] = workflow.Status
+		previous, seen := s.statuses[workflow.ID]
+		if !seen && time.Time(workflow.CreatedAt).After(s.since) {
+			events = append(events, serverEvent{name: "submitted", data: workflow.WorkflowSummary})
+		}
+		if previous != workflow.Status && resources.WorkflowStatusIsDone(&workflow) &&
+			time.Time(workflow.LastUpdated).After(s.since) {
+			events = append(events, serverEvent{name: "completed", data: workflow.WorkflowSummary})
+		}
+	}
+	s.statuses = statuses
+	return events, false, nil
+}
+
+// update does nothing, since definition streams aren't passed the workflows the update loop syncs.
+func (s *workflowDefinitionSource) update(workflow models.Workflow) ([]serverEvent, bool) {
+	return nil, false
+}
+
+func (s *workflowDefinitionSource) snapshot() []serverEvent {
+	return nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Clever/workflow-manager/gen-go/models"
+	"github.com/Clever/workflow-manager/resources"
+	"github.com/Clever/workflow-manager/store"
+	"github.com/Clever/workflow-manager/store/memory"
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventReader reads the names of the events of a stream.
+type eventReader struct {
+	t       *testing.T
+	scanner *bufio.Scanner
+}
+
+func newEventReader(t *testing.T, resp *http.Response) *eventReader {
+	return &eventReader{t: t, scanner: bufio.NewScanner(resp.Body)}
+}
+
+// next returns the name of the next event, or "" once the stream ends.
+func (r *eventReader) next() string {
+	for r.scanner.Scan() {
+		if strings.HasPrefix(r.scanner.Text(), "event: ") {
+			return strings.TrimPrefix(r.scanner.Text(), "event: ")
+		}
+	}
+	require.NoError(r.t, r.scanner.Err())
+	return ""
+}
+
+func TestWorkflowEvents(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+	workflow := resources.NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+
+	events := newWorkflowEvents(store, 10*time.Millisecond)
+	s := httptest.NewServer(newServer(Handler{store: store, events: events}, ":0").Handler)
+	defer s.Close()
+
+	t.Log("Returns 404 for unknown workflows")
+	resp, err := http.Get(s.URL + "/workflows/unknown/events")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	t.Log("Streams the changes of a workflow to all its watchers")
+	readers := []*eventReader{}
+	for i := 0; i < 2; i++ {
+		resp, err := http.Get(s.URL + "/workflows/" + workflow.ID + "/events")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+		readers = append(readers, newEventReader(t, resp))
+	}
+	assert.True(t, events.IsWatched(workflow.ID))
+	expect := func(names ...string) {
+		for _, reader := range readers {
+			for _, name := range names {
+				assert.Equal(t, name, reader.next())
+			}
+		}
+	}
+	// save saves the workflow the way the update loop does, and tells the watchers if updated
+	save := func(status models.WorkflowStatus, jobs []*models.Job, updated bool) {
+		workflow.Status = status
+		workflow.Jobs = jobs
+		workflow.LastUpdated = strfmt.DateTime(time.Now())
+		require.NoError(t, store.UpdateWorkflow(ctx, *workflow))
+		if updated {
+			events.WorkflowUpdated(*workflow)
+		}
+	}
+	expect("workflow")
+	save(models.WorkflowStatusRunning, []*models.Job{
+		{ID: "1", State: "start-state", Status: models.JobStatusRunning},
+	}, true)
+	expect("job", "status")
+	save(models.WorkflowStatusRunning, []*models.Job{
+		{ID: "1", State: "start-state", Status: models.JobStatusSucceeded},
+		{ID: "2", State: "second-state", Status: models.JobStatusRunning},
+	}, true)
+	expect("job", "job")
+
+	t.Log("Streams changes synced by other instances from the store")
+	save(models.WorkflowStatusSucceeded, []*models.Job{
+		{ID: "1", State: "start-state", Status: models.JobStatusSucceeded},
+		{ID: "2", State: "second-state", Status: models.JobStatusSucceeded},
+		{ID: "3", State: "end-state", Status: models.JobStatusSucceeded},
+	}, false)
+	expect("job", "job", "status", "end", "")
+	assert.False(t, events.IsWatched(workflow.ID))
+}
+
+// lockedStore lets a test write workflows while the memory store is polled.
+type lockedStore struct {
+	store.Store
+	mu sync.Mutex
+}
+
+func (s *lockedStore) SaveWorkflow(ctx context.Context, workflow models.Workflow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Store.SaveWorkflow(ctx, workflow)
+}
+
+func (s *lockedStore) UpdateWorkflow(ctx context.Context, workflow models.Workflow) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Store.UpdateWorkflow(ctx, workflow)
+}
+
+func (s *lockedStore) GetWorkflows(ctx context.Context, query *models.WorkflowQuery) ([]models.Workflow, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Store.GetWorkflows(ctx, query)
+}
+
+func TestWorkflowDefinitionEvents(t *testing.T) {
+	ctx := context.Background()
+	store := &lockedStore{Store: memory.New()}
+	workflowDefinition := resources.KitchenSinkWorkflowDefinition(t)
+	require.NoError(t, store.SaveWorkflowDefinition(ctx, *workflowDefinition))
+
+	events := newWorkflowEvents(store, 10*time.Millisecond)
+	s := httptest.NewServer(newServer(Handler{store: store, events: events}, ":0").Handler)
+	defer s.Close()
+
+	t.Log("Returns 404 for unknown definitions")
+	resp, err := http.Get(s.URL + "/workflow-definitions/unknown/events")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	t.Log("Streams the submissions and completions of workflows")
+	resp, err = http.Get(s.URL + "/workflow-definitions/" + workflowDefinition.Name + "/events")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	reader := newEventReader(t, resp)
+
+	workflow := resources.NewWorkflow(workflowDefinition, `{}`, "namespace", "queue", map[string]interface{}{})
+	require.NoError(t, store.SaveWorkflow(ctx, *workflow))
+	assert.Equal(t, "submitted", reader.next())
+	workflow.Status = models.WorkflowStatusFailed
+	require.NoError(t, store.UpdateWorkflow(ctx, *workflow))
+	assert.Equal(t, "completed", reader.next())
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/Clever/workflow-manager/embedded"
+	"github.com/Clever/workflow-manager/gen-go/models"
+)
+
+// yamlMediaTypes are the media types accepted for YAML workflow definitions
+var yamlMediaTypes = map[string]bool{
+	"application/yaml":   true,
+	"application/x-yaml": true,
+	"text/yaml":          true,
+}
+
+// yamlWorkflowDefinitions lets clients send and receive workflow definitions as YAML, the same
+// format as the definitions of embedded workflow-manager. YAML requests are parsed the way embedded
+// workflow-manager parses them, and errors about a field of a state point at its line of the YAML.
+func yamlWorkflowDefinitions(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		yamlRequest := isYAMLMediaType(r.Header.Get("Content-Type"))
+		yamlResponse := acceptsYAML(r.Header.Get("Accept"))
+		if !isWorkflowDefinitionPath(r.URL.Path) || (!yamlRequest && !yamlResponse) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		var keys []yamlKey
+		if yamlRequest {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				writeJSONError(w, models.BadRequest{Message: err.Error()}, http.StatusBadRequest)
+				return
+			}
+			// syntax errors already include their line, e.g. "yaml: line 3: mapping values are not allowed"
+			wfd, err := embedded.ParseWorkflowDefinition(body)
+			if err != nil {
+				writeJSONError(w, models.BadRequest{Message: err.Error()}, http.StatusBadRequest)
+				return
+			}
+			jsonBody, err := json.Marshal(models.NewWorkflowDefinitionRequest{
+				AutoRetry:     wfd.AutoRetry,
+				Deadlines:     wfd.Deadlines,
+				DefaultTags:   wfd.DefaultTags,
+				InputSchema:   wfd.InputSchema,
+				Manager:       wfd.Manager,
+				Name:          wfd.Name,
+				OutputSchemas: wfd.OutputSchemas,
+				StateMachine:  wfd.StateMachine,
+				Triggers:      wfd.Triggers,
+			})
+			if err != nil {
+				writeJSONError(w, models.BadRequest{Message: err.Error()}, http.StatusBadRequest)
+				return
+			}
+			keys = yamlKeys(body)
+			r.Body = ioutil.NopCloser(bytes.NewReader(jsonBody))
+			r.ContentLength = int64(len(jsonBody))
+			r.Header.Set("Content-Type", "application/json")
+		}
+
+		resp := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+		handler.ServeHTTP(resp, r)
+		body := resp.body.Bytes()
+		switch {
+		case resp.status >= http.StatusBadRequest && yamlRequest:
+			var apiErr struct {
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+				if line, ok := yamlErrorLine(keys, apiErr.Message); ok {
+					body = bytes.Replace(body,
+						[]byte(jsonString(apiErr.Message)),
+						[]byte(jsonString(fmt.Sprintf("line %d: %s", line, apiErr.Message))), 1)
+				}
+			}
+		case resp.status < http.StatusMultipleChoices && yamlResponse:
+			yamlBody, err := yaml.JSONToYAML(body)
+			if err != nil {
+				writeJSONError(w, models.InternalError{Message: err.Error()}, http.StatusInternalServerError)
+				return
+			}
+			body = yamlBody
+			resp.header.Set("Content-Type", "application/yaml")
+		}
+
+		for key, values := range resp.header {
+			w.Header()[key] = values
+		}
+		w.Header().Del("Content-Length")
+		w.WriteHeader(resp.status)
+		w.Write(body)
+	})
+}
+
+// isWorkflowDefinitionPath matches the paths of workflow definitions, i.e. /workflow-definitions,
+// /workflow-definitions/{name} and /workflow-definitions/{name}/{version}.
+func isWorkflowDefinitionPath(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] != "workflow-definitions" {
+		return false
+	}
+	switch len(segments) {
+	case 1, 2:
+		return true
+	case 3:
+		_, err := strconv.Atoi(segments[2])
+		return err == nil
+	}
+	return false
+}
+
+func isYAMLMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && yamlMediaTypes[mediaType]
+}
+
+func acceptsYAML(accept string) bool {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		if isYAMLMediaType(strings.TrimSpace(mediaRange)) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSONError(w http.ResponseWriter, err error, status int) {
+	encoded, _ := json.Marshal(err)
+	http.Error(w, string(encoded), status)
+}
+
+func jsonString(s string) string {
+	encoded, _ := json.Marshal(s)
+	return string(encoded)
+}
+
+// bufferedResponse holds a response so that it can be rewritten before it is sent.
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *bufferedResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *bufferedResponse) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *bufferedResponse) WriteHeader(status int) {
+	r.status = status
+}
+
+// yamlKey is a key of a YAML mapping, with the keys of the mappings containing it and its value
+// if the value is a scalar on the same line.
+type yamlKey struct {
+	path  []string
+	value string
+	line  int
+}
+
+// yamlKeys lists the keys of the block mappings in a YAML document with their line numbers. It
+// relies on indentation rather than fully parsing the document, which has been parsed already.
+func yamlKeys(doc []byte) []yamlKey {
+	type level struct {
+		indent int
+		key    string
+	}
+	keys := []yamlKey{}
+	stack := []level{}
+	for i, line := range strings.Split(string(doc), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+		// the keys of mappings in lists are indented by the list's dash
+		for strings.HasPrefix(trimmed, "- ") {
+			rest := strings.TrimLeft(trimmed[1:], " ")
+			indent += len(trimmed) - len(rest)
+			trimmed = rest
+		}
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		colon := strings.Index(trimmed, ":")
+		if colon <= 0 || (colon+1 < len(trimmed) && trimmed[colon+1] != ' ') {
+			continue
+		}
+		key := strings.Trim(trimmed[:colon], `"'`)
+		value := trimmed[colon+1:]
+		if comment := strings.Index(value, " #"); comment >= 0 {
+			value = value[:comment]
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		stack = append(stack, level{indent: indent, key: key})
+		path := []string{}
+		for _, l := range stack {
+			path = append(path, l.key)
+		}
+		keys = append(keys, yamlKey{path: path, value: value, line: i + 1})
+	}
+	return keys
+}
+
+// yamlErrorLine finds the line of a YAML workflow definition that an error is about: the field of
+// the state the error names whose key or value the error also names. Errors that don't name both
+// get no line, since field names such as Next or Resource are shared by most states.
+func yamlErrorLine(keys []yamlKey, message string) (int, bool) {
+	isState := func(key yamlKey) bool {
+		return len(key.path) >= 3 && key.path[0] == "stateMachine" && key.path[1] == "States"
+	}
+	// prefer the longest state name, since state names can contain each other
+	state := ""
+	for _, key := range keys {
+		if isState(key) && len(key.path) == 3 && containsWord(message, key.path[2]) && len(key.path[2]) > len(state) {
+			state = key.path[2]
+		}
+	}
+	if state == "" {
+		return 0, false
+	}
+	for _, key := range keys {
+		if !isState(key) || len(key.path) == 3 || key.path[2] != state {
+			continue
+		}
+		if containsWord(message, key.path[len(key.path)-1]) || (key.value != "" && containsWord(message, key.value)) {
+			return key.line, true
+		}
+	}
+	return 0, false
+}
+
+// containsWord checks if a message contains a word that isn't part of a longer name.
+func containsWord(message, word string) bool {
+	isNameChar := func(c byte) bool {
+		return c == '-' || c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+	}
+	for offset := 0; offset < len(message); {
+		i := strings.Index(message[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(word)
+		if (start == 0 || !isNameChar(message[start-1])) && (end == len(message) || !isNameChar(message[end])) {
+			return true
+		}
+		offset = start + 1
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Clever/workflow-manager/gen-go/server"
+	"github.com/Clever/workflow-manager/store/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const yamlDefinition = `name: yaml-definition
+stateMachine:
+  StartAt: first
+  States:
+    first:
+      Type: Task
+      Resource: worker
+      Next: second
+    second:
+      Type: Task
+      Resource: worker
+      Next: %s
+    third:
+      Type: Succeed
+`
+
+func TestYAMLWorkflowDefinitions(t *testing.T) {
+	h := Handler{store: memory.New()}
+	s := server.NewWithMiddleware(h, ":0", []func(http.Handler) http.Handler{yamlWorkflowDefinitions})
+	request := func(method, path, contentType, accept, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		req.Header.Set("Accept", accept)
+		resp := httptest.NewRecorder()
+		s.Handler.ServeHTTP(resp, req)
+		return resp
+	}
+
+	t.Log("Creates definitions from YAML")
+	resp := request("POST", "/workflow-definitions", "application/yaml", "application/json",
+		strings.Replace(yamlDefinition, "%s", "third", 1))
+	require.Equal(t, http.StatusCreated, resp.Code, resp.Body.String())
+	assert.Contains(t, resp.Body.String(), `"name": "yaml-definition"`)
+
+	t.Log("Returns definitions as YAML")
+	resp = request("GET", "/workflow-definitions/yaml-definition/0", "", "application/yaml", "")
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	assert.Equal(t, "application/yaml", resp.Header().Get("Content-Type"))
+	assert.Contains(t, resp.Body.String(), "name: yaml-definition\n")
+
+	t.Log("Points errors at the line of the YAML they are about")
+	resp = request("PUT", "/workflow-definitions/yaml-definition", "application/yaml", "",
+		strings.Replace(yamlDefinition, "%s", "missing", 1))
+	assert.Contains(t, resp.Body.String(), `line 12: invalid transition in 'second': 'missing'`)
+	resp = request("PUT", "/workflow-definitions/yaml-definition", "application/yaml", "",
+		strings.Replace(yamlDefinition, "%s", "[third", 1))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, resp.Body.String(), "line 12")
+
+	t.Log("Leaves JSON requests and other paths alone")
+	resp = request("GET", "/workflow-definitions/yaml-definition/diff?from=0&to=0", "", "application/yaml", "")
+	assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	assert.Contains(t, resp.Body.String(), `"name": "yaml-definition"`)
+}
+
+func TestYAMLErrorLine(t *testing.T) {
+	keys := yamlKeys([]byte(strings.Replace(yamlDefinition, "%s", "third", 1)))
+	line, ok := yamlErrorLine(keys, "invalid transition in 'second': 'third'")
+	assert.True(t, ok)
+	assert.Equal(t, 12, line)
+	line, ok = yamlErrorLine(keys, "Resource of state first is not allowed")
+	assert.True(t, ok)
+	assert.Equal(t, 7, line)
+	_, ok = yamlErrorLine(keys, "json: cannot unmarshal string into Go struct field SLState.Resource of type int")
+	assert.False(t, ok)
+	_, ok = yamlErrorLine(keys, "Must define at least one state")
+	assert.False(t, ok)
+}